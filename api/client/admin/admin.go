@@ -13,6 +13,7 @@ type Admin struct {
 	Data        *Data
 	Records     *Records
 	Indices     *Indices
+	Scheduler   *Scheduler
 }
 
 // NewAdmin creates a new admin API.
@@ -25,5 +26,6 @@ func NewAdmin(client adminPb.AdminServiceClient) *Admin {
 		Data:        &Data{client: client},
 		Records:     &Records{client: client},
 		Indices:     &Indices{client: client},
+		Scheduler:   &Scheduler{client: client},
 	}
 }
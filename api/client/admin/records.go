@@ -32,3 +32,13 @@ func (c *Records) GetUpdatedStorageDealRecordsSince(ctx context.Context, since t
 	}
 	return c.client.GetUpdatedStorageDealRecordsSince(ctx, req)
 }
+
+// RetrievalMinerRanking returns how miners are ranked for retrievals considering
+// their past retrieval records. If no miners are provided, all miners with
+// past retrievals are ranked.
+func (c *Records) RetrievalMinerRanking(ctx context.Context, miners []string) (*adminPb.RetrievalMinerRankingResponse, error) {
+	req := &adminPb.RetrievalMinerRankingRequest{
+		Miners: miners,
+	}
+	return c.client.RetrievalMinerRanking(ctx, req)
+}
@@ -0,0 +1,30 @@
+package admin
+
+import (
+	"context"
+	"time"
+
+	adminPb "github.com/textileio/powergate/v2/api/gen/powergate/admin/v1"
+)
+
+// Scheduler provides access to Powergate scheduler admin APIs.
+type Scheduler struct {
+	client adminPb.AdminServiceClient
+}
+
+// Drain stops dequeuing queued storage and retrieval jobs, letting executing jobs
+// finish. If timeout isn't zero, jobs still executing after it are
+// interrupted and re-queued, to be executed again when undrained or restarted.
+func (s *Scheduler) Drain(ctx context.Context, timeout time.Duration) (*adminPb.DrainResponse, error) {
+	return s.client.Drain(ctx, &adminPb.DrainRequest{TimeoutSeconds: int64(timeout.Seconds())})
+}
+
+// Undrain resumes dequeuing queued storage and retrieval jobs.
+func (s *Scheduler) Undrain(ctx context.Context) (*adminPb.UndrainResponse, error) {
+	return s.client.Undrain(ctx, &adminPb.UndrainRequest{})
+}
+
+// DrainStatus returns the draining state and the number of executing jobs.
+func (s *Scheduler) DrainStatus(ctx context.Context) (*adminPb.DrainStatusResponse, error) {
+	return s.client.DrainStatus(ctx, &adminPb.DrainStatusRequest{})
+}
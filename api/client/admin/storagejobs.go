@@ -2,6 +2,7 @@ package admin
 
 import (
 	"context"
+	"time"
 
 	adminPb "github.com/textileio/powergate/v2/api/gen/powergate/admin/v1"
 	userPb "github.com/textileio/powergate/v2/api/gen/powergate/user/v1"
@@ -24,6 +25,8 @@ const (
 	Executing
 	// Final lists final StorageJobs.
 	Final
+	// Scheduled lists scheduled StorageJobs.
+	Scheduled
 )
 
 // ListConfig controls the behavior for listing StorageJobs.
@@ -76,6 +79,8 @@ func (j *StorageJobs) List(ctx context.Context, config ListConfig) (*adminPb.Lis
 		sel = userPb.StorageJobsSelector_STORAGE_JOBS_SELECTOR_EXECUTING
 	case Final:
 		sel = userPb.StorageJobsSelector_STORAGE_JOBS_SELECTOR_FINAL
+	case Scheduled:
+		sel = userPb.StorageJobsSelector_STORAGE_JOBS_SELECTOR_SCHEDULED
 	}
 	req := &adminPb.ListStorageJobsRequest{
 		CidFilter:     config.CidFilter,
@@ -99,3 +104,38 @@ func (j *StorageJobs) Summary(ctx context.Context, opts ...SummaryOption) (*admi
 	}
 	return j.client.StorageJobsSummary(ctx, req)
 }
+
+// SetUserFairShare sets the fair-share scheduling configuration of a user. The weight
+// is the relative share of execution slots compared to other users, and maxExecuting
+// limits the number of concurrently executing storage jobs, zero meaning no limit.
+func (j *StorageJobs) SetUserFairShare(ctx context.Context, userID string, weight, maxExecuting int64) (*adminPb.SetUserFairShareResponse, error) {
+	req := &adminPb.SetUserFairShareRequest{
+		UserId:           userID,
+		Weight:           weight,
+		MaxExecutingJobs: maxExecuting,
+	}
+	return j.client.SetUserFairShare(ctx, req)
+}
+
+// RemoveUserFairShare removes the fair-share scheduling configuration of a user,
+// which gets the default share of execution slots.
+func (j *StorageJobs) RemoveUserFairShare(ctx context.Context, userID string) (*adminPb.RemoveUserFairShareResponse, error) {
+	return j.client.RemoveUserFairShare(ctx, &adminPb.RemoveUserFairShareRequest{UserId: userID})
+}
+
+// UserFairShares returns the fair-share scheduling configuration of all users
+// that have one configured.
+func (j *StorageJobs) UserFairShares(ctx context.Context) (*adminPb.UserFairSharesResponse, error) {
+	return j.client.UserFairShares(ctx, &adminPb.UserFairSharesRequest{})
+}
+
+// Prune deletes final storage jobs, and their logs, older than maxAge or exceeding
+// maxPerCid storage jobs per user and cid. The last successful storage job of each
+// user and cid is always kept. Zero values use the Powergate configured values.
+func (j *StorageJobs) Prune(ctx context.Context, maxAge time.Duration, maxPerCid int64) (*adminPb.PruneStorageJobsResponse, error) {
+	req := &adminPb.PruneStorageJobsRequest{
+		MaxAgeSeconds: int64(maxAge / time.Second),
+		MaxPerCid:     maxPerCid,
+	}
+	return j.client.PruneStorageJobs(ctx, req)
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 
 	adminPb "github.com/textileio/powergate/v2/api/gen/powergate/admin/v1"
+	userPb "github.com/textileio/powergate/v2/api/gen/powergate/user/v1"
 )
 
 // Users provides access to Powergate admin users APIs.
@@ -25,3 +26,22 @@ func (p *Users) RegenerateAuth(ctx context.Context, token string) (*adminPb.Rege
 func (p *Users) List(ctx context.Context) (*adminPb.UsersResponse, error) {
 	return p.client.Users(ctx, &adminPb.UsersRequest{})
 }
+
+// SetBudget sets the spending limits of a user in attoFIL per calendar month
+// and per cid lifetime. An empty or zero limit means no limit.
+func (p *Users) SetBudget(ctx context.Context, userID, monthlyLimit, cidLimit string) (*adminPb.SetUserBudgetResponse, error) {
+	req := &adminPb.SetUserBudgetRequest{
+		UserId: userID,
+		Budget: &userPb.Budget{
+			MonthlyLimit: monthlyLimit,
+			CidLimit:     cidLimit,
+		},
+	}
+	return p.client.SetUserBudget(ctx, req)
+}
+
+// Budget returns the spending limits of a user, and the spent and remaining
+// amounts. If a cid is provided, the amounts for it are also included.
+func (p *Users) Budget(ctx context.Context, userID, cid string) (*adminPb.UserBudgetResponse, error) {
+	return p.client.UserBudget(ctx, &adminPb.UserBudgetRequest{UserId: userID, Cid: cid})
+}
@@ -0,0 +1,30 @@
+package client
+
+import (
+	"context"
+
+	userPb "github.com/textileio/powergate/v2/api/gen/powergate/user/v1"
+)
+
+// Budgets provides access to Powergate budgets APIs.
+type Budgets struct {
+	client userPb.UserServiceClient
+}
+
+// Set sets the spending limits in attoFIL per calendar month and per cid
+// lifetime. An empty or zero limit means no limit.
+func (b *Budgets) Set(ctx context.Context, monthlyLimit, cidLimit string) (*userPb.SetBudgetResponse, error) {
+	req := &userPb.SetBudgetRequest{
+		Budget: &userPb.Budget{
+			MonthlyLimit: monthlyLimit,
+			CidLimit:     cidLimit,
+		},
+	}
+	return b.client.SetBudget(ctx, req)
+}
+
+// Get returns the spending limits, and the spent and remaining amounts.
+// If a cid is provided, the amounts for it are also included.
+func (b *Budgets) Get(ctx context.Context, cid string) (*userPb.BudgetResponse, error) {
+	return b.client.Budget(ctx, &userPb.BudgetRequest{Cid: cid})
+}
@@ -20,6 +20,9 @@ type Client struct {
 	Deals         *Deals
 	StorageInfo   *StorageInfo
 	StorageJobs   *StorageJobs
+	Retrievals    *Retrievals
+	Webhooks      *Webhooks
+	Budgets       *Budgets
 	Admin         *admin.Admin
 	conn          *grpc.ClientConn
 	client        userPb.UserServiceClient
@@ -100,6 +103,9 @@ func NewClient(host string, optsOverrides ...grpc.DialOption) (*Client, error) {
 		Deals:         &Deals{client: client},
 		StorageInfo:   &StorageInfo{client: client},
 		StorageJobs:   &StorageJobs{client: client},
+		Retrievals:    &Retrievals{client: client},
+		Webhooks:      &Webhooks{client: client},
+		Budgets:       &Budgets{client: client},
 		Admin:         admin.NewAdmin(adminPb.NewAdminServiceClient(conn)),
 		conn:          conn,
 		client:        client,
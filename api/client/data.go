@@ -2,11 +2,14 @@ package client
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
 	"io"
 	"net/http"
 	"os"
 	"strings"
+	"time"
 
 	files "github.com/ipfs/go-ipfs-files"
 	httpapi "github.com/ipfs/go-ipfs-http-client"
@@ -19,6 +22,12 @@ import (
 	"google.golang.org/grpc/status"
 )
 
+const (
+	maxUploadRetries      = 5
+	uploadRetryBackoff    = time.Second
+	maxUploadRetryBackoff = time.Minute
+)
+
 // Data provides access to Powergate general data APIs.
 type Data struct {
 	client userPb.UserServiceClient
@@ -43,6 +52,32 @@ func WithHistory(enabled bool) WatchLogsOption {
 	}
 }
 
+// GetOption is a function that changes a GetRequest.
+type GetOption func(r *userPb.GetRequest)
+
+// WithGetPath indicates a UnixFS path to be resolved from
+// the cid, so only the data of that path is read.
+func WithGetPath(path string) GetOption {
+	return func(r *userPb.GetRequest) {
+		r.Path = path
+	}
+}
+
+// WithGetOffset indicates the byte offset from which data is read.
+func WithGetOffset(offset int64) GetOption {
+	return func(r *userPb.GetRequest) {
+		r.Offset = offset
+	}
+}
+
+// WithGetLength indicates the maximum number of bytes to read.
+// If zero, data is read until the end.
+func WithGetLength(length int64) GetOption {
+	return func(r *userPb.GetRequest) {
+		r.Length = length
+	}
+}
+
 // WatchLogsEvent represents an event for watching cid logs.
 type WatchLogsEvent struct {
 	Res *userPb.WatchLogsResponse
@@ -77,6 +112,137 @@ func (d *Data) Stage(ctx context.Context, data io.Reader) (*userPb.StageResponse
 	return stream.CloseAndRecv()
 }
 
+// StageCar allows to temporarily import a CAR file in hot storage, preserving its DAG and
+// roots, in preparation for pushing cid storage configs for the roots.
+func (d *Data) StageCar(ctx context.Context, data io.Reader) (*userPb.StageCarResponse, error) {
+	stream, err := d.client.StageCar(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	buffer := make([]byte, 1024*32) // 32KB
+	for {
+		bytesRead, err := data.Read(buffer)
+		if err != nil && err != io.EOF {
+			return nil, err
+		}
+		sendErr := stream.Send(&userPb.StageCarRequest{Chunk: buffer[:bytesRead]})
+		if sendErr != nil {
+			if sendErr == io.EOF {
+				var noOp interface{}
+				return nil, stream.RecvMsg(noOp)
+			}
+			return nil, sendErr
+		}
+		if err == io.EOF {
+			break
+		}
+	}
+	return stream.CloseAndRecv()
+}
+
+// CreateUploadSession creates a session to upload data in chunks of chunkSize bytes, which
+// can be resumed if interrupted. If chunkSize is zero, the server default is used.
+func (d *Data) CreateUploadSession(ctx context.Context, chunkSize int64) (*userPb.CreateUploadSessionResponse, error) {
+	return d.client.CreateUploadSession(ctx, &userPb.CreateUploadSessionRequest{ChunkSize: chunkSize})
+}
+
+// UploadChunk uploads the chunk with the provided index of an upload session.
+func (d *Data) UploadChunk(ctx context.Context, sessionID string, index int64, chunk []byte) (*userPb.UploadChunkResponse, error) {
+	sum := sha256.Sum256(chunk)
+	req := &userPb.UploadChunkRequest{
+		SessionId: sessionID,
+		Index:     index,
+		Data:      chunk,
+		Checksum:  hex.EncodeToString(sum[:]),
+	}
+	return d.client.UploadChunk(ctx, req)
+}
+
+// UploadSessionStatus returns how much data an upload session has received.
+func (d *Data) UploadSessionStatus(ctx context.Context, sessionID string) (*userPb.UploadSessionStatusResponse, error) {
+	return d.client.UploadSessionStatus(ctx, &userPb.UploadSessionStatusRequest{SessionId: sessionID})
+}
+
+// FinalizeUploadSession stages the data of an upload session in hot storage in preparation
+// for pushing a cid storage config.
+func (d *Data) FinalizeUploadSession(ctx context.Context, sessionID string) (*userPb.FinalizeUploadSessionResponse, error) {
+	return d.client.FinalizeUploadSession(ctx, &userPb.FinalizeUploadSessionRequest{SessionId: sessionID})
+}
+
+// StageResumable uploads data using an upload session created with CreateUploadSession, and
+// stages it in hot storage. The upload starts from the data already received by the session,
+// so an interrupted upload can be resumed by calling it again with the same session. Transient
+// errors are retried with backoff, and the retries are reset after every uploaded chunk.
+func (d *Data) StageResumable(ctx context.Context, sessionID string, data io.ReadSeeker) (*userPb.FinalizeUploadSessionResponse, error) {
+	backoff := uploadRetryBackoff
+	for retries := 0; ; retries++ {
+		uploaded, err := d.uploadChunks(ctx, sessionID, data)
+		if err == nil {
+			break
+		}
+		if uploaded > 0 {
+			retries, backoff = 0, uploadRetryBackoff
+		}
+		if retries == maxUploadRetries || !isRetriable(err) {
+			return nil, fmt.Errorf("uploading data: %s", err)
+		}
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(backoff):
+		}
+		backoff *= 2
+		if backoff > maxUploadRetryBackoff {
+			backoff = maxUploadRetryBackoff
+		}
+	}
+	return d.FinalizeUploadSession(ctx, sessionID)
+}
+
+// uploadChunks uploads the data that the session didn't receive yet. It
+// returns the number of chunks uploaded.
+func (d *Data) uploadChunks(ctx context.Context, sessionID string, data io.ReadSeeker) (int, error) {
+	st, err := d.UploadSessionStatus(ctx, sessionID)
+	if err != nil {
+		return 0, err
+	}
+	if _, err := data.Seek(st.Offset, io.SeekStart); err != nil {
+		return 0, fmt.Errorf("seeking data: %s", err)
+	}
+	var uploaded int
+	buffer := make([]byte, st.ChunkSize)
+	for index := st.NextChunk; ; index++ {
+		bytesRead, err := io.ReadFull(data, buffer)
+		if err == io.EOF {
+			return uploaded, nil
+		}
+		if err != nil && err != io.ErrUnexpectedEOF {
+			return uploaded, fmt.Errorf("reading data: %s", err)
+		}
+		if _, err := d.UploadChunk(ctx, sessionID, index, buffer[:bytesRead]); err != nil {
+			return uploaded, err
+		}
+		uploaded++
+		if bytesRead < len(buffer) {
+			return uploaded, nil
+		}
+	}
+}
+
+func isRetriable(err error) bool {
+	st, ok := status.FromError(err)
+	if !ok {
+		return false
+	}
+	switch st.Code() {
+	case codes.Unavailable, codes.DeadlineExceeded, codes.Aborted, codes.ResourceExhausted:
+		return true
+	default:
+		return false
+	}
+}
+
 // StageFolder allows to temporarily stage a folder in hot storage in preparation for pushing a cid storage config.
 func (d *Data) StageFolder(ctx context.Context, ipfsRevProxyAddr string, folderPath string) (string, error) {
 	ffsToken := ctx.Value(AuthKey).(string)
@@ -119,10 +285,13 @@ func (d *Data) ReplaceData(ctx context.Context, cid1, cid2 string) (*userPb.Repl
 }
 
 // Get returns an io.Reader for reading a stored Cid from hot storage.
-func (d *Data) Get(ctx context.Context, cid string) (io.Reader, error) {
-	stream, err := d.client.Get(ctx, &userPb.GetRequest{
-		Cid: cid,
-	})
+// Options allow reading a path in the cid, or only a byte range.
+func (d *Data) Get(ctx context.Context, cid string, opts ...GetOption) (io.Reader, error) {
+	r := &userPb.GetRequest{Cid: cid}
+	for _, opt := range opts {
+		opt(r)
+	}
+	stream, err := d.client.Get(ctx, r)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,110 @@
+package client
+
+import (
+	"context"
+	"io"
+
+	userPb "github.com/textileio/powergate/v2/api/gen/powergate/user/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Retrievals provides access to Powergate Filecoin retrieval APIs.
+type Retrievals struct {
+	client userPb.UserServiceClient
+}
+
+// StartRetrievalOption mutates a start retrieval request.
+type StartRetrievalOption func(r *userPb.StartRetrievalRequest)
+
+// WithRetrievalSelector sets the selector to use in the retrieval.
+// Selectors aren't supported yet, so retrievals using one are rejected.
+func WithRetrievalSelector(selector string) StartRetrievalOption {
+	return func(r *userPb.StartRetrievalRequest) {
+		r.Selector = selector
+	}
+}
+
+// WithRetrievalMiners sets the miners to try retrieving the data from.
+func WithRetrievalMiners(miners []string) StartRetrievalOption {
+	return func(r *userPb.StartRetrievalRequest) {
+		r.Miners = miners
+	}
+}
+
+// WithRetrievalWalletAddress sets the wallet address paying for the retrieval.
+// If not set, the wallet address of the default storage config is used.
+func WithRetrievalWalletAddress(addr string) StartRetrievalOption {
+	return func(r *userPb.StartRetrievalRequest) {
+		r.WalletAddress = addr
+	}
+}
+
+// WithRetrievalMaxPrice sets the maximum price to pay for the retrieval.
+func WithRetrievalMaxPrice(maxPrice uint64) StartRetrievalOption {
+	return func(r *userPb.StartRetrievalRequest) {
+		r.MaxPrice = maxPrice
+	}
+}
+
+// WatchRetrievalJobsEvent represents an event for Watching a retrieval job.
+type WatchRetrievalJobsEvent struct {
+	Res *userPb.WatchRetrievalJobsResponse
+	Err error
+}
+
+// Start schedules a job to retrieve the data identified by payloadCid and
+// pieceCid from the Filecoin network.
+func (r *Retrievals) Start(ctx context.Context, payloadCid, pieceCid string, opts ...StartRetrievalOption) (*userPb.StartRetrievalResponse, error) {
+	req := &userPb.StartRetrievalRequest{
+		PayloadCid: payloadCid,
+		PieceCid:   pieceCid,
+	}
+	for _, opt := range opts {
+		opt(req)
+	}
+	return r.client.StartRetrieval(ctx, req)
+}
+
+// Get returns information about a retrieval.
+func (r *Retrievals) Get(ctx context.Context, id string) (*userPb.GetRetrievalResponse, error) {
+	return r.client.GetRetrieval(ctx, &userPb.GetRetrievalRequest{Id: id})
+}
+
+// Watch pushes retrieval job events to the provided channel. The provided channel will be owned
+// by the client after the call, so it shouldn't be closed by the client. To stop receiving
+// events, the provided ctx should be canceled. If an error occurs, it will be returned
+// in the Err field of WatchRetrievalJobsEvent and the channel will be closed.
+func (r *Retrievals) Watch(ctx context.Context, ch chan<- WatchRetrievalJobsEvent, jobIDs ...string) error {
+	stream, err := r.client.WatchRetrievalJobs(ctx, &userPb.WatchRetrievalJobsRequest{JobIds: jobIDs})
+	if err != nil {
+		return err
+	}
+	go func() {
+		for {
+			res, err := stream.Recv()
+			if err == io.EOF || status.Code(err) == codes.Canceled {
+				close(ch)
+				break
+			}
+			if err != nil {
+				ch <- WatchRetrievalJobsEvent{Err: err}
+				close(ch)
+				break
+			}
+			ch <- WatchRetrievalJobsEvent{Res: res}
+		}
+	}()
+	return nil
+}
+
+// Cancel signals that the queued or executing retrieval job with id jobID
+// should be canceled.
+func (r *Retrievals) Cancel(ctx context.Context, jobID string) (*userPb.CancelRetrievalJobResponse, error) {
+	return r.client.CancelRetrievalJob(ctx, &userPb.CancelRetrievalJobRequest{JobId: jobID})
+}
+
+// Remove removes a finished retrieval and unpins its retrieved data.
+func (r *Retrievals) Remove(ctx context.Context, id string) (*userPb.RemoveRetrievalResponse, error) {
+	return r.client.RemoveRetrieval(ctx, &userPb.RemoveRetrievalRequest{Id: id})
+}
@@ -2,8 +2,10 @@ package client
 
 import (
 	"context"
+	"time"
 
 	userPb "github.com/textileio/powergate/v2/api/gen/powergate/user/v1"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 // StorageConfig provides access to Powergate storage config APIs.
@@ -45,6 +47,22 @@ func WithNoExec(noExec bool) ApplyOption {
 	}
 }
 
+// WithPriority sets the priority of the Job created to ensure the new storage configuration.
+// The urgent priority is reserved for the scheduler and can't be used.
+func WithPriority(priority userPb.JobPriority) ApplyOption {
+	return func(r *userPb.ApplyStorageConfigRequest) {
+		r.Priority = priority
+	}
+}
+
+// WithNotBefore defers the execution of the Job created to ensure the new
+// storage configuration until the provided time.
+func WithNotBefore(notBefore time.Time) ApplyOption {
+	return func(r *userPb.ApplyStorageConfigRequest) {
+		r.NotBefore = timestamppb.New(notBefore)
+	}
+}
+
 // Default returns the default storage config.
 func (s *StorageConfig) Default(ctx context.Context) (*userPb.DefaultStorageConfigResponse, error) {
 	return s.client.DefaultStorageConfig(ctx, &userPb.DefaultStorageConfigRequest{})
@@ -67,6 +85,17 @@ func (s *StorageConfig) Apply(ctx context.Context, cid string, opts ...ApplyOpti
 	return s.client.ApplyStorageConfig(ctx, req)
 }
 
+// Plan returns the actions that applying a configuration for the Cid would take,
+// without applying it. If config is nil, the default storage config is used.
+func (s *StorageConfig) Plan(ctx context.Context, cid string, config *userPb.StorageConfig) (*userPb.PlanStorageConfigResponse, error) {
+	req := &userPb.PlanStorageConfigRequest{Cid: cid}
+	if config != nil {
+		req.HasConfig = true
+		req.Config = config
+	}
+	return s.client.PlanStorageConfig(ctx, req)
+}
+
 // Remove removes a Cid from being tracked as an active storage. The Cid should have
 // both Hot and Cold storage disabled, if that isn't the case it will return ErrActiveInStorage.
 func (s *StorageConfig) Remove(ctx context.Context, cid string) (*userPb.RemoveResponse, error) {
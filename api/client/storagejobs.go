@@ -26,6 +26,8 @@ const (
 	Executing
 	// Final lists final StorageJobs.
 	Final
+	// Scheduled lists scheduled StorageJobs.
+	Scheduled
 )
 
 // ListConfig controls the behavior for listing StorageJobs.
@@ -84,6 +86,8 @@ func (j *StorageJobs) List(ctx context.Context, config ListConfig) (*userPb.List
 		sel = userPb.StorageJobsSelector_STORAGE_JOBS_SELECTOR_EXECUTING
 	case Final:
 		sel = userPb.StorageJobsSelector_STORAGE_JOBS_SELECTOR_FINAL
+	case Scheduled:
+		sel = userPb.StorageJobsSelector_STORAGE_JOBS_SELECTOR_SCHEDULED
 	}
 	req := &userPb.ListStorageJobsRequest{
 		CidFilter:     config.CidFilter,
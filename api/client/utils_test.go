@@ -55,6 +55,7 @@ func defaultServerConfig(t *testing.T) server.Config {
 		FFSMaxParallelDealPreparing:   1,
 		FFSGCAutomaticGCInterval:      0,
 		FFSRetrievalNextEventTimeout:  time.Hour,
+		FFSUploadSessionTTL:           time.Hour,
 		DealWatchPollDuration:         time.Second * 15,
 		SchedMaxParallel:              10,
 		AskIndexQueryAskTimeout:       time.Second * 3,
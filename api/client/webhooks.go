@@ -0,0 +1,29 @@
+package client
+
+import (
+	"context"
+
+	userPb "github.com/textileio/powergate/v2/api/gen/powergate/user/v1"
+)
+
+// Webhooks provides access to Powergate webhooks APIs.
+type Webhooks struct {
+	client userPb.UserServiceClient
+}
+
+// Register registers a webhook which receives notifications of the provided events
+// at url. If no events are provided, it receives notifications of all events.
+// The returned webhook contains the secret used to sign notifications.
+func (w *Webhooks) Register(ctx context.Context, url string, events ...userPb.WebhookEvent) (*userPb.RegisterWebhookResponse, error) {
+	return w.client.RegisterWebhook(ctx, &userPb.RegisterWebhookRequest{Url: url, Events: events})
+}
+
+// List returns the registered webhooks.
+func (w *Webhooks) List(ctx context.Context) (*userPb.WebhooksResponse, error) {
+	return w.client.Webhooks(ctx, &userPb.WebhooksRequest{})
+}
+
+// Remove removes a registered webhook.
+func (w *Webhooks) Remove(ctx context.Context, id string) (*userPb.RemoveWebhookResponse, error) {
+	return w.client.RemoveWebhook(ctx, &userPb.RemoveWebhookRequest{Id: id})
+}
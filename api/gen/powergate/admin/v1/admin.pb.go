@@ -1053,6 +1053,7 @@ type StorageJobsSummaryResponse struct {
 	QueuedStorageJobs    []string `protobuf:"bytes,1,rep,name=queued_storage_jobs,json=queuedStorageJobs,proto3" json:"queued_storage_jobs,omitempty"`
 	ExecutingStorageJobs []string `protobuf:"bytes,2,rep,name=executing_storage_jobs,json=executingStorageJobs,proto3" json:"executing_storage_jobs,omitempty"`
 	FinalStorageJobs     []string `protobuf:"bytes,3,rep,name=final_storage_jobs,json=finalStorageJobs,proto3" json:"final_storage_jobs,omitempty"`
+	ScheduledStorageJobs []string `protobuf:"bytes,4,rep,name=scheduled_storage_jobs,json=scheduledStorageJobs,proto3" json:"scheduled_storage_jobs,omitempty"`
 }
 
 func (x *StorageJobsSummaryResponse) Reset() {
@@ -1108,6 +1109,922 @@ func (x *StorageJobsSummaryResponse) GetFinalStorageJobs() []string {
 	return nil
 }
 
+func (x *StorageJobsSummaryResponse) GetScheduledStorageJobs() []string {
+	if x != nil {
+		return x.ScheduledStorageJobs
+	}
+	return nil
+}
+
+type SetUserFairShareRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId           string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Weight           int64  `protobuf:"varint,2,opt,name=weight,proto3" json:"weight,omitempty"`
+	MaxExecutingJobs int64  `protobuf:"varint,3,opt,name=max_executing_jobs,json=maxExecutingJobs,proto3" json:"max_executing_jobs,omitempty"`
+}
+
+func (x *SetUserFairShareRequest) Reset() {
+	*x = SetUserFairShareRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_powergate_admin_v1_admin_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetUserFairShareRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetUserFairShareRequest) ProtoMessage() {}
+
+func (x *SetUserFairShareRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_powergate_admin_v1_admin_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetUserFairShareRequest.ProtoReflect.Descriptor instead.
+func (*SetUserFairShareRequest) Descriptor() ([]byte, []int) {
+	return file_powergate_admin_v1_admin_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *SetUserFairShareRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *SetUserFairShareRequest) GetWeight() int64 {
+	if x != nil {
+		return x.Weight
+	}
+	return 0
+}
+
+func (x *SetUserFairShareRequest) GetMaxExecutingJobs() int64 {
+	if x != nil {
+		return x.MaxExecutingJobs
+	}
+	return 0
+}
+
+type SetUserFairShareResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *SetUserFairShareResponse) Reset() {
+	*x = SetUserFairShareResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_powergate_admin_v1_admin_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetUserFairShareResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetUserFairShareResponse) ProtoMessage() {}
+
+func (x *SetUserFairShareResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_powergate_admin_v1_admin_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetUserFairShareResponse.ProtoReflect.Descriptor instead.
+func (*SetUserFairShareResponse) Descriptor() ([]byte, []int) {
+	return file_powergate_admin_v1_admin_proto_rawDescGZIP(), []int{22}
+}
+
+type RemoveUserFairShareRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+}
+
+func (x *RemoveUserFairShareRequest) Reset() {
+	*x = RemoveUserFairShareRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_powergate_admin_v1_admin_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RemoveUserFairShareRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveUserFairShareRequest) ProtoMessage() {}
+
+func (x *RemoveUserFairShareRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_powergate_admin_v1_admin_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveUserFairShareRequest.ProtoReflect.Descriptor instead.
+func (*RemoveUserFairShareRequest) Descriptor() ([]byte, []int) {
+	return file_powergate_admin_v1_admin_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *RemoveUserFairShareRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type RemoveUserFairShareResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *RemoveUserFairShareResponse) Reset() {
+	*x = RemoveUserFairShareResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_powergate_admin_v1_admin_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RemoveUserFairShareResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveUserFairShareResponse) ProtoMessage() {}
+
+func (x *RemoveUserFairShareResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_powergate_admin_v1_admin_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveUserFairShareResponse.ProtoReflect.Descriptor instead.
+func (*RemoveUserFairShareResponse) Descriptor() ([]byte, []int) {
+	return file_powergate_admin_v1_admin_proto_rawDescGZIP(), []int{24}
+}
+
+type UserFairSharesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *UserFairSharesRequest) Reset() {
+	*x = UserFairSharesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_powergate_admin_v1_admin_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UserFairSharesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserFairSharesRequest) ProtoMessage() {}
+
+func (x *UserFairSharesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_powergate_admin_v1_admin_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserFairSharesRequest.ProtoReflect.Descriptor instead.
+func (*UserFairSharesRequest) Descriptor() ([]byte, []int) {
+	return file_powergate_admin_v1_admin_proto_rawDescGZIP(), []int{25}
+}
+
+type UserFairSharesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	FairShares []*UserFairShare `protobuf:"bytes,1,rep,name=fair_shares,json=fairShares,proto3" json:"fair_shares,omitempty"`
+}
+
+func (x *UserFairSharesResponse) Reset() {
+	*x = UserFairSharesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_powergate_admin_v1_admin_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UserFairSharesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserFairSharesResponse) ProtoMessage() {}
+
+func (x *UserFairSharesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_powergate_admin_v1_admin_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserFairSharesResponse.ProtoReflect.Descriptor instead.
+func (*UserFairSharesResponse) Descriptor() ([]byte, []int) {
+	return file_powergate_admin_v1_admin_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *UserFairSharesResponse) GetFairShares() []*UserFairShare {
+	if x != nil {
+		return x.FairShares
+	}
+	return nil
+}
+
+type UserFairShare struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId           string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Weight           int64  `protobuf:"varint,2,opt,name=weight,proto3" json:"weight,omitempty"`
+	MaxExecutingJobs int64  `protobuf:"varint,3,opt,name=max_executing_jobs,json=maxExecutingJobs,proto3" json:"max_executing_jobs,omitempty"`
+}
+
+func (x *UserFairShare) Reset() {
+	*x = UserFairShare{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_powergate_admin_v1_admin_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UserFairShare) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserFairShare) ProtoMessage() {}
+
+func (x *UserFairShare) ProtoReflect() protoreflect.Message {
+	mi := &file_powergate_admin_v1_admin_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserFairShare.ProtoReflect.Descriptor instead.
+func (*UserFairShare) Descriptor() ([]byte, []int) {
+	return file_powergate_admin_v1_admin_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *UserFairShare) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *UserFairShare) GetWeight() int64 {
+	if x != nil {
+		return x.Weight
+	}
+	return 0
+}
+
+func (x *UserFairShare) GetMaxExecutingJobs() int64 {
+	if x != nil {
+		return x.MaxExecutingJobs
+	}
+	return 0
+}
+
+type SetUserBudgetRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId string     `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Budget *v1.Budget `protobuf:"bytes,2,opt,name=budget,proto3" json:"budget,omitempty"`
+}
+
+func (x *SetUserBudgetRequest) Reset() {
+	*x = SetUserBudgetRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_powergate_admin_v1_admin_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetUserBudgetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetUserBudgetRequest) ProtoMessage() {}
+
+func (x *SetUserBudgetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_powergate_admin_v1_admin_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetUserBudgetRequest.ProtoReflect.Descriptor instead.
+func (*SetUserBudgetRequest) Descriptor() ([]byte, []int) {
+	return file_powergate_admin_v1_admin_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *SetUserBudgetRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *SetUserBudgetRequest) GetBudget() *v1.Budget {
+	if x != nil {
+		return x.Budget
+	}
+	return nil
+}
+
+type SetUserBudgetResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *SetUserBudgetResponse) Reset() {
+	*x = SetUserBudgetResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_powergate_admin_v1_admin_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetUserBudgetResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetUserBudgetResponse) ProtoMessage() {}
+
+func (x *SetUserBudgetResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_powergate_admin_v1_admin_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetUserBudgetResponse.ProtoReflect.Descriptor instead.
+func (*SetUserBudgetResponse) Descriptor() ([]byte, []int) {
+	return file_powergate_admin_v1_admin_proto_rawDescGZIP(), []int{29}
+}
+
+type UserBudgetRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Cid    string `protobuf:"bytes,2,opt,name=cid,proto3" json:"cid,omitempty"`
+}
+
+func (x *UserBudgetRequest) Reset() {
+	*x = UserBudgetRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_powergate_admin_v1_admin_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UserBudgetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserBudgetRequest) ProtoMessage() {}
+
+func (x *UserBudgetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_powergate_admin_v1_admin_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserBudgetRequest.ProtoReflect.Descriptor instead.
+func (*UserBudgetRequest) Descriptor() ([]byte, []int) {
+	return file_powergate_admin_v1_admin_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *UserBudgetRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *UserBudgetRequest) GetCid() string {
+	if x != nil {
+		return x.Cid
+	}
+	return ""
+}
+
+type UserBudgetResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status *v1.BudgetStatus `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+}
+
+func (x *UserBudgetResponse) Reset() {
+	*x = UserBudgetResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_powergate_admin_v1_admin_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UserBudgetResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserBudgetResponse) ProtoMessage() {}
+
+func (x *UserBudgetResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_powergate_admin_v1_admin_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserBudgetResponse.ProtoReflect.Descriptor instead.
+func (*UserBudgetResponse) Descriptor() ([]byte, []int) {
+	return file_powergate_admin_v1_admin_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *UserBudgetResponse) GetStatus() *v1.BudgetStatus {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+type PruneStorageJobsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MaxAgeSeconds int64 `protobuf:"varint,1,opt,name=max_age_seconds,json=maxAgeSeconds,proto3" json:"max_age_seconds,omitempty"`
+	MaxPerCid     int64 `protobuf:"varint,2,opt,name=max_per_cid,json=maxPerCid,proto3" json:"max_per_cid,omitempty"`
+}
+
+func (x *PruneStorageJobsRequest) Reset() {
+	*x = PruneStorageJobsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_powergate_admin_v1_admin_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PruneStorageJobsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PruneStorageJobsRequest) ProtoMessage() {}
+
+func (x *PruneStorageJobsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_powergate_admin_v1_admin_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PruneStorageJobsRequest.ProtoReflect.Descriptor instead.
+func (*PruneStorageJobsRequest) Descriptor() ([]byte, []int) {
+	return file_powergate_admin_v1_admin_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *PruneStorageJobsRequest) GetMaxAgeSeconds() int64 {
+	if x != nil {
+		return x.MaxAgeSeconds
+	}
+	return 0
+}
+
+func (x *PruneStorageJobsRequest) GetMaxPerCid() int64 {
+	if x != nil {
+		return x.MaxPerCid
+	}
+	return 0
+}
+
+type PruneStorageJobsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PrunedJobIds      []string `protobuf:"bytes,1,rep,name=pruned_job_ids,json=prunedJobIds,proto3" json:"pruned_job_ids,omitempty"`
+	RemovedLogEntries int64    `protobuf:"varint,2,opt,name=removed_log_entries,json=removedLogEntries,proto3" json:"removed_log_entries,omitempty"`
+}
+
+func (x *PruneStorageJobsResponse) Reset() {
+	*x = PruneStorageJobsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_powergate_admin_v1_admin_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PruneStorageJobsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PruneStorageJobsResponse) ProtoMessage() {}
+
+func (x *PruneStorageJobsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_powergate_admin_v1_admin_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PruneStorageJobsResponse.ProtoReflect.Descriptor instead.
+func (*PruneStorageJobsResponse) Descriptor() ([]byte, []int) {
+	return file_powergate_admin_v1_admin_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *PruneStorageJobsResponse) GetPrunedJobIds() []string {
+	if x != nil {
+		return x.PrunedJobIds
+	}
+	return nil
+}
+
+func (x *PruneStorageJobsResponse) GetRemovedLogEntries() int64 {
+	if x != nil {
+		return x.RemovedLogEntries
+	}
+	return 0
+}
+
+type DrainRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TimeoutSeconds int64 `protobuf:"varint,1,opt,name=timeout_seconds,json=timeoutSeconds,proto3" json:"timeout_seconds,omitempty"`
+}
+
+func (x *DrainRequest) Reset() {
+	*x = DrainRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_powergate_admin_v1_admin_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DrainRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DrainRequest) ProtoMessage() {}
+
+func (x *DrainRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_powergate_admin_v1_admin_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DrainRequest.ProtoReflect.Descriptor instead.
+func (*DrainRequest) Descriptor() ([]byte, []int) {
+	return file_powergate_admin_v1_admin_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *DrainRequest) GetTimeoutSeconds() int64 {
+	if x != nil {
+		return x.TimeoutSeconds
+	}
+	return 0
+}
+
+type DrainResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *DrainResponse) Reset() {
+	*x = DrainResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_powergate_admin_v1_admin_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DrainResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DrainResponse) ProtoMessage() {}
+
+func (x *DrainResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_powergate_admin_v1_admin_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DrainResponse.ProtoReflect.Descriptor instead.
+func (*DrainResponse) Descriptor() ([]byte, []int) {
+	return file_powergate_admin_v1_admin_proto_rawDescGZIP(), []int{35}
+}
+
+type UndrainRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *UndrainRequest) Reset() {
+	*x = UndrainRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_powergate_admin_v1_admin_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UndrainRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UndrainRequest) ProtoMessage() {}
+
+func (x *UndrainRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_powergate_admin_v1_admin_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UndrainRequest.ProtoReflect.Descriptor instead.
+func (*UndrainRequest) Descriptor() ([]byte, []int) {
+	return file_powergate_admin_v1_admin_proto_rawDescGZIP(), []int{36}
+}
+
+type UndrainResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *UndrainResponse) Reset() {
+	*x = UndrainResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_powergate_admin_v1_admin_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UndrainResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UndrainResponse) ProtoMessage() {}
+
+func (x *UndrainResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_powergate_admin_v1_admin_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UndrainResponse.ProtoReflect.Descriptor instead.
+func (*UndrainResponse) Descriptor() ([]byte, []int) {
+	return file_powergate_admin_v1_admin_proto_rawDescGZIP(), []int{37}
+}
+
+type DrainStatusRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *DrainStatusRequest) Reset() {
+	*x = DrainStatusRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_powergate_admin_v1_admin_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DrainStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DrainStatusRequest) ProtoMessage() {}
+
+func (x *DrainStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_powergate_admin_v1_admin_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DrainStatusRequest.ProtoReflect.Descriptor instead.
+func (*DrainStatusRequest) Descriptor() ([]byte, []int) {
+	return file_powergate_admin_v1_admin_proto_rawDescGZIP(), []int{38}
+}
+
+type DrainStatusResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Draining               bool                 `protobuf:"varint,1,opt,name=draining,proto3" json:"draining,omitempty"`
+	Deadline               *timestamp.Timestamp `protobuf:"bytes,2,opt,name=deadline,proto3" json:"deadline,omitempty"`
+	ExecutingStorageJobs   int64                `protobuf:"varint,3,opt,name=executing_storage_jobs,json=executingStorageJobs,proto3" json:"executing_storage_jobs,omitempty"`
+	ExecutingRetrievalJobs int64                `protobuf:"varint,4,opt,name=executing_retrieval_jobs,json=executingRetrievalJobs,proto3" json:"executing_retrieval_jobs,omitempty"`
+}
+
+func (x *DrainStatusResponse) Reset() {
+	*x = DrainStatusResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_powergate_admin_v1_admin_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DrainStatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DrainStatusResponse) ProtoMessage() {}
+
+func (x *DrainStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_powergate_admin_v1_admin_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DrainStatusResponse.ProtoReflect.Descriptor instead.
+func (*DrainStatusResponse) Descriptor() ([]byte, []int) {
+	return file_powergate_admin_v1_admin_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *DrainStatusResponse) GetDraining() bool {
+	if x != nil {
+		return x.Draining
+	}
+	return false
+}
+
+func (x *DrainStatusResponse) GetDeadline() *timestamp.Timestamp {
+	if x != nil {
+		return x.Deadline
+	}
+	return nil
+}
+
+func (x *DrainStatusResponse) GetExecutingStorageJobs() int64 {
+	if x != nil {
+		return x.ExecutingStorageJobs
+	}
+	return 0
+}
+
+func (x *DrainStatusResponse) GetExecutingRetrievalJobs() int64 {
+	if x != nil {
+		return x.ExecutingRetrievalJobs
+	}
+	return 0
+}
+
 type GCStagedRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1117,7 +2034,7 @@ type GCStagedRequest struct {
 func (x *GCStagedRequest) Reset() {
 	*x = GCStagedRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_powergate_admin_v1_admin_proto_msgTypes[21]
+		mi := &file_powergate_admin_v1_admin_proto_msgTypes[40]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1130,7 +2047,7 @@ func (x *GCStagedRequest) String() string {
 func (*GCStagedRequest) ProtoMessage() {}
 
 func (x *GCStagedRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_powergate_admin_v1_admin_proto_msgTypes[21]
+	mi := &file_powergate_admin_v1_admin_proto_msgTypes[40]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1143,7 +2060,7 @@ func (x *GCStagedRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GCStagedRequest.ProtoReflect.Descriptor instead.
 func (*GCStagedRequest) Descriptor() ([]byte, []int) {
-	return file_powergate_admin_v1_admin_proto_rawDescGZIP(), []int{21}
+	return file_powergate_admin_v1_admin_proto_rawDescGZIP(), []int{40}
 }
 
 type GCStagedResponse struct {
@@ -1157,7 +2074,7 @@ type GCStagedResponse struct {
 func (x *GCStagedResponse) Reset() {
 	*x = GCStagedResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_powergate_admin_v1_admin_proto_msgTypes[22]
+		mi := &file_powergate_admin_v1_admin_proto_msgTypes[41]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1170,7 +2087,7 @@ func (x *GCStagedResponse) String() string {
 func (*GCStagedResponse) ProtoMessage() {}
 
 func (x *GCStagedResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_powergate_admin_v1_admin_proto_msgTypes[22]
+	mi := &file_powergate_admin_v1_admin_proto_msgTypes[41]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1183,7 +2100,7 @@ func (x *GCStagedResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GCStagedResponse.ProtoReflect.Descriptor instead.
 func (*GCStagedResponse) Descriptor() ([]byte, []int) {
-	return file_powergate_admin_v1_admin_proto_rawDescGZIP(), []int{22}
+	return file_powergate_admin_v1_admin_proto_rawDescGZIP(), []int{41}
 }
 
 func (x *GCStagedResponse) GetUnpinnedCids() []string {
@@ -1202,7 +2119,7 @@ type PinnedCidsRequest struct {
 func (x *PinnedCidsRequest) Reset() {
 	*x = PinnedCidsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_powergate_admin_v1_admin_proto_msgTypes[23]
+		mi := &file_powergate_admin_v1_admin_proto_msgTypes[42]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1215,7 +2132,7 @@ func (x *PinnedCidsRequest) String() string {
 func (*PinnedCidsRequest) ProtoMessage() {}
 
 func (x *PinnedCidsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_powergate_admin_v1_admin_proto_msgTypes[23]
+	mi := &file_powergate_admin_v1_admin_proto_msgTypes[42]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1228,7 +2145,7 @@ func (x *PinnedCidsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PinnedCidsRequest.ProtoReflect.Descriptor instead.
 func (*PinnedCidsRequest) Descriptor() ([]byte, []int) {
-	return file_powergate_admin_v1_admin_proto_rawDescGZIP(), []int{23}
+	return file_powergate_admin_v1_admin_proto_rawDescGZIP(), []int{42}
 }
 
 type PinnedCidsResponse struct {
@@ -1242,7 +2159,7 @@ type PinnedCidsResponse struct {
 func (x *PinnedCidsResponse) Reset() {
 	*x = PinnedCidsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_powergate_admin_v1_admin_proto_msgTypes[24]
+		mi := &file_powergate_admin_v1_admin_proto_msgTypes[43]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1255,7 +2172,7 @@ func (x *PinnedCidsResponse) String() string {
 func (*PinnedCidsResponse) ProtoMessage() {}
 
 func (x *PinnedCidsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_powergate_admin_v1_admin_proto_msgTypes[24]
+	mi := &file_powergate_admin_v1_admin_proto_msgTypes[43]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1268,7 +2185,7 @@ func (x *PinnedCidsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PinnedCidsResponse.ProtoReflect.Descriptor instead.
 func (*PinnedCidsResponse) Descriptor() ([]byte, []int) {
-	return file_powergate_admin_v1_admin_proto_rawDescGZIP(), []int{24}
+	return file_powergate_admin_v1_admin_proto_rawDescGZIP(), []int{43}
 }
 
 func (x *PinnedCidsResponse) GetCids() []*HSPinnedCid {
@@ -1290,7 +2207,7 @@ type HSPinnedCid struct {
 func (x *HSPinnedCid) Reset() {
 	*x = HSPinnedCid{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_powergate_admin_v1_admin_proto_msgTypes[25]
+		mi := &file_powergate_admin_v1_admin_proto_msgTypes[44]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1303,7 +2220,7 @@ func (x *HSPinnedCid) String() string {
 func (*HSPinnedCid) ProtoMessage() {}
 
 func (x *HSPinnedCid) ProtoReflect() protoreflect.Message {
-	mi := &file_powergate_admin_v1_admin_proto_msgTypes[25]
+	mi := &file_powergate_admin_v1_admin_proto_msgTypes[44]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1316,7 +2233,7 @@ func (x *HSPinnedCid) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use HSPinnedCid.ProtoReflect.Descriptor instead.
 func (*HSPinnedCid) Descriptor() ([]byte, []int) {
-	return file_powergate_admin_v1_admin_proto_rawDescGZIP(), []int{25}
+	return file_powergate_admin_v1_admin_proto_rawDescGZIP(), []int{44}
 }
 
 func (x *HSPinnedCid) GetCid() string {
@@ -1341,12 +2258,13 @@ type HSPinnedCidUser struct {
 	UserId    string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
 	Staged    bool   `protobuf:"varint,2,opt,name=staged,proto3" json:"staged,omitempty"`
 	CreatedAt int64  `protobuf:"varint,3,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	ExpiresAt int64  `protobuf:"varint,4,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
 }
 
 func (x *HSPinnedCidUser) Reset() {
 	*x = HSPinnedCidUser{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_powergate_admin_v1_admin_proto_msgTypes[26]
+		mi := &file_powergate_admin_v1_admin_proto_msgTypes[45]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1359,7 +2277,7 @@ func (x *HSPinnedCidUser) String() string {
 func (*HSPinnedCidUser) ProtoMessage() {}
 
 func (x *HSPinnedCidUser) ProtoReflect() protoreflect.Message {
-	mi := &file_powergate_admin_v1_admin_proto_msgTypes[26]
+	mi := &file_powergate_admin_v1_admin_proto_msgTypes[45]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1372,7 +2290,7 @@ func (x *HSPinnedCidUser) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use HSPinnedCidUser.ProtoReflect.Descriptor instead.
 func (*HSPinnedCidUser) Descriptor() ([]byte, []int) {
-	return file_powergate_admin_v1_admin_proto_rawDescGZIP(), []int{26}
+	return file_powergate_admin_v1_admin_proto_rawDescGZIP(), []int{45}
 }
 
 func (x *HSPinnedCidUser) GetUserId() string {
@@ -1396,6 +2314,13 @@ func (x *HSPinnedCidUser) GetCreatedAt() int64 {
 	return 0
 }
 
+func (x *HSPinnedCidUser) GetExpiresAt() int64 {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return 0
+}
+
 type GetUpdatedStorageDealRecordsSinceRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1408,7 +2333,7 @@ type GetUpdatedStorageDealRecordsSinceRequest struct {
 func (x *GetUpdatedStorageDealRecordsSinceRequest) Reset() {
 	*x = GetUpdatedStorageDealRecordsSinceRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_powergate_admin_v1_admin_proto_msgTypes[27]
+		mi := &file_powergate_admin_v1_admin_proto_msgTypes[46]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1421,7 +2346,7 @@ func (x *GetUpdatedStorageDealRecordsSinceRequest) String() string {
 func (*GetUpdatedStorageDealRecordsSinceRequest) ProtoMessage() {}
 
 func (x *GetUpdatedStorageDealRecordsSinceRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_powergate_admin_v1_admin_proto_msgTypes[27]
+	mi := &file_powergate_admin_v1_admin_proto_msgTypes[46]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1434,7 +2359,7 @@ func (x *GetUpdatedStorageDealRecordsSinceRequest) ProtoReflect() protoreflect.M
 
 // Deprecated: Use GetUpdatedStorageDealRecordsSinceRequest.ProtoReflect.Descriptor instead.
 func (*GetUpdatedStorageDealRecordsSinceRequest) Descriptor() ([]byte, []int) {
-	return file_powergate_admin_v1_admin_proto_rawDescGZIP(), []int{27}
+	return file_powergate_admin_v1_admin_proto_rawDescGZIP(), []int{46}
 }
 
 func (x *GetUpdatedStorageDealRecordsSinceRequest) GetSince() *timestamp.Timestamp {
@@ -1462,7 +2387,7 @@ type GetUpdatedStorageDealRecordsSinceResponse struct {
 func (x *GetUpdatedStorageDealRecordsSinceResponse) Reset() {
 	*x = GetUpdatedStorageDealRecordsSinceResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_powergate_admin_v1_admin_proto_msgTypes[28]
+		mi := &file_powergate_admin_v1_admin_proto_msgTypes[47]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1475,7 +2400,7 @@ func (x *GetUpdatedStorageDealRecordsSinceResponse) String() string {
 func (*GetUpdatedStorageDealRecordsSinceResponse) ProtoMessage() {}
 
 func (x *GetUpdatedStorageDealRecordsSinceResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_powergate_admin_v1_admin_proto_msgTypes[28]
+	mi := &file_powergate_admin_v1_admin_proto_msgTypes[47]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1488,7 +2413,7 @@ func (x *GetUpdatedStorageDealRecordsSinceResponse) ProtoReflect() protoreflect.
 
 // Deprecated: Use GetUpdatedStorageDealRecordsSinceResponse.ProtoReflect.Descriptor instead.
 func (*GetUpdatedStorageDealRecordsSinceResponse) Descriptor() ([]byte, []int) {
-	return file_powergate_admin_v1_admin_proto_rawDescGZIP(), []int{28}
+	return file_powergate_admin_v1_admin_proto_rawDescGZIP(), []int{47}
 }
 
 func (x *GetUpdatedStorageDealRecordsSinceResponse) GetRecords() []*v1.StorageDealRecord {
@@ -1510,7 +2435,7 @@ type GetUpdatedRetrievalRecordsSinceRequest struct {
 func (x *GetUpdatedRetrievalRecordsSinceRequest) Reset() {
 	*x = GetUpdatedRetrievalRecordsSinceRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_powergate_admin_v1_admin_proto_msgTypes[29]
+		mi := &file_powergate_admin_v1_admin_proto_msgTypes[48]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1523,7 +2448,7 @@ func (x *GetUpdatedRetrievalRecordsSinceRequest) String() string {
 func (*GetUpdatedRetrievalRecordsSinceRequest) ProtoMessage() {}
 
 func (x *GetUpdatedRetrievalRecordsSinceRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_powergate_admin_v1_admin_proto_msgTypes[29]
+	mi := &file_powergate_admin_v1_admin_proto_msgTypes[48]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1536,7 +2461,7 @@ func (x *GetUpdatedRetrievalRecordsSinceRequest) ProtoReflect() protoreflect.Mes
 
 // Deprecated: Use GetUpdatedRetrievalRecordsSinceRequest.ProtoReflect.Descriptor instead.
 func (*GetUpdatedRetrievalRecordsSinceRequest) Descriptor() ([]byte, []int) {
-	return file_powergate_admin_v1_admin_proto_rawDescGZIP(), []int{29}
+	return file_powergate_admin_v1_admin_proto_rawDescGZIP(), []int{48}
 }
 
 func (x *GetUpdatedRetrievalRecordsSinceRequest) GetSince() *timestamp.Timestamp {
@@ -1564,7 +2489,7 @@ type GetUpdatedRetrievalRecordsSinceResponse struct {
 func (x *GetUpdatedRetrievalRecordsSinceResponse) Reset() {
 	*x = GetUpdatedRetrievalRecordsSinceResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_powergate_admin_v1_admin_proto_msgTypes[30]
+		mi := &file_powergate_admin_v1_admin_proto_msgTypes[49]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1577,7 +2502,7 @@ func (x *GetUpdatedRetrievalRecordsSinceResponse) String() string {
 func (*GetUpdatedRetrievalRecordsSinceResponse) ProtoMessage() {}
 
 func (x *GetUpdatedRetrievalRecordsSinceResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_powergate_admin_v1_admin_proto_msgTypes[30]
+	mi := &file_powergate_admin_v1_admin_proto_msgTypes[49]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1590,7 +2515,7 @@ func (x *GetUpdatedRetrievalRecordsSinceResponse) ProtoReflect() protoreflect.Me
 
 // Deprecated: Use GetUpdatedRetrievalRecordsSinceResponse.ProtoReflect.Descriptor instead.
 func (*GetUpdatedRetrievalRecordsSinceResponse) Descriptor() ([]byte, []int) {
-	return file_powergate_admin_v1_admin_proto_rawDescGZIP(), []int{30}
+	return file_powergate_admin_v1_admin_proto_rawDescGZIP(), []int{49}
 }
 
 func (x *GetUpdatedRetrievalRecordsSinceResponse) GetRecords() []*v1.RetrievalDealRecord {
@@ -1600,6 +2525,203 @@ func (x *GetUpdatedRetrievalRecordsSinceResponse) GetRecords() []*v1.RetrievalDe
 	return nil
 }
 
+type RetrievalMinerRank struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Miner          string               `protobuf:"bytes,1,opt,name=miner,proto3" json:"miner,omitempty"`
+	Successes      int64                `protobuf:"varint,2,opt,name=successes,proto3" json:"successes,omitempty"`
+	Failures       int64                `protobuf:"varint,3,opt,name=failures,proto3" json:"failures,omitempty"`
+	SuccessRate    float64              `protobuf:"fixed64,4,opt,name=success_rate,json=successRate,proto3" json:"success_rate,omitempty"`
+	Throughput     float64              `protobuf:"fixed64,5,opt,name=throughput,proto3" json:"throughput,omitempty"`
+	LastFailure    *timestamp.Timestamp `protobuf:"bytes,6,opt,name=last_failure,json=lastFailure,proto3" json:"last_failure,omitempty"`
+	RecentlyFailed bool                 `protobuf:"varint,7,opt,name=recently_failed,json=recentlyFailed,proto3" json:"recently_failed,omitempty"`
+	Score          float64              `protobuf:"fixed64,8,opt,name=score,proto3" json:"score,omitempty"`
+}
+
+func (x *RetrievalMinerRank) Reset() {
+	*x = RetrievalMinerRank{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_powergate_admin_v1_admin_proto_msgTypes[50]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RetrievalMinerRank) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RetrievalMinerRank) ProtoMessage() {}
+
+func (x *RetrievalMinerRank) ProtoReflect() protoreflect.Message {
+	mi := &file_powergate_admin_v1_admin_proto_msgTypes[50]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RetrievalMinerRank.ProtoReflect.Descriptor instead.
+func (*RetrievalMinerRank) Descriptor() ([]byte, []int) {
+	return file_powergate_admin_v1_admin_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *RetrievalMinerRank) GetMiner() string {
+	if x != nil {
+		return x.Miner
+	}
+	return ""
+}
+
+func (x *RetrievalMinerRank) GetSuccesses() int64 {
+	if x != nil {
+		return x.Successes
+	}
+	return 0
+}
+
+func (x *RetrievalMinerRank) GetFailures() int64 {
+	if x != nil {
+		return x.Failures
+	}
+	return 0
+}
+
+func (x *RetrievalMinerRank) GetSuccessRate() float64 {
+	if x != nil {
+		return x.SuccessRate
+	}
+	return 0
+}
+
+func (x *RetrievalMinerRank) GetThroughput() float64 {
+	if x != nil {
+		return x.Throughput
+	}
+	return 0
+}
+
+func (x *RetrievalMinerRank) GetLastFailure() *timestamp.Timestamp {
+	if x != nil {
+		return x.LastFailure
+	}
+	return nil
+}
+
+func (x *RetrievalMinerRank) GetRecentlyFailed() bool {
+	if x != nil {
+		return x.RecentlyFailed
+	}
+	return false
+}
+
+func (x *RetrievalMinerRank) GetScore() float64 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+type RetrievalMinerRankingRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Miners []string `protobuf:"bytes,1,rep,name=miners,proto3" json:"miners,omitempty"`
+}
+
+func (x *RetrievalMinerRankingRequest) Reset() {
+	*x = RetrievalMinerRankingRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_powergate_admin_v1_admin_proto_msgTypes[51]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RetrievalMinerRankingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RetrievalMinerRankingRequest) ProtoMessage() {}
+
+func (x *RetrievalMinerRankingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_powergate_admin_v1_admin_proto_msgTypes[51]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RetrievalMinerRankingRequest.ProtoReflect.Descriptor instead.
+func (*RetrievalMinerRankingRequest) Descriptor() ([]byte, []int) {
+	return file_powergate_admin_v1_admin_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *RetrievalMinerRankingRequest) GetMiners() []string {
+	if x != nil {
+		return x.Miners
+	}
+	return nil
+}
+
+type RetrievalMinerRankingResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Ranking []*RetrievalMinerRank `protobuf:"bytes,1,rep,name=ranking,proto3" json:"ranking,omitempty"`
+}
+
+func (x *RetrievalMinerRankingResponse) Reset() {
+	*x = RetrievalMinerRankingResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_powergate_admin_v1_admin_proto_msgTypes[52]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RetrievalMinerRankingResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RetrievalMinerRankingResponse) ProtoMessage() {}
+
+func (x *RetrievalMinerRankingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_powergate_admin_v1_admin_proto_msgTypes[52]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RetrievalMinerRankingResponse.ProtoReflect.Descriptor instead.
+func (*RetrievalMinerRankingResponse) Descriptor() ([]byte, []int) {
+	return file_powergate_admin_v1_admin_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *RetrievalMinerRankingResponse) GetRanking() []*RetrievalMinerRank {
+	if x != nil {
+		return x.Ranking
+	}
+	return nil
+}
+
 type GetMinersRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1611,7 +2733,7 @@ type GetMinersRequest struct {
 func (x *GetMinersRequest) Reset() {
 	*x = GetMinersRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_powergate_admin_v1_admin_proto_msgTypes[31]
+		mi := &file_powergate_admin_v1_admin_proto_msgTypes[53]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1624,7 +2746,7 @@ func (x *GetMinersRequest) String() string {
 func (*GetMinersRequest) ProtoMessage() {}
 
 func (x *GetMinersRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_powergate_admin_v1_admin_proto_msgTypes[31]
+	mi := &file_powergate_admin_v1_admin_proto_msgTypes[53]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1637,7 +2759,7 @@ func (x *GetMinersRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetMinersRequest.ProtoReflect.Descriptor instead.
 func (*GetMinersRequest) Descriptor() ([]byte, []int) {
-	return file_powergate_admin_v1_admin_proto_rawDescGZIP(), []int{31}
+	return file_powergate_admin_v1_admin_proto_rawDescGZIP(), []int{53}
 }
 
 func (x *GetMinersRequest) GetWithPower() bool {
@@ -1658,7 +2780,7 @@ type GetMinersResponse struct {
 func (x *GetMinersResponse) Reset() {
 	*x = GetMinersResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_powergate_admin_v1_admin_proto_msgTypes[32]
+		mi := &file_powergate_admin_v1_admin_proto_msgTypes[54]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1671,7 +2793,7 @@ func (x *GetMinersResponse) String() string {
 func (*GetMinersResponse) ProtoMessage() {}
 
 func (x *GetMinersResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_powergate_admin_v1_admin_proto_msgTypes[32]
+	mi := &file_powergate_admin_v1_admin_proto_msgTypes[54]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1684,7 +2806,7 @@ func (x *GetMinersResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetMinersResponse.ProtoReflect.Descriptor instead.
 func (*GetMinersResponse) Descriptor() ([]byte, []int) {
-	return file_powergate_admin_v1_admin_proto_rawDescGZIP(), []int{32}
+	return file_powergate_admin_v1_admin_proto_rawDescGZIP(), []int{54}
 }
 
 func (x *GetMinersResponse) GetMiners() []*FilecoinMiner {
@@ -1705,7 +2827,7 @@ type FilecoinMiner struct {
 func (x *FilecoinMiner) Reset() {
 	*x = FilecoinMiner{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_powergate_admin_v1_admin_proto_msgTypes[33]
+		mi := &file_powergate_admin_v1_admin_proto_msgTypes[55]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1718,7 +2840,7 @@ func (x *FilecoinMiner) String() string {
 func (*FilecoinMiner) ProtoMessage() {}
 
 func (x *FilecoinMiner) ProtoReflect() protoreflect.Message {
-	mi := &file_powergate_admin_v1_admin_proto_msgTypes[33]
+	mi := &file_powergate_admin_v1_admin_proto_msgTypes[55]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1731,7 +2853,7 @@ func (x *FilecoinMiner) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FilecoinMiner.ProtoReflect.Descriptor instead.
 func (*FilecoinMiner) Descriptor() ([]byte, []int) {
-	return file_powergate_admin_v1_admin_proto_rawDescGZIP(), []int{33}
+	return file_powergate_admin_v1_admin_proto_rawDescGZIP(), []int{55}
 }
 
 func (x *FilecoinMiner) GetAddress() string {
@@ -1752,7 +2874,7 @@ type GetMinerInfoRequest struct {
 func (x *GetMinerInfoRequest) Reset() {
 	*x = GetMinerInfoRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_powergate_admin_v1_admin_proto_msgTypes[34]
+		mi := &file_powergate_admin_v1_admin_proto_msgTypes[56]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1765,7 +2887,7 @@ func (x *GetMinerInfoRequest) String() string {
 func (*GetMinerInfoRequest) ProtoMessage() {}
 
 func (x *GetMinerInfoRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_powergate_admin_v1_admin_proto_msgTypes[34]
+	mi := &file_powergate_admin_v1_admin_proto_msgTypes[56]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1778,7 +2900,7 @@ func (x *GetMinerInfoRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetMinerInfoRequest.ProtoReflect.Descriptor instead.
 func (*GetMinerInfoRequest) Descriptor() ([]byte, []int) {
-	return file_powergate_admin_v1_admin_proto_rawDescGZIP(), []int{34}
+	return file_powergate_admin_v1_admin_proto_rawDescGZIP(), []int{56}
 }
 
 func (x *GetMinerInfoRequest) GetMiners() []string {
@@ -1799,7 +2921,7 @@ type GetMinerInfoResponse struct {
 func (x *GetMinerInfoResponse) Reset() {
 	*x = GetMinerInfoResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_powergate_admin_v1_admin_proto_msgTypes[35]
+		mi := &file_powergate_admin_v1_admin_proto_msgTypes[57]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1812,7 +2934,7 @@ func (x *GetMinerInfoResponse) String() string {
 func (*GetMinerInfoResponse) ProtoMessage() {}
 
 func (x *GetMinerInfoResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_powergate_admin_v1_admin_proto_msgTypes[35]
+	mi := &file_powergate_admin_v1_admin_proto_msgTypes[57]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1825,7 +2947,7 @@ func (x *GetMinerInfoResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetMinerInfoResponse.ProtoReflect.Descriptor instead.
 func (*GetMinerInfoResponse) Descriptor() ([]byte, []int) {
-	return file_powergate_admin_v1_admin_proto_rawDescGZIP(), []int{35}
+	return file_powergate_admin_v1_admin_proto_rawDescGZIP(), []int{57}
 }
 
 func (x *GetMinerInfoResponse) GetMinersInfo() []*MinerInfo {
@@ -1856,7 +2978,7 @@ type MinerInfo struct {
 func (x *MinerInfo) Reset() {
 	*x = MinerInfo{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_powergate_admin_v1_admin_proto_msgTypes[36]
+		mi := &file_powergate_admin_v1_admin_proto_msgTypes[58]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1869,7 +2991,7 @@ func (x *MinerInfo) String() string {
 func (*MinerInfo) ProtoMessage() {}
 
 func (x *MinerInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_powergate_admin_v1_admin_proto_msgTypes[36]
+	mi := &file_powergate_admin_v1_admin_proto_msgTypes[58]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1882,7 +3004,7 @@ func (x *MinerInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MinerInfo.ProtoReflect.Descriptor instead.
 func (*MinerInfo) Descriptor() ([]byte, []int) {
-	return file_powergate_admin_v1_admin_proto_rawDescGZIP(), []int{36}
+	return file_powergate_admin_v1_admin_proto_rawDescGZIP(), []int{58}
 }
 
 func (x *MinerInfo) GetAddress() string {
@@ -2060,7 +3182,7 @@ var file_powergate_admin_v1_admin_proto_rawDesc = []byte{
 	0x6d, 0x6d, 0x61, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07,
 	0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75,
 	0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x63, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x03, 0x63, 0x69, 0x64, 0x22, 0xb0, 0x01, 0x0a, 0x1a, 0x53, 0x74, 0x6f, 0x72,
+	0x28, 0x09, 0x52, 0x03, 0x63, 0x69, 0x64, 0x22, 0xe6, 0x01, 0x0a, 0x1a, 0x53, 0x74, 0x6f, 0x72,
 	0x61, 0x67, 0x65, 0x4a, 0x6f, 0x62, 0x73, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x52, 0x65,
 	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2e, 0x0a, 0x13, 0x71, 0x75, 0x65, 0x75, 0x65, 0x64,
 	0x5f, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x5f, 0x6a, 0x6f, 0x62, 0x73, 0x18, 0x01, 0x20,
@@ -2071,214 +3193,394 @@ var file_powergate_admin_v1_admin_proto_rawDesc = []byte{
 	0x67, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x4a, 0x6f, 0x62, 0x73, 0x12, 0x2c, 0x0a, 0x12,
 	0x66, 0x69, 0x6e, 0x61, 0x6c, 0x5f, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x5f, 0x6a, 0x6f,
 	0x62, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x10, 0x66, 0x69, 0x6e, 0x61, 0x6c, 0x53,
-	0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x4a, 0x6f, 0x62, 0x73, 0x22, 0x11, 0x0a, 0x0f, 0x47, 0x43,
-	0x53, 0x74, 0x61, 0x67, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x37, 0x0a,
-	0x10, 0x47, 0x43, 0x53, 0x74, 0x61, 0x67, 0x65, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x23, 0x0a, 0x0d, 0x75, 0x6e, 0x70, 0x69, 0x6e, 0x6e, 0x65, 0x64, 0x5f, 0x63, 0x69,
-	0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0c, 0x75, 0x6e, 0x70, 0x69, 0x6e, 0x6e,
-	0x65, 0x64, 0x43, 0x69, 0x64, 0x73, 0x22, 0x13, 0x0a, 0x11, 0x50, 0x69, 0x6e, 0x6e, 0x65, 0x64,
-	0x43, 0x69, 0x64, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x49, 0x0a, 0x12, 0x50,
-	0x69, 0x6e, 0x6e, 0x65, 0x64, 0x43, 0x69, 0x64, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x33, 0x0a, 0x04, 0x63, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x1f, 0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x61, 0x64, 0x6d, 0x69,
-	0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x53, 0x50, 0x69, 0x6e, 0x6e, 0x65, 0x64, 0x43, 0x69, 0x64,
-	0x52, 0x04, 0x63, 0x69, 0x64, 0x73, 0x22, 0x5a, 0x0a, 0x0b, 0x48, 0x53, 0x50, 0x69, 0x6e, 0x6e,
-	0x65, 0x64, 0x43, 0x69, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x63, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x03, 0x63, 0x69, 0x64, 0x12, 0x39, 0x0a, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73,
-	0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x67, 0x61,
-	0x74, 0x65, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x53, 0x50, 0x69,
-	0x6e, 0x6e, 0x65, 0x64, 0x43, 0x69, 0x64, 0x55, 0x73, 0x65, 0x72, 0x52, 0x05, 0x75, 0x73, 0x65,
-	0x72, 0x73, 0x22, 0x61, 0x0a, 0x0f, 0x48, 0x53, 0x50, 0x69, 0x6e, 0x6e, 0x65, 0x64, 0x43, 0x69,
-	0x64, 0x55, 0x73, 0x65, 0x72, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x16,
-	0x0a, 0x06, 0x73, 0x74, 0x61, 0x67, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06,
-	0x73, 0x74, 0x61, 0x67, 0x65, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
-	0x64, 0x5f, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61,
-	0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x72, 0x0a, 0x28, 0x47, 0x65, 0x74, 0x55, 0x70, 0x64, 0x61,
-	0x74, 0x65, 0x64, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x44, 0x65, 0x61, 0x6c, 0x52, 0x65,
-	0x63, 0x6f, 0x72, 0x64, 0x73, 0x53, 0x69, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x30, 0x0a, 0x05, 0x73, 0x69, 0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
-	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x05, 0x73, 0x69,
-	0x6e, 0x63, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x05, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0x6b, 0x0a, 0x29, 0x47, 0x65, 0x74,
-	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x44, 0x65,
-	0x61, 0x6c, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x53, 0x69, 0x6e, 0x63, 0x65, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3e, 0x0a, 0x07, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64,
-	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x67,
-	0x61, 0x74, 0x65, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x6f, 0x72,
-	0x61, 0x67, 0x65, 0x44, 0x65, 0x61, 0x6c, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x52, 0x07, 0x72,
-	0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x22, 0x70, 0x0a, 0x26, 0x47, 0x65, 0x74, 0x55, 0x70, 0x64,
-	0x61, 0x74, 0x65, 0x64, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65, 0x76, 0x61, 0x6c, 0x52, 0x65, 0x63,
-	0x6f, 0x72, 0x64, 0x73, 0x53, 0x69, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x30, 0x0a, 0x05, 0x73, 0x69, 0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x4a, 0x6f, 0x62, 0x73, 0x12, 0x34, 0x0a, 0x16, 0x73, 0x63,
+	0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x5f, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x5f,
+	0x6a, 0x6f, 0x62, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x14, 0x73, 0x63, 0x68, 0x65,
+	0x64, 0x75, 0x6c, 0x65, 0x64, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x4a, 0x6f, 0x62, 0x73,
+	0x22, 0x78, 0x0a, 0x17, 0x53, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x46, 0x61, 0x69, 0x72, 0x53,
+	0x68, 0x61, 0x72, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75,
+	0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73,
+	0x65, 0x72, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x2c, 0x0a, 0x12,
+	0x6d, 0x61, 0x78, 0x5f, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x6a, 0x6f,
+	0x62, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x10, 0x6d, 0x61, 0x78, 0x45, 0x78, 0x65,
+	0x63, 0x75, 0x74, 0x69, 0x6e, 0x67, 0x4a, 0x6f, 0x62, 0x73, 0x22, 0x1a, 0x0a, 0x18, 0x53, 0x65,
+	0x74, 0x55, 0x73, 0x65, 0x72, 0x46, 0x61, 0x69, 0x72, 0x53, 0x68, 0x61, 0x72, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x35, 0x0a, 0x1a, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65,
+	0x55, 0x73, 0x65, 0x72, 0x46, 0x61, 0x69, 0x72, 0x53, 0x68, 0x61, 0x72, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0x1d, 0x0a,
+	0x1b, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x55, 0x73, 0x65, 0x72, 0x46, 0x61, 0x69, 0x72, 0x53,
+	0x68, 0x61, 0x72, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x17, 0x0a, 0x15,
+	0x55, 0x73, 0x65, 0x72, 0x46, 0x61, 0x69, 0x72, 0x53, 0x68, 0x61, 0x72, 0x65, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x5c, 0x0a, 0x16, 0x55, 0x73, 0x65, 0x72, 0x46, 0x61, 0x69,
+	0x72, 0x53, 0x68, 0x61, 0x72, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x42, 0x0a, 0x0b, 0x66, 0x61, 0x69, 0x72, 0x5f, 0x73, 0x68, 0x61, 0x72, 0x65, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x67, 0x61, 0x74, 0x65,
+	0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x46, 0x61,
+	0x69, 0x72, 0x53, 0x68, 0x61, 0x72, 0x65, 0x52, 0x0a, 0x66, 0x61, 0x69, 0x72, 0x53, 0x68, 0x61,
+	0x72, 0x65, 0x73, 0x22, 0x6e, 0x0a, 0x0d, 0x55, 0x73, 0x65, 0x72, 0x46, 0x61, 0x69, 0x72, 0x53,
+	0x68, 0x61, 0x72, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x16, 0x0a,
+	0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x77,
+	0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x2c, 0x0a, 0x12, 0x6d, 0x61, 0x78, 0x5f, 0x65, 0x78, 0x65,
+	0x63, 0x75, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x6a, 0x6f, 0x62, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x10, 0x6d, 0x61, 0x78, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6e, 0x67, 0x4a,
+	0x6f, 0x62, 0x73, 0x22, 0x62, 0x0a, 0x14, 0x53, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x42, 0x75,
+	0x64, 0x67, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75,
+	0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73,
+	0x65, 0x72, 0x49, 0x64, 0x12, 0x31, 0x0a, 0x06, 0x62, 0x75, 0x64, 0x67, 0x65, 0x74, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x67, 0x61, 0x74, 0x65,
+	0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x75, 0x64, 0x67, 0x65, 0x74, 0x52,
+	0x06, 0x62, 0x75, 0x64, 0x67, 0x65, 0x74, 0x22, 0x17, 0x0a, 0x15, 0x53, 0x65, 0x74, 0x55, 0x73,
+	0x65, 0x72, 0x42, 0x75, 0x64, 0x67, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x3e, 0x0a, 0x11, 0x55, 0x73, 0x65, 0x72, 0x42, 0x75, 0x64, 0x67, 0x65, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x10,
+	0x0a, 0x03, 0x63, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x63, 0x69, 0x64,
+	0x22, 0x4d, 0x0a, 0x12, 0x55, 0x73, 0x65, 0x72, 0x42, 0x75, 0x64, 0x67, 0x65, 0x74, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x37, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x67, 0x61,
+	0x74, 0x65, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x75, 0x64, 0x67, 0x65,
+	0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22,
+	0x61, 0x0a, 0x17, 0x50, 0x72, 0x75, 0x6e, 0x65, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x4a,
+	0x6f, 0x62, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x26, 0x0a, 0x0f, 0x6d, 0x61,
+	0x78, 0x5f, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x0d, 0x6d, 0x61, 0x78, 0x41, 0x67, 0x65, 0x53, 0x65, 0x63, 0x6f, 0x6e,
+	0x64, 0x73, 0x12, 0x1e, 0x0a, 0x0b, 0x6d, 0x61, 0x78, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x63, 0x69,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x6d, 0x61, 0x78, 0x50, 0x65, 0x72, 0x43,
+	0x69, 0x64, 0x22, 0x70, 0x0a, 0x18, 0x50, 0x72, 0x75, 0x6e, 0x65, 0x53, 0x74, 0x6f, 0x72, 0x61,
+	0x67, 0x65, 0x4a, 0x6f, 0x62, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x24,
+	0x0a, 0x0e, 0x70, 0x72, 0x75, 0x6e, 0x65, 0x64, 0x5f, 0x6a, 0x6f, 0x62, 0x5f, 0x69, 0x64, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0c, 0x70, 0x72, 0x75, 0x6e, 0x65, 0x64, 0x4a, 0x6f,
+	0x62, 0x49, 0x64, 0x73, 0x12, 0x2e, 0x0a, 0x13, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x5f,
+	0x6c, 0x6f, 0x67, 0x5f, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x11, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x4c, 0x6f, 0x67, 0x45, 0x6e, 0x74,
+	0x72, 0x69, 0x65, 0x73, 0x22, 0x37, 0x0a, 0x0c, 0x44, 0x72, 0x61, 0x69, 0x6e, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x27, 0x0a, 0x0f, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x5f,
+	0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x74,
+	0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x22, 0x0f, 0x0a,
+	0x0d, 0x44, 0x72, 0x61, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x10,
+	0x0a, 0x0e, 0x55, 0x6e, 0x64, 0x72, 0x61, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x22, 0x11, 0x0a, 0x0f, 0x55, 0x6e, 0x64, 0x72, 0x61, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x14, 0x0a, 0x12, 0x44, 0x72, 0x61, 0x69, 0x6e, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0xd9, 0x01, 0x0a, 0x13, 0x44, 0x72,
+	0x61, 0x69, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x72, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x08, 0x64, 0x72, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x12, 0x36, 0x0a,
+	0x08, 0x64, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
 	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
-	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x05, 0x73, 0x69, 0x6e,
-	0x63, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x05, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0x6b, 0x0a, 0x27, 0x47, 0x65, 0x74, 0x55,
-	0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65, 0x76, 0x61, 0x6c, 0x52,
-	0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x53, 0x69, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x40, 0x0a, 0x07, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x18, 0x01,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x67, 0x61, 0x74, 0x65,
-	0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65, 0x76,
-	0x61, 0x6c, 0x44, 0x65, 0x61, 0x6c, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x52, 0x07, 0x72, 0x65,
-	0x63, 0x6f, 0x72, 0x64, 0x73, 0x22, 0x31, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x4d, 0x69, 0x6e, 0x65,
-	0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x77, 0x69, 0x74,
-	0x68, 0x5f, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x77,
-	0x69, 0x74, 0x68, 0x50, 0x6f, 0x77, 0x65, 0x72, 0x22, 0x4e, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x4d,
-	0x69, 0x6e, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x39, 0x0a,
-	0x06, 0x6d, 0x69, 0x6e, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e,
-	0x70, 0x6f, 0x77, 0x65, 0x72, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e,
-	0x76, 0x31, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x63, 0x6f, 0x69, 0x6e, 0x4d, 0x69, 0x6e, 0x65, 0x72,
-	0x52, 0x06, 0x6d, 0x69, 0x6e, 0x65, 0x72, 0x73, 0x22, 0x29, 0x0a, 0x0d, 0x46, 0x69, 0x6c, 0x65,
-	0x63, 0x6f, 0x69, 0x6e, 0x4d, 0x69, 0x6e, 0x65, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64,
-	0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72,
-	0x65, 0x73, 0x73, 0x22, 0x2d, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x4d, 0x69, 0x6e, 0x65, 0x72, 0x49,
-	0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x69,
-	0x6e, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x6d, 0x69, 0x6e, 0x65,
-	0x72, 0x73, 0x22, 0x56, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x4d, 0x69, 0x6e, 0x65, 0x72, 0x49, 0x6e,
-	0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3e, 0x0a, 0x0b, 0x6d, 0x69,
-	0x6e, 0x65, 0x72, 0x73, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x1d, 0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x61, 0x64, 0x6d, 0x69,
-	0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x69, 0x6e, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0a,
-	0x6d, 0x69, 0x6e, 0x65, 0x72, 0x73, 0x49, 0x6e, 0x66, 0x6f, 0x22, 0x91, 0x03, 0x0a, 0x09, 0x4d,
-	0x69, 0x6e, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72,
-	0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65,
-	0x73, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x70,
-	0x6f, 0x77, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0d, 0x72, 0x65, 0x6c, 0x61,
-	0x74, 0x69, 0x76, 0x65, 0x50, 0x6f, 0x77, 0x65, 0x72, 0x12, 0x1b, 0x0a, 0x09, 0x61, 0x73, 0x6b,
-	0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x61, 0x73,
-	0x6b, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x2c, 0x0a, 0x12, 0x61, 0x73, 0x6b, 0x5f, 0x76, 0x65,
-	0x72, 0x69, 0x66, 0x69, 0x65, 0x64, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x05, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x10, 0x61, 0x73, 0x6b, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x64, 0x50,
-	0x72, 0x69, 0x63, 0x65, 0x12, 0x24, 0x0a, 0x0e, 0x6d, 0x69, 0x6e, 0x5f, 0x70, 0x69, 0x65, 0x63,
-	0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x6d, 0x69,
-	0x6e, 0x50, 0x69, 0x65, 0x63, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x24, 0x0a, 0x0e, 0x6d, 0x61,
-	0x78, 0x5f, 0x70, 0x69, 0x65, 0x63, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x07, 0x20, 0x01,
-	0x28, 0x04, 0x52, 0x0c, 0x6d, 0x61, 0x78, 0x50, 0x69, 0x65, 0x63, 0x65, 0x53, 0x69, 0x7a, 0x65,
-	0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18,
-	0x08, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x73, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x53, 0x69, 0x7a,
-	0x65, 0x12, 0x25, 0x0a, 0x0e, 0x73, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x5f, 0x61, 0x63, 0x74,
-	0x69, 0x76, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x73, 0x65, 0x63, 0x74, 0x6f,
-	0x72, 0x73, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x65, 0x63, 0x74,
-	0x6f, 0x72, 0x73, 0x5f, 0x6c, 0x69, 0x76, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b,
-	0x73, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x4c, 0x69, 0x76, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x73,
-	0x65, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x5f, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x79, 0x18, 0x0b, 0x20,
-	0x01, 0x28, 0x04, 0x52, 0x0d, 0x73, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x46, 0x61, 0x75, 0x6c,
-	0x74, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0c,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x32, 0xab,
-	0x0d, 0x0a, 0x0c, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12,
-	0x5d, 0x0a, 0x0a, 0x4e, 0x65, 0x77, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x25, 0x2e,
+	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x08, 0x64, 0x65, 0x61,
+	0x64, 0x6c, 0x69, 0x6e, 0x65, 0x12, 0x34, 0x0a, 0x16, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69,
+	0x6e, 0x67, 0x5f, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x5f, 0x6a, 0x6f, 0x62, 0x73, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x14, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6e, 0x67,
+	0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x4a, 0x6f, 0x62, 0x73, 0x12, 0x38, 0x0a, 0x18, 0x65,
+	0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x72, 0x65, 0x74, 0x72, 0x69, 0x65, 0x76,
+	0x61, 0x6c, 0x5f, 0x6a, 0x6f, 0x62, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x16, 0x65,
+	0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65, 0x76, 0x61,
+	0x6c, 0x4a, 0x6f, 0x62, 0x73, 0x22, 0x11, 0x0a, 0x0f, 0x47, 0x43, 0x53, 0x74, 0x61, 0x67, 0x65,
+	0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x37, 0x0a, 0x10, 0x47, 0x43, 0x53, 0x74,
+	0x61, 0x67, 0x65, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x23, 0x0a, 0x0d,
+	0x75, 0x6e, 0x70, 0x69, 0x6e, 0x6e, 0x65, 0x64, 0x5f, 0x63, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x0c, 0x75, 0x6e, 0x70, 0x69, 0x6e, 0x6e, 0x65, 0x64, 0x43, 0x69, 0x64,
+	0x73, 0x22, 0x13, 0x0a, 0x11, 0x50, 0x69, 0x6e, 0x6e, 0x65, 0x64, 0x43, 0x69, 0x64, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x49, 0x0a, 0x12, 0x50, 0x69, 0x6e, 0x6e, 0x65, 0x64,
+	0x43, 0x69, 0x64, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x33, 0x0a, 0x04,
+	0x63, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x70, 0x6f, 0x77,
+	0x65, 0x72, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e,
+	0x48, 0x53, 0x50, 0x69, 0x6e, 0x6e, 0x65, 0x64, 0x43, 0x69, 0x64, 0x52, 0x04, 0x63, 0x69, 0x64,
+	0x73, 0x22, 0x5a, 0x0a, 0x0b, 0x48, 0x53, 0x50, 0x69, 0x6e, 0x6e, 0x65, 0x64, 0x43, 0x69, 0x64,
+	0x12, 0x10, 0x0a, 0x03, 0x63, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x63,
+	0x69, 0x64, 0x12, 0x39, 0x0a, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x23, 0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x61, 0x64,
+	0x6d, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x53, 0x50, 0x69, 0x6e, 0x6e, 0x65, 0x64, 0x43,
+	0x69, 0x64, 0x55, 0x73, 0x65, 0x72, 0x52, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x22, 0x80, 0x01,
+	0x0a, 0x0f, 0x48, 0x53, 0x50, 0x69, 0x6e, 0x6e, 0x65, 0x64, 0x43, 0x69, 0x64, 0x55, 0x73, 0x65,
+	0x72, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74,
+	0x61, 0x67, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x73, 0x74, 0x61, 0x67,
+	0x65, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41,
+	0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x5f, 0x61, 0x74, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x74,
+	0x22, 0x72, 0x0a, 0x28, 0x47, 0x65, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x53, 0x74,
+	0x6f, 0x72, 0x61, 0x67, 0x65, 0x44, 0x65, 0x61, 0x6c, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73,
+	0x53, 0x69, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x30, 0x0a, 0x05,
+	0x73, 0x69, 0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x05, 0x73, 0x69, 0x6e, 0x63, 0x65, 0x12, 0x14,
+	0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6c,
+	0x69, 0x6d, 0x69, 0x74, 0x22, 0x6b, 0x0a, 0x29, 0x47, 0x65, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x64, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x44, 0x65, 0x61, 0x6c, 0x52, 0x65, 0x63,
+	0x6f, 0x72, 0x64, 0x73, 0x53, 0x69, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x3e, 0x0a, 0x07, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x24, 0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x75,
+	0x73, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x44, 0x65,
+	0x61, 0x6c, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x52, 0x07, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64,
+	0x73, 0x22, 0x70, 0x0a, 0x26, 0x47, 0x65, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x52,
+	0x65, 0x74, 0x72, 0x69, 0x65, 0x76, 0x61, 0x6c, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x53,
+	0x69, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x30, 0x0a, 0x05, 0x73,
+	0x69, 0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x05, 0x73, 0x69, 0x6e, 0x63, 0x65, 0x12, 0x14, 0x0a,
+	0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6c, 0x69,
+	0x6d, 0x69, 0x74, 0x22, 0x6b, 0x0a, 0x27, 0x47, 0x65, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x64, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65, 0x76, 0x61, 0x6c, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64,
+	0x73, 0x53, 0x69, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x40,
+	0x0a, 0x07, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x26, 0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x75, 0x73, 0x65, 0x72,
+	0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65, 0x76, 0x61, 0x6c, 0x44, 0x65, 0x61,
+	0x6c, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x52, 0x07, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73,
+	0x22, 0xa5, 0x02, 0x0a, 0x12, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65, 0x76, 0x61, 0x6c, 0x4d, 0x69,
+	0x6e, 0x65, 0x72, 0x52, 0x61, 0x6e, 0x6b, 0x12, 0x14, 0x0a, 0x05, 0x6d, 0x69, 0x6e, 0x65, 0x72,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6d, 0x69, 0x6e, 0x65, 0x72, 0x12, 0x1c, 0x0a,
+	0x09, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x09, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x65, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x66,
+	0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x66,
+	0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x75, 0x63, 0x63, 0x65,
+	0x73, 0x73, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0b, 0x73,
+	0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x52, 0x61, 0x74, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x74, 0x68,
+	0x72, 0x6f, 0x75, 0x67, 0x68, 0x70, 0x75, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0a,
+	0x74, 0x68, 0x72, 0x6f, 0x75, 0x67, 0x68, 0x70, 0x75, 0x74, 0x12, 0x3d, 0x0a, 0x0c, 0x6c, 0x61,
+	0x73, 0x74, 0x5f, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0b, 0x6c, 0x61,
+	0x73, 0x74, 0x46, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x72, 0x65, 0x63,
+	0x65, 0x6e, 0x74, 0x6c, 0x79, 0x5f, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x0e, 0x72, 0x65, 0x63, 0x65, 0x6e, 0x74, 0x6c, 0x79, 0x46, 0x61, 0x69, 0x6c,
+	0x65, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x22, 0x36, 0x0a, 0x1c, 0x52, 0x65, 0x74, 0x72,
+	0x69, 0x65, 0x76, 0x61, 0x6c, 0x4d, 0x69, 0x6e, 0x65, 0x72, 0x52, 0x61, 0x6e, 0x6b, 0x69, 0x6e,
+	0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x69, 0x6e, 0x65,
+	0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x6d, 0x69, 0x6e, 0x65, 0x72, 0x73,
+	0x22, 0x61, 0x0a, 0x1d, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65, 0x76, 0x61, 0x6c, 0x4d, 0x69, 0x6e,
+	0x65, 0x72, 0x52, 0x61, 0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x40, 0x0a, 0x07, 0x72, 0x61, 0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x26, 0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x61,
+	0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65, 0x76, 0x61,
+	0x6c, 0x4d, 0x69, 0x6e, 0x65, 0x72, 0x52, 0x61, 0x6e, 0x6b, 0x52, 0x07, 0x72, 0x61, 0x6e, 0x6b,
+	0x69, 0x6e, 0x67, 0x22, 0x31, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x4d, 0x69, 0x6e, 0x65, 0x72, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x77, 0x69, 0x74, 0x68, 0x5f,
+	0x70, 0x6f, 0x77, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x77, 0x69, 0x74,
+	0x68, 0x50, 0x6f, 0x77, 0x65, 0x72, 0x22, 0x4e, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x4d, 0x69, 0x6e,
+	0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x39, 0x0a, 0x06, 0x6d,
+	0x69, 0x6e, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x70, 0x6f,
+	0x77, 0x65, 0x72, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x76, 0x31,
+	0x2e, 0x46, 0x69, 0x6c, 0x65, 0x63, 0x6f, 0x69, 0x6e, 0x4d, 0x69, 0x6e, 0x65, 0x72, 0x52, 0x06,
+	0x6d, 0x69, 0x6e, 0x65, 0x72, 0x73, 0x22, 0x29, 0x0a, 0x0d, 0x46, 0x69, 0x6c, 0x65, 0x63, 0x6f,
+	0x69, 0x6e, 0x4d, 0x69, 0x6e, 0x65, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x22, 0x2d, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x4d, 0x69, 0x6e, 0x65, 0x72, 0x49, 0x6e, 0x66,
+	0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x69, 0x6e, 0x65,
+	0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x6d, 0x69, 0x6e, 0x65, 0x72, 0x73,
+	0x22, 0x56, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x4d, 0x69, 0x6e, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3e, 0x0a, 0x0b, 0x6d, 0x69, 0x6e, 0x65,
+	0x72, 0x73, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e,
 	0x70, 0x6f, 0x77, 0x65, 0x72, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e,
-	0x76, 0x31, 0x2e, 0x4e, 0x65, 0x77, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x67, 0x61, 0x74, 0x65,
-	0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x65, 0x77, 0x41, 0x64, 0x64,
-	0x72, 0x65, 0x73, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5a,
-	0x0a, 0x09, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x12, 0x24, 0x2e, 0x70, 0x6f,
+	0x76, 0x31, 0x2e, 0x4d, 0x69, 0x6e, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0a, 0x6d, 0x69,
+	0x6e, 0x65, 0x72, 0x73, 0x49, 0x6e, 0x66, 0x6f, 0x22, 0x91, 0x03, 0x0a, 0x09, 0x4d, 0x69, 0x6e,
+	0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x12, 0x25, 0x0a, 0x0e, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x70, 0x6f, 0x77,
+	0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0d, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69,
+	0x76, 0x65, 0x50, 0x6f, 0x77, 0x65, 0x72, 0x12, 0x1b, 0x0a, 0x09, 0x61, 0x73, 0x6b, 0x5f, 0x70,
+	0x72, 0x69, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x61, 0x73, 0x6b, 0x50,
+	0x72, 0x69, 0x63, 0x65, 0x12, 0x2c, 0x0a, 0x12, 0x61, 0x73, 0x6b, 0x5f, 0x76, 0x65, 0x72, 0x69,
+	0x66, 0x69, 0x65, 0x64, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x10, 0x61, 0x73, 0x6b, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x64, 0x50, 0x72, 0x69,
+	0x63, 0x65, 0x12, 0x24, 0x0a, 0x0e, 0x6d, 0x69, 0x6e, 0x5f, 0x70, 0x69, 0x65, 0x63, 0x65, 0x5f,
+	0x73, 0x69, 0x7a, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x6d, 0x69, 0x6e, 0x50,
+	0x69, 0x65, 0x63, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x24, 0x0a, 0x0e, 0x6d, 0x61, 0x78, 0x5f,
+	0x70, 0x69, 0x65, 0x63, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x0c, 0x6d, 0x61, 0x78, 0x50, 0x69, 0x65, 0x63, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x1f,
+	0x0a, 0x0b, 0x73, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x0a, 0x73, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x53, 0x69, 0x7a, 0x65, 0x12,
+	0x25, 0x0a, 0x0e, 0x73, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x5f, 0x61, 0x63, 0x74, 0x69, 0x76,
+	0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x73, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x73,
+	0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x65, 0x63, 0x74, 0x6f, 0x72,
+	0x73, 0x5f, 0x6c, 0x69, 0x76, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x73, 0x65,
+	0x63, 0x74, 0x6f, 0x72, 0x73, 0x4c, 0x69, 0x76, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x73, 0x65, 0x63,
+	0x74, 0x6f, 0x72, 0x73, 0x5f, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x79, 0x18, 0x0b, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x0d, 0x73, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x46, 0x61, 0x75, 0x6c, 0x74, 0x79,
+	0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0c, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x32, 0xc1, 0x15, 0x0a,
+	0x0c, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x5d, 0x0a,
+	0x0a, 0x4e, 0x65, 0x77, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x25, 0x2e, 0x70, 0x6f,
 	0x77, 0x65, 0x72, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x76, 0x31,
-	0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x25, 0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x61, 0x64,
-	0x6d, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x54, 0x0a, 0x07, 0x53, 0x65,
-	0x6e, 0x64, 0x46, 0x69, 0x6c, 0x12, 0x22, 0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x67, 0x61, 0x74,
-	0x65, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x46,
-	0x69, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x70, 0x6f, 0x77, 0x65,
-	0x72, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x53,
-	0x65, 0x6e, 0x64, 0x46, 0x69, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
-	0x12, 0x5d, 0x0a, 0x0a, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x12, 0x25,
-	0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e,
-	0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x67, 0x61, 0x74,
-	0x65, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74,
-	0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
-	0x69, 0x0a, 0x0e, 0x52, 0x65, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x41, 0x75, 0x74,
-	0x68, 0x12, 0x29, 0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x61, 0x64,
-	0x6d, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74,
-	0x65, 0x41, 0x75, 0x74, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x70,
+	0x2e, 0x4e, 0x65, 0x77, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x26, 0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x61,
+	0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x65, 0x77, 0x41, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5a, 0x0a, 0x09,
+	0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x12, 0x24, 0x2e, 0x70, 0x6f, 0x77, 0x65,
+	0x72, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x41,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x25, 0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x61, 0x64, 0x6d, 0x69,
+	0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x54, 0x0a, 0x07, 0x53, 0x65, 0x6e, 0x64,
+	0x46, 0x69, 0x6c, 0x12, 0x22, 0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x67, 0x61, 0x74, 0x65, 0x2e,
+	0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x46, 0x69, 0x6c,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x67,
+	0x61, 0x74, 0x65, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x6e,
+	0x64, 0x46, 0x69, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5d,
+	0x0a, 0x0a, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x12, 0x25, 0x2e, 0x70,
 	0x6f, 0x77, 0x65, 0x72, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x76,
-	0x31, 0x2e, 0x52, 0x65, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x41, 0x75, 0x74, 0x68,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4e, 0x0a, 0x05, 0x55, 0x73,
-	0x65, 0x72, 0x73, 0x12, 0x20, 0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x67, 0x61, 0x74, 0x65, 0x2e,
+	0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x67, 0x61, 0x74, 0x65, 0x2e,
+	0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x55,
+	0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x69, 0x0a,
+	0x0e, 0x52, 0x65, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x41, 0x75, 0x74, 0x68, 0x12,
+	0x29, 0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x61, 0x64, 0x6d, 0x69,
+	0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x41,
+	0x75, 0x74, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x70, 0x6f, 0x77,
+	0x65, 0x72, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e,
+	0x52, 0x65, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x41, 0x75, 0x74, 0x68, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4e, 0x0a, 0x05, 0x55, 0x73, 0x65, 0x72,
+	0x73, 0x12, 0x20, 0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x61, 0x64,
+	0x6d, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x67, 0x61, 0x74, 0x65, 0x2e,
 	0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x73, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x67, 0x61, 0x74,
-	0x65, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x73,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x60, 0x0a, 0x0b, 0x53, 0x74,
-	0x6f, 0x72, 0x61, 0x67, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x26, 0x2e, 0x70, 0x6f, 0x77, 0x65,
-	0x72, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x53,
-	0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x27, 0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x61, 0x64,
-	0x6d, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x49, 0x6e,
-	0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x6c, 0x0a, 0x0f,
-	0x4c, 0x69, 0x73, 0x74, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x12,
-	0x2a, 0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x61, 0x64, 0x6d, 0x69,
-	0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65,
-	0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x70, 0x6f,
-	0x77, 0x65, 0x72, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x76, 0x31,
-	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x49, 0x6e, 0x66, 0x6f,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x60, 0x0a, 0x0b, 0x53, 0x74, 0x6f, 0x72,
+	0x61, 0x67, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x26, 0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x67,
+	0x61, 0x74, 0x65, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x6f,
+	0x72, 0x61, 0x67, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x27, 0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x61, 0x64, 0x6d, 0x69,
+	0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x49, 0x6e, 0x66, 0x6f,
 	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x6c, 0x0a, 0x0f, 0x4c, 0x69,
-	0x73, 0x74, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x4a, 0x6f, 0x62, 0x73, 0x12, 0x2a, 0x2e,
+	0x73, 0x74, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x2a, 0x2e,
 	0x70, 0x6f, 0x77, 0x65, 0x72, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e,
-	0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x4a, 0x6f,
-	0x62, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x70, 0x6f, 0x77, 0x65,
+	0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x49, 0x6e,
+	0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x70, 0x6f, 0x77, 0x65,
 	0x72, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x4c,
-	0x69, 0x73, 0x74, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x4a, 0x6f, 0x62, 0x73, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x75, 0x0a, 0x12, 0x53, 0x74, 0x6f, 0x72,
-	0x61, 0x67, 0x65, 0x4a, 0x6f, 0x62, 0x73, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x12, 0x2d,
+	0x69, 0x73, 0x74, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x6c, 0x0a, 0x0f, 0x4c, 0x69, 0x73, 0x74,
+	0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x4a, 0x6f, 0x62, 0x73, 0x12, 0x2a, 0x2e, 0x70, 0x6f,
+	0x77, 0x65, 0x72, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x76, 0x31,
+	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x4a, 0x6f, 0x62, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x67,
+	0x61, 0x74, 0x65, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73,
+	0x74, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x4a, 0x6f, 0x62, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x75, 0x0a, 0x12, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67,
+	0x65, 0x4a, 0x6f, 0x62, 0x73, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x12, 0x2d, 0x2e, 0x70,
+	0x6f, 0x77, 0x65, 0x72, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x76,
+	0x31, 0x2e, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x4a, 0x6f, 0x62, 0x73, 0x53, 0x75, 0x6d,
+	0x6d, 0x61, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2e, 0x2e, 0x70, 0x6f,
+	0x77, 0x65, 0x72, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x76, 0x31,
+	0x2e, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x4a, 0x6f, 0x62, 0x73, 0x53, 0x75, 0x6d, 0x6d,
+	0x61, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x6f, 0x0a,
+	0x10, 0x53, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x46, 0x61, 0x69, 0x72, 0x53, 0x68, 0x61, 0x72,
+	0x65, 0x12, 0x2b, 0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x61, 0x64,
+	0x6d, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x46, 0x61,
+	0x69, 0x72, 0x53, 0x68, 0x61, 0x72, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c,
 	0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e,
-	0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x4a, 0x6f, 0x62, 0x73, 0x53,
-	0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2e, 0x2e,
-	0x70, 0x6f, 0x77, 0x65, 0x72, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e,
-	0x76, 0x31, 0x2e, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x4a, 0x6f, 0x62, 0x73, 0x53, 0x75,
-	0x6d, 0x6d, 0x61, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
-	0xa2, 0x01, 0x0a, 0x21, 0x47, 0x65, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x53, 0x74,
-	0x6f, 0x72, 0x61, 0x67, 0x65, 0x44, 0x65, 0x61, 0x6c, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73,
-	0x53, 0x69, 0x6e, 0x63, 0x65, 0x12, 0x3c, 0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x67, 0x61, 0x74,
-	0x65, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x70,
-	0x64, 0x61, 0x74, 0x65, 0x64, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x44, 0x65, 0x61, 0x6c,
-	0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x53, 0x69, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x3d, 0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x67, 0x61, 0x74, 0x65, 0x2e,
-	0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x70, 0x64, 0x61,
-	0x74, 0x65, 0x64, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x44, 0x65, 0x61, 0x6c, 0x52, 0x65,
-	0x63, 0x6f, 0x72, 0x64, 0x73, 0x53, 0x69, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x00, 0x12, 0x9c, 0x01, 0x0a, 0x1f, 0x47, 0x65, 0x74, 0x55, 0x70, 0x64, 0x61,
-	0x74, 0x65, 0x64, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65, 0x76, 0x61, 0x6c, 0x52, 0x65, 0x63, 0x6f,
-	0x72, 0x64, 0x73, 0x53, 0x69, 0x6e, 0x63, 0x65, 0x12, 0x3a, 0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72,
-	0x67, 0x61, 0x74, 0x65, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65,
-	0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65, 0x76, 0x61,
-	0x6c, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x53, 0x69, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x3b, 0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x67, 0x61, 0x74, 0x65,
-	0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x70, 0x64,
-	0x61, 0x74, 0x65, 0x64, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65, 0x76, 0x61, 0x6c, 0x52, 0x65, 0x63,
-	0x6f, 0x72, 0x64, 0x73, 0x53, 0x69, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x00, 0x12, 0x57, 0x0a, 0x08, 0x47, 0x43, 0x53, 0x74, 0x61, 0x67, 0x65, 0x64, 0x12,
-	0x23, 0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x61, 0x64, 0x6d, 0x69,
-	0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x43, 0x53, 0x74, 0x61, 0x67, 0x65, 0x64, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x67, 0x61, 0x74, 0x65,
-	0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x43, 0x53, 0x74, 0x61, 0x67,
-	0x65, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5d, 0x0a, 0x0a,
-	0x50, 0x69, 0x6e, 0x6e, 0x65, 0x64, 0x43, 0x69, 0x64, 0x73, 0x12, 0x25, 0x2e, 0x70, 0x6f, 0x77,
+	0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x46, 0x61, 0x69, 0x72, 0x53,
+	0x68, 0x61, 0x72, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x78,
+	0x0a, 0x13, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x55, 0x73, 0x65, 0x72, 0x46, 0x61, 0x69, 0x72,
+	0x53, 0x68, 0x61, 0x72, 0x65, 0x12, 0x2e, 0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x67, 0x61, 0x74,
+	0x65, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76,
+	0x65, 0x55, 0x73, 0x65, 0x72, 0x46, 0x61, 0x69, 0x72, 0x53, 0x68, 0x61, 0x72, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2f, 0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x67, 0x61, 0x74,
+	0x65, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76,
+	0x65, 0x55, 0x73, 0x65, 0x72, 0x46, 0x61, 0x69, 0x72, 0x53, 0x68, 0x61, 0x72, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x69, 0x0a, 0x0e, 0x55, 0x73, 0x65, 0x72,
+	0x46, 0x61, 0x69, 0x72, 0x53, 0x68, 0x61, 0x72, 0x65, 0x73, 0x12, 0x29, 0x2e, 0x70, 0x6f, 0x77,
+	0x65, 0x72, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e,
+	0x55, 0x73, 0x65, 0x72, 0x46, 0x61, 0x69, 0x72, 0x53, 0x68, 0x61, 0x72, 0x65, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x67, 0x61, 0x74,
+	0x65, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x46,
+	0x61, 0x69, 0x72, 0x53, 0x68, 0x61, 0x72, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x12, 0x66, 0x0a, 0x0d, 0x53, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x42, 0x75,
+	0x64, 0x67, 0x65, 0x74, 0x12, 0x28, 0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x67, 0x61, 0x74, 0x65,
+	0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x74, 0x55, 0x73, 0x65,
+	0x72, 0x42, 0x75, 0x64, 0x67, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29,
+	0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e,
+	0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x42, 0x75, 0x64, 0x67, 0x65,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5d, 0x0a, 0x0a, 0x55,
+	0x73, 0x65, 0x72, 0x42, 0x75, 0x64, 0x67, 0x65, 0x74, 0x12, 0x25, 0x2e, 0x70, 0x6f, 0x77, 0x65,
+	0x72, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x55,
+	0x73, 0x65, 0x72, 0x42, 0x75, 0x64, 0x67, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x26, 0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x61, 0x64, 0x6d,
+	0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x42, 0x75, 0x64, 0x67, 0x65, 0x74,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x6f, 0x0a, 0x10, 0x50, 0x72,
+	0x75, 0x6e, 0x65, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x4a, 0x6f, 0x62, 0x73, 0x12, 0x2b,
+	0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e,
+	0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x75, 0x6e, 0x65, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65,
+	0x4a, 0x6f, 0x62, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x70, 0x6f,
+	0x77, 0x65, 0x72, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x76, 0x31,
+	0x2e, 0x50, 0x72, 0x75, 0x6e, 0x65, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x4a, 0x6f, 0x62,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4e, 0x0a, 0x05, 0x44,
+	0x72, 0x61, 0x69, 0x6e, 0x12, 0x20, 0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x67, 0x61, 0x74, 0x65,
+	0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x72, 0x61, 0x69, 0x6e, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x67, 0x61,
+	0x74, 0x65, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x72, 0x61, 0x69,
+	0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x54, 0x0a, 0x07, 0x55,
+	0x6e, 0x64, 0x72, 0x61, 0x69, 0x6e, 0x12, 0x22, 0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x67, 0x61,
+	0x74, 0x65, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x6e, 0x64, 0x72,
+	0x61, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x70, 0x6f, 0x77,
 	0x65, 0x72, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e,
-	0x50, 0x69, 0x6e, 0x6e, 0x65, 0x64, 0x43, 0x69, 0x64, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x26, 0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x61, 0x64,
-	0x6d, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x69, 0x6e, 0x6e, 0x65, 0x64, 0x43, 0x69, 0x64,
-	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5a, 0x0a, 0x09, 0x47,
-	0x65, 0x74, 0x4d, 0x69, 0x6e, 0x65, 0x72, 0x73, 0x12, 0x24, 0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72,
+	0x55, 0x6e, 0x64, 0x72, 0x61, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x00, 0x12, 0x60, 0x0a, 0x0b, 0x44, 0x72, 0x61, 0x69, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x12, 0x26, 0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x61, 0x64, 0x6d,
+	0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x72, 0x61, 0x69, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72,
+	0x67, 0x61, 0x74, 0x65, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x72,
+	0x61, 0x69, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x12, 0xa2, 0x01, 0x0a, 0x21, 0x47, 0x65, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x64, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x44, 0x65, 0x61, 0x6c, 0x52, 0x65, 0x63,
+	0x6f, 0x72, 0x64, 0x73, 0x53, 0x69, 0x6e, 0x63, 0x65, 0x12, 0x3c, 0x2e, 0x70, 0x6f, 0x77, 0x65,
+	0x72, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47,
+	0x65, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65,
+	0x44, 0x65, 0x61, 0x6c, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x53, 0x69, 0x6e, 0x63, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x3d, 0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x67,
+	0x61, 0x74, 0x65, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x44, 0x65,
+	0x61, 0x6c, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x53, 0x69, 0x6e, 0x63, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x9c, 0x01, 0x0a, 0x1f, 0x47, 0x65, 0x74,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65, 0x76, 0x61, 0x6c,
+	0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x53, 0x69, 0x6e, 0x63, 0x65, 0x12, 0x3a, 0x2e, 0x70,
+	0x6f, 0x77, 0x65, 0x72, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x76,
+	0x31, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x52, 0x65, 0x74, 0x72,
+	0x69, 0x65, 0x76, 0x61, 0x6c, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x53, 0x69, 0x6e, 0x63,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x3b, 0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72,
 	0x67, 0x61, 0x74, 0x65, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65,
-	0x74, 0x4d, 0x69, 0x6e, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25,
+	0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65, 0x76, 0x61,
+	0x6c, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x53, 0x69, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x7e, 0x0a, 0x15, 0x52, 0x65, 0x74, 0x72, 0x69,
+	0x65, 0x76, 0x61, 0x6c, 0x4d, 0x69, 0x6e, 0x65, 0x72, 0x52, 0x61, 0x6e, 0x6b, 0x69, 0x6e, 0x67,
+	0x12, 0x30, 0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x61, 0x64, 0x6d,
+	0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65, 0x76, 0x61, 0x6c, 0x4d,
+	0x69, 0x6e, 0x65, 0x72, 0x52, 0x61, 0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x31, 0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x61,
+	0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65, 0x76, 0x61,
+	0x6c, 0x4d, 0x69, 0x6e, 0x65, 0x72, 0x52, 0x61, 0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x57, 0x0a, 0x08, 0x47, 0x43, 0x53, 0x74, 0x61,
+	0x67, 0x65, 0x64, 0x12, 0x23, 0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x67, 0x61, 0x74, 0x65, 0x2e,
+	0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x43, 0x53, 0x74, 0x61, 0x67, 0x65,
+	0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72,
+	0x67, 0x61, 0x74, 0x65, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x43,
+	0x53, 0x74, 0x61, 0x67, 0x65, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x12, 0x5d, 0x0a, 0x0a, 0x50, 0x69, 0x6e, 0x6e, 0x65, 0x64, 0x43, 0x69, 0x64, 0x73, 0x12, 0x25,
 	0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e,
-	0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x69, 0x6e, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x63, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x4d, 0x69,
-	0x6e, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x27, 0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x67,
-	0x61, 0x74, 0x65, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74,
-	0x4d, 0x69, 0x6e, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x28, 0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x61, 0x64, 0x6d,
-	0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x69, 0x6e, 0x65, 0x72, 0x49, 0x6e,
-	0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x42, 0x46, 0x5a, 0x44,
-	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x74, 0x65, 0x78, 0x74, 0x69,
-	0x6c, 0x65, 0x69, 0x6f, 0x2f, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x67, 0x61, 0x74, 0x65, 0x2f, 0x76,
-	0x32, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x67, 0x65, 0x6e, 0x2f, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x67,
-	0x61, 0x74, 0x65, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2f, 0x76, 0x31, 0x3b, 0x61, 0x64, 0x6d,
-	0x69, 0x6e, 0x50, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x2e, 0x76, 0x31, 0x2e, 0x50, 0x69, 0x6e, 0x6e, 0x65, 0x64, 0x43, 0x69, 0x64, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x67, 0x61, 0x74,
+	0x65, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x69, 0x6e, 0x6e, 0x65,
+	0x64, 0x43, 0x69, 0x64, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x5a, 0x0a, 0x09, 0x47, 0x65, 0x74, 0x4d, 0x69, 0x6e, 0x65, 0x72, 0x73, 0x12, 0x24, 0x2e, 0x70,
+	0x6f, 0x77, 0x65, 0x72, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x76,
+	0x31, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x69, 0x6e, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x25, 0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x61,
+	0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x69, 0x6e, 0x65, 0x72,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x63, 0x0a, 0x0c, 0x47,
+	0x65, 0x74, 0x4d, 0x69, 0x6e, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x27, 0x2e, 0x70, 0x6f,
+	0x77, 0x65, 0x72, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x76, 0x31,
+	0x2e, 0x47, 0x65, 0x74, 0x4d, 0x69, 0x6e, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x67, 0x61, 0x74, 0x65,
+	0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x69, 0x6e,
+	0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x42, 0x46, 0x5a, 0x44, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x74,
+	0x65, 0x78, 0x74, 0x69, 0x6c, 0x65, 0x69, 0x6f, 0x2f, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x67, 0x61,
+	0x74, 0x65, 0x2f, 0x76, 0x32, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x67, 0x65, 0x6e, 0x2f, 0x70, 0x6f,
+	0x77, 0x65, 0x72, 0x67, 0x61, 0x74, 0x65, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2f, 0x76, 0x31,
+	0x3b, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x50, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -2293,7 +3595,7 @@ func file_powergate_admin_v1_admin_proto_rawDescGZIP() []byte {
 	return file_powergate_admin_v1_admin_proto_rawDescData
 }
 
-var file_powergate_admin_v1_admin_proto_msgTypes = make([]protoimpl.MessageInfo, 37)
+var file_powergate_admin_v1_admin_proto_msgTypes = make([]protoimpl.MessageInfo, 59)
 var file_powergate_admin_v1_admin_proto_goTypes = []interface{}{
 	(*NewAddressRequest)(nil),                         // 0: powergate.admin.v1.NewAddressRequest
 	(*NewAddressResponse)(nil),                        // 1: powergate.admin.v1.NewAddressResponse
@@ -2316,81 +3618,131 @@ var file_powergate_admin_v1_admin_proto_goTypes = []interface{}{
 	(*ListStorageJobsResponse)(nil),                   // 18: powergate.admin.v1.ListStorageJobsResponse
 	(*StorageJobsSummaryRequest)(nil),                 // 19: powergate.admin.v1.StorageJobsSummaryRequest
 	(*StorageJobsSummaryResponse)(nil),                // 20: powergate.admin.v1.StorageJobsSummaryResponse
-	(*GCStagedRequest)(nil),                           // 21: powergate.admin.v1.GCStagedRequest
-	(*GCStagedResponse)(nil),                          // 22: powergate.admin.v1.GCStagedResponse
-	(*PinnedCidsRequest)(nil),                         // 23: powergate.admin.v1.PinnedCidsRequest
-	(*PinnedCidsResponse)(nil),                        // 24: powergate.admin.v1.PinnedCidsResponse
-	(*HSPinnedCid)(nil),                               // 25: powergate.admin.v1.HSPinnedCid
-	(*HSPinnedCidUser)(nil),                           // 26: powergate.admin.v1.HSPinnedCidUser
-	(*GetUpdatedStorageDealRecordsSinceRequest)(nil),  // 27: powergate.admin.v1.GetUpdatedStorageDealRecordsSinceRequest
-	(*GetUpdatedStorageDealRecordsSinceResponse)(nil), // 28: powergate.admin.v1.GetUpdatedStorageDealRecordsSinceResponse
-	(*GetUpdatedRetrievalRecordsSinceRequest)(nil),    // 29: powergate.admin.v1.GetUpdatedRetrievalRecordsSinceRequest
-	(*GetUpdatedRetrievalRecordsSinceResponse)(nil),   // 30: powergate.admin.v1.GetUpdatedRetrievalRecordsSinceResponse
-	(*GetMinersRequest)(nil),                          // 31: powergate.admin.v1.GetMinersRequest
-	(*GetMinersResponse)(nil),                         // 32: powergate.admin.v1.GetMinersResponse
-	(*FilecoinMiner)(nil),                             // 33: powergate.admin.v1.FilecoinMiner
-	(*GetMinerInfoRequest)(nil),                       // 34: powergate.admin.v1.GetMinerInfoRequest
-	(*GetMinerInfoResponse)(nil),                      // 35: powergate.admin.v1.GetMinerInfoResponse
-	(*MinerInfo)(nil),                                 // 36: powergate.admin.v1.MinerInfo
-	(*v1.StorageInfo)(nil),                            // 37: powergate.user.v1.StorageInfo
-	(v1.StorageJobsSelector)(0),                       // 38: powergate.user.v1.StorageJobsSelector
-	(*v1.StorageJob)(nil),                             // 39: powergate.user.v1.StorageJob
-	(*timestamp.Timestamp)(nil),                       // 40: google.protobuf.Timestamp
-	(*v1.StorageDealRecord)(nil),                      // 41: powergate.user.v1.StorageDealRecord
-	(*v1.RetrievalDealRecord)(nil),                    // 42: powergate.user.v1.RetrievalDealRecord
+	(*SetUserFairShareRequest)(nil),                   // 21: powergate.admin.v1.SetUserFairShareRequest
+	(*SetUserFairShareResponse)(nil),                  // 22: powergate.admin.v1.SetUserFairShareResponse
+	(*RemoveUserFairShareRequest)(nil),                // 23: powergate.admin.v1.RemoveUserFairShareRequest
+	(*RemoveUserFairShareResponse)(nil),               // 24: powergate.admin.v1.RemoveUserFairShareResponse
+	(*UserFairSharesRequest)(nil),                     // 25: powergate.admin.v1.UserFairSharesRequest
+	(*UserFairSharesResponse)(nil),                    // 26: powergate.admin.v1.UserFairSharesResponse
+	(*UserFairShare)(nil),                             // 27: powergate.admin.v1.UserFairShare
+	(*SetUserBudgetRequest)(nil),                      // 28: powergate.admin.v1.SetUserBudgetRequest
+	(*SetUserBudgetResponse)(nil),                     // 29: powergate.admin.v1.SetUserBudgetResponse
+	(*UserBudgetRequest)(nil),                         // 30: powergate.admin.v1.UserBudgetRequest
+	(*UserBudgetResponse)(nil),                        // 31: powergate.admin.v1.UserBudgetResponse
+	(*PruneStorageJobsRequest)(nil),                   // 32: powergate.admin.v1.PruneStorageJobsRequest
+	(*PruneStorageJobsResponse)(nil),                  // 33: powergate.admin.v1.PruneStorageJobsResponse
+	(*DrainRequest)(nil),                              // 34: powergate.admin.v1.DrainRequest
+	(*DrainResponse)(nil),                             // 35: powergate.admin.v1.DrainResponse
+	(*UndrainRequest)(nil),                            // 36: powergate.admin.v1.UndrainRequest
+	(*UndrainResponse)(nil),                           // 37: powergate.admin.v1.UndrainResponse
+	(*DrainStatusRequest)(nil),                        // 38: powergate.admin.v1.DrainStatusRequest
+	(*DrainStatusResponse)(nil),                       // 39: powergate.admin.v1.DrainStatusResponse
+	(*GCStagedRequest)(nil),                           // 40: powergate.admin.v1.GCStagedRequest
+	(*GCStagedResponse)(nil),                          // 41: powergate.admin.v1.GCStagedResponse
+	(*PinnedCidsRequest)(nil),                         // 42: powergate.admin.v1.PinnedCidsRequest
+	(*PinnedCidsResponse)(nil),                        // 43: powergate.admin.v1.PinnedCidsResponse
+	(*HSPinnedCid)(nil),                               // 44: powergate.admin.v1.HSPinnedCid
+	(*HSPinnedCidUser)(nil),                           // 45: powergate.admin.v1.HSPinnedCidUser
+	(*GetUpdatedStorageDealRecordsSinceRequest)(nil),  // 46: powergate.admin.v1.GetUpdatedStorageDealRecordsSinceRequest
+	(*GetUpdatedStorageDealRecordsSinceResponse)(nil), // 47: powergate.admin.v1.GetUpdatedStorageDealRecordsSinceResponse
+	(*GetUpdatedRetrievalRecordsSinceRequest)(nil),    // 48: powergate.admin.v1.GetUpdatedRetrievalRecordsSinceRequest
+	(*GetUpdatedRetrievalRecordsSinceResponse)(nil),   // 49: powergate.admin.v1.GetUpdatedRetrievalRecordsSinceResponse
+	(*RetrievalMinerRank)(nil),                        // 50: powergate.admin.v1.RetrievalMinerRank
+	(*RetrievalMinerRankingRequest)(nil),              // 51: powergate.admin.v1.RetrievalMinerRankingRequest
+	(*RetrievalMinerRankingResponse)(nil),             // 52: powergate.admin.v1.RetrievalMinerRankingResponse
+	(*GetMinersRequest)(nil),                          // 53: powergate.admin.v1.GetMinersRequest
+	(*GetMinersResponse)(nil),                         // 54: powergate.admin.v1.GetMinersResponse
+	(*FilecoinMiner)(nil),                             // 55: powergate.admin.v1.FilecoinMiner
+	(*GetMinerInfoRequest)(nil),                       // 56: powergate.admin.v1.GetMinerInfoRequest
+	(*GetMinerInfoResponse)(nil),                      // 57: powergate.admin.v1.GetMinerInfoResponse
+	(*MinerInfo)(nil),                                 // 58: powergate.admin.v1.MinerInfo
+	(*v1.StorageInfo)(nil),                            // 59: powergate.user.v1.StorageInfo
+	(v1.StorageJobsSelector)(0),                       // 60: powergate.user.v1.StorageJobsSelector
+	(*v1.StorageJob)(nil),                             // 61: powergate.user.v1.StorageJob
+	(*v1.Budget)(nil),                                 // 62: powergate.user.v1.Budget
+	(*v1.BudgetStatus)(nil),                           // 63: powergate.user.v1.BudgetStatus
+	(*timestamp.Timestamp)(nil),                       // 64: google.protobuf.Timestamp
+	(*v1.StorageDealRecord)(nil),                      // 65: powergate.user.v1.StorageDealRecord
+	(*v1.RetrievalDealRecord)(nil),                    // 66: powergate.user.v1.RetrievalDealRecord
 }
 var file_powergate_admin_v1_admin_proto_depIdxs = []int32{
 	6,  // 0: powergate.admin.v1.CreateUserResponse.user:type_name -> powergate.admin.v1.User
 	6,  // 1: powergate.admin.v1.UsersResponse.users:type_name -> powergate.admin.v1.User
-	37, // 2: powergate.admin.v1.StorageInfoResponse.storage_info:type_name -> powergate.user.v1.StorageInfo
-	37, // 3: powergate.admin.v1.ListStorageInfoResponse.storage_info:type_name -> powergate.user.v1.StorageInfo
-	38, // 4: powergate.admin.v1.ListStorageJobsRequest.selector:type_name -> powergate.user.v1.StorageJobsSelector
-	39, // 5: powergate.admin.v1.ListStorageJobsResponse.storage_jobs:type_name -> powergate.user.v1.StorageJob
-	25, // 6: powergate.admin.v1.PinnedCidsResponse.cids:type_name -> powergate.admin.v1.HSPinnedCid
-	26, // 7: powergate.admin.v1.HSPinnedCid.users:type_name -> powergate.admin.v1.HSPinnedCidUser
-	40, // 8: powergate.admin.v1.GetUpdatedStorageDealRecordsSinceRequest.since:type_name -> google.protobuf.Timestamp
-	41, // 9: powergate.admin.v1.GetUpdatedStorageDealRecordsSinceResponse.records:type_name -> powergate.user.v1.StorageDealRecord
-	40, // 10: powergate.admin.v1.GetUpdatedRetrievalRecordsSinceRequest.since:type_name -> google.protobuf.Timestamp
-	42, // 11: powergate.admin.v1.GetUpdatedRetrievalRecordsSinceResponse.records:type_name -> powergate.user.v1.RetrievalDealRecord
-	33, // 12: powergate.admin.v1.GetMinersResponse.miners:type_name -> powergate.admin.v1.FilecoinMiner
-	36, // 13: powergate.admin.v1.GetMinerInfoResponse.miners_info:type_name -> powergate.admin.v1.MinerInfo
-	0,  // 14: powergate.admin.v1.AdminService.NewAddress:input_type -> powergate.admin.v1.NewAddressRequest
-	2,  // 15: powergate.admin.v1.AdminService.Addresses:input_type -> powergate.admin.v1.AddressesRequest
-	4,  // 16: powergate.admin.v1.AdminService.SendFil:input_type -> powergate.admin.v1.SendFilRequest
-	7,  // 17: powergate.admin.v1.AdminService.CreateUser:input_type -> powergate.admin.v1.CreateUserRequest
-	9,  // 18: powergate.admin.v1.AdminService.RegenerateAuth:input_type -> powergate.admin.v1.RegenerateAuthRequest
-	11, // 19: powergate.admin.v1.AdminService.Users:input_type -> powergate.admin.v1.UsersRequest
-	13, // 20: powergate.admin.v1.AdminService.StorageInfo:input_type -> powergate.admin.v1.StorageInfoRequest
-	15, // 21: powergate.admin.v1.AdminService.ListStorageInfo:input_type -> powergate.admin.v1.ListStorageInfoRequest
-	17, // 22: powergate.admin.v1.AdminService.ListStorageJobs:input_type -> powergate.admin.v1.ListStorageJobsRequest
-	19, // 23: powergate.admin.v1.AdminService.StorageJobsSummary:input_type -> powergate.admin.v1.StorageJobsSummaryRequest
-	27, // 24: powergate.admin.v1.AdminService.GetUpdatedStorageDealRecordsSince:input_type -> powergate.admin.v1.GetUpdatedStorageDealRecordsSinceRequest
-	29, // 25: powergate.admin.v1.AdminService.GetUpdatedRetrievalRecordsSince:input_type -> powergate.admin.v1.GetUpdatedRetrievalRecordsSinceRequest
-	21, // 26: powergate.admin.v1.AdminService.GCStaged:input_type -> powergate.admin.v1.GCStagedRequest
-	23, // 27: powergate.admin.v1.AdminService.PinnedCids:input_type -> powergate.admin.v1.PinnedCidsRequest
-	31, // 28: powergate.admin.v1.AdminService.GetMiners:input_type -> powergate.admin.v1.GetMinersRequest
-	34, // 29: powergate.admin.v1.AdminService.GetMinerInfo:input_type -> powergate.admin.v1.GetMinerInfoRequest
-	1,  // 30: powergate.admin.v1.AdminService.NewAddress:output_type -> powergate.admin.v1.NewAddressResponse
-	3,  // 31: powergate.admin.v1.AdminService.Addresses:output_type -> powergate.admin.v1.AddressesResponse
-	5,  // 32: powergate.admin.v1.AdminService.SendFil:output_type -> powergate.admin.v1.SendFilResponse
-	8,  // 33: powergate.admin.v1.AdminService.CreateUser:output_type -> powergate.admin.v1.CreateUserResponse
-	10, // 34: powergate.admin.v1.AdminService.RegenerateAuth:output_type -> powergate.admin.v1.RegenerateAuthResponse
-	12, // 35: powergate.admin.v1.AdminService.Users:output_type -> powergate.admin.v1.UsersResponse
-	14, // 36: powergate.admin.v1.AdminService.StorageInfo:output_type -> powergate.admin.v1.StorageInfoResponse
-	16, // 37: powergate.admin.v1.AdminService.ListStorageInfo:output_type -> powergate.admin.v1.ListStorageInfoResponse
-	18, // 38: powergate.admin.v1.AdminService.ListStorageJobs:output_type -> powergate.admin.v1.ListStorageJobsResponse
-	20, // 39: powergate.admin.v1.AdminService.StorageJobsSummary:output_type -> powergate.admin.v1.StorageJobsSummaryResponse
-	28, // 40: powergate.admin.v1.AdminService.GetUpdatedStorageDealRecordsSince:output_type -> powergate.admin.v1.GetUpdatedStorageDealRecordsSinceResponse
-	30, // 41: powergate.admin.v1.AdminService.GetUpdatedRetrievalRecordsSince:output_type -> powergate.admin.v1.GetUpdatedRetrievalRecordsSinceResponse
-	22, // 42: powergate.admin.v1.AdminService.GCStaged:output_type -> powergate.admin.v1.GCStagedResponse
-	24, // 43: powergate.admin.v1.AdminService.PinnedCids:output_type -> powergate.admin.v1.PinnedCidsResponse
-	32, // 44: powergate.admin.v1.AdminService.GetMiners:output_type -> powergate.admin.v1.GetMinersResponse
-	35, // 45: powergate.admin.v1.AdminService.GetMinerInfo:output_type -> powergate.admin.v1.GetMinerInfoResponse
-	30, // [30:46] is the sub-list for method output_type
-	14, // [14:30] is the sub-list for method input_type
-	14, // [14:14] is the sub-list for extension type_name
-	14, // [14:14] is the sub-list for extension extendee
-	0,  // [0:14] is the sub-list for field type_name
+	59, // 2: powergate.admin.v1.StorageInfoResponse.storage_info:type_name -> powergate.user.v1.StorageInfo
+	59, // 3: powergate.admin.v1.ListStorageInfoResponse.storage_info:type_name -> powergate.user.v1.StorageInfo
+	60, // 4: powergate.admin.v1.ListStorageJobsRequest.selector:type_name -> powergate.user.v1.StorageJobsSelector
+	61, // 5: powergate.admin.v1.ListStorageJobsResponse.storage_jobs:type_name -> powergate.user.v1.StorageJob
+	27, // 6: powergate.admin.v1.UserFairSharesResponse.fair_shares:type_name -> powergate.admin.v1.UserFairShare
+	62, // 7: powergate.admin.v1.SetUserBudgetRequest.budget:type_name -> powergate.user.v1.Budget
+	63, // 8: powergate.admin.v1.UserBudgetResponse.status:type_name -> powergate.user.v1.BudgetStatus
+	64, // 9: powergate.admin.v1.DrainStatusResponse.deadline:type_name -> google.protobuf.Timestamp
+	44, // 10: powergate.admin.v1.PinnedCidsResponse.cids:type_name -> powergate.admin.v1.HSPinnedCid
+	45, // 11: powergate.admin.v1.HSPinnedCid.users:type_name -> powergate.admin.v1.HSPinnedCidUser
+	64, // 12: powergate.admin.v1.GetUpdatedStorageDealRecordsSinceRequest.since:type_name -> google.protobuf.Timestamp
+	65, // 13: powergate.admin.v1.GetUpdatedStorageDealRecordsSinceResponse.records:type_name -> powergate.user.v1.StorageDealRecord
+	64, // 14: powergate.admin.v1.GetUpdatedRetrievalRecordsSinceRequest.since:type_name -> google.protobuf.Timestamp
+	66, // 15: powergate.admin.v1.GetUpdatedRetrievalRecordsSinceResponse.records:type_name -> powergate.user.v1.RetrievalDealRecord
+	64, // 16: powergate.admin.v1.RetrievalMinerRank.last_failure:type_name -> google.protobuf.Timestamp
+	50, // 17: powergate.admin.v1.RetrievalMinerRankingResponse.ranking:type_name -> powergate.admin.v1.RetrievalMinerRank
+	55, // 18: powergate.admin.v1.GetMinersResponse.miners:type_name -> powergate.admin.v1.FilecoinMiner
+	58, // 19: powergate.admin.v1.GetMinerInfoResponse.miners_info:type_name -> powergate.admin.v1.MinerInfo
+	0,  // 20: powergate.admin.v1.AdminService.NewAddress:input_type -> powergate.admin.v1.NewAddressRequest
+	2,  // 21: powergate.admin.v1.AdminService.Addresses:input_type -> powergate.admin.v1.AddressesRequest
+	4,  // 22: powergate.admin.v1.AdminService.SendFil:input_type -> powergate.admin.v1.SendFilRequest
+	7,  // 23: powergate.admin.v1.AdminService.CreateUser:input_type -> powergate.admin.v1.CreateUserRequest
+	9,  // 24: powergate.admin.v1.AdminService.RegenerateAuth:input_type -> powergate.admin.v1.RegenerateAuthRequest
+	11, // 25: powergate.admin.v1.AdminService.Users:input_type -> powergate.admin.v1.UsersRequest
+	13, // 26: powergate.admin.v1.AdminService.StorageInfo:input_type -> powergate.admin.v1.StorageInfoRequest
+	15, // 27: powergate.admin.v1.AdminService.ListStorageInfo:input_type -> powergate.admin.v1.ListStorageInfoRequest
+	17, // 28: powergate.admin.v1.AdminService.ListStorageJobs:input_type -> powergate.admin.v1.ListStorageJobsRequest
+	19, // 29: powergate.admin.v1.AdminService.StorageJobsSummary:input_type -> powergate.admin.v1.StorageJobsSummaryRequest
+	21, // 30: powergate.admin.v1.AdminService.SetUserFairShare:input_type -> powergate.admin.v1.SetUserFairShareRequest
+	23, // 31: powergate.admin.v1.AdminService.RemoveUserFairShare:input_type -> powergate.admin.v1.RemoveUserFairShareRequest
+	25, // 32: powergate.admin.v1.AdminService.UserFairShares:input_type -> powergate.admin.v1.UserFairSharesRequest
+	28, // 33: powergate.admin.v1.AdminService.SetUserBudget:input_type -> powergate.admin.v1.SetUserBudgetRequest
+	30, // 34: powergate.admin.v1.AdminService.UserBudget:input_type -> powergate.admin.v1.UserBudgetRequest
+	32, // 35: powergate.admin.v1.AdminService.PruneStorageJobs:input_type -> powergate.admin.v1.PruneStorageJobsRequest
+	34, // 36: powergate.admin.v1.AdminService.Drain:input_type -> powergate.admin.v1.DrainRequest
+	36, // 37: powergate.admin.v1.AdminService.Undrain:input_type -> powergate.admin.v1.UndrainRequest
+	38, // 38: powergate.admin.v1.AdminService.DrainStatus:input_type -> powergate.admin.v1.DrainStatusRequest
+	46, // 39: powergate.admin.v1.AdminService.GetUpdatedStorageDealRecordsSince:input_type -> powergate.admin.v1.GetUpdatedStorageDealRecordsSinceRequest
+	48, // 40: powergate.admin.v1.AdminService.GetUpdatedRetrievalRecordsSince:input_type -> powergate.admin.v1.GetUpdatedRetrievalRecordsSinceRequest
+	51, // 41: powergate.admin.v1.AdminService.RetrievalMinerRanking:input_type -> powergate.admin.v1.RetrievalMinerRankingRequest
+	40, // 42: powergate.admin.v1.AdminService.GCStaged:input_type -> powergate.admin.v1.GCStagedRequest
+	42, // 43: powergate.admin.v1.AdminService.PinnedCids:input_type -> powergate.admin.v1.PinnedCidsRequest
+	53, // 44: powergate.admin.v1.AdminService.GetMiners:input_type -> powergate.admin.v1.GetMinersRequest
+	56, // 45: powergate.admin.v1.AdminService.GetMinerInfo:input_type -> powergate.admin.v1.GetMinerInfoRequest
+	1,  // 46: powergate.admin.v1.AdminService.NewAddress:output_type -> powergate.admin.v1.NewAddressResponse
+	3,  // 47: powergate.admin.v1.AdminService.Addresses:output_type -> powergate.admin.v1.AddressesResponse
+	5,  // 48: powergate.admin.v1.AdminService.SendFil:output_type -> powergate.admin.v1.SendFilResponse
+	8,  // 49: powergate.admin.v1.AdminService.CreateUser:output_type -> powergate.admin.v1.CreateUserResponse
+	10, // 50: powergate.admin.v1.AdminService.RegenerateAuth:output_type -> powergate.admin.v1.RegenerateAuthResponse
+	12, // 51: powergate.admin.v1.AdminService.Users:output_type -> powergate.admin.v1.UsersResponse
+	14, // 52: powergate.admin.v1.AdminService.StorageInfo:output_type -> powergate.admin.v1.StorageInfoResponse
+	16, // 53: powergate.admin.v1.AdminService.ListStorageInfo:output_type -> powergate.admin.v1.ListStorageInfoResponse
+	18, // 54: powergate.admin.v1.AdminService.ListStorageJobs:output_type -> powergate.admin.v1.ListStorageJobsResponse
+	20, // 55: powergate.admin.v1.AdminService.StorageJobsSummary:output_type -> powergate.admin.v1.StorageJobsSummaryResponse
+	22, // 56: powergate.admin.v1.AdminService.SetUserFairShare:output_type -> powergate.admin.v1.SetUserFairShareResponse
+	24, // 57: powergate.admin.v1.AdminService.RemoveUserFairShare:output_type -> powergate.admin.v1.RemoveUserFairShareResponse
+	26, // 58: powergate.admin.v1.AdminService.UserFairShares:output_type -> powergate.admin.v1.UserFairSharesResponse
+	29, // 59: powergate.admin.v1.AdminService.SetUserBudget:output_type -> powergate.admin.v1.SetUserBudgetResponse
+	31, // 60: powergate.admin.v1.AdminService.UserBudget:output_type -> powergate.admin.v1.UserBudgetResponse
+	33, // 61: powergate.admin.v1.AdminService.PruneStorageJobs:output_type -> powergate.admin.v1.PruneStorageJobsResponse
+	35, // 62: powergate.admin.v1.AdminService.Drain:output_type -> powergate.admin.v1.DrainResponse
+	37, // 63: powergate.admin.v1.AdminService.Undrain:output_type -> powergate.admin.v1.UndrainResponse
+	39, // 64: powergate.admin.v1.AdminService.DrainStatus:output_type -> powergate.admin.v1.DrainStatusResponse
+	47, // 65: powergate.admin.v1.AdminService.GetUpdatedStorageDealRecordsSince:output_type -> powergate.admin.v1.GetUpdatedStorageDealRecordsSinceResponse
+	49, // 66: powergate.admin.v1.AdminService.GetUpdatedRetrievalRecordsSince:output_type -> powergate.admin.v1.GetUpdatedRetrievalRecordsSinceResponse
+	52, // 67: powergate.admin.v1.AdminService.RetrievalMinerRanking:output_type -> powergate.admin.v1.RetrievalMinerRankingResponse
+	41, // 68: powergate.admin.v1.AdminService.GCStaged:output_type -> powergate.admin.v1.GCStagedResponse
+	43, // 69: powergate.admin.v1.AdminService.PinnedCids:output_type -> powergate.admin.v1.PinnedCidsResponse
+	54, // 70: powergate.admin.v1.AdminService.GetMiners:output_type -> powergate.admin.v1.GetMinersResponse
+	57, // 71: powergate.admin.v1.AdminService.GetMinerInfo:output_type -> powergate.admin.v1.GetMinerInfoResponse
+	46, // [46:72] is the sub-list for method output_type
+	20, // [20:46] is the sub-list for method input_type
+	20, // [20:20] is the sub-list for extension type_name
+	20, // [20:20] is the sub-list for extension extendee
+	0,  // [0:20] is the sub-list for field type_name
 }
 
 func init() { file_powergate_admin_v1_admin_proto_init() }
@@ -2652,7 +4004,7 @@ func file_powergate_admin_v1_admin_proto_init() {
 			}
 		}
 		file_powergate_admin_v1_admin_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GCStagedRequest); i {
+			switch v := v.(*SetUserFairShareRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2664,7 +4016,7 @@ func file_powergate_admin_v1_admin_proto_init() {
 			}
 		}
 		file_powergate_admin_v1_admin_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GCStagedResponse); i {
+			switch v := v.(*SetUserFairShareResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2676,7 +4028,7 @@ func file_powergate_admin_v1_admin_proto_init() {
 			}
 		}
 		file_powergate_admin_v1_admin_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PinnedCidsRequest); i {
+			switch v := v.(*RemoveUserFairShareRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2688,7 +4040,7 @@ func file_powergate_admin_v1_admin_proto_init() {
 			}
 		}
 		file_powergate_admin_v1_admin_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PinnedCidsResponse); i {
+			switch v := v.(*RemoveUserFairShareResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2700,7 +4052,7 @@ func file_powergate_admin_v1_admin_proto_init() {
 			}
 		}
 		file_powergate_admin_v1_admin_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*HSPinnedCid); i {
+			switch v := v.(*UserFairSharesRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2712,7 +4064,7 @@ func file_powergate_admin_v1_admin_proto_init() {
 			}
 		}
 		file_powergate_admin_v1_admin_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*HSPinnedCidUser); i {
+			switch v := v.(*UserFairSharesResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2724,7 +4076,7 @@ func file_powergate_admin_v1_admin_proto_init() {
 			}
 		}
 		file_powergate_admin_v1_admin_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetUpdatedStorageDealRecordsSinceRequest); i {
+			switch v := v.(*UserFairShare); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2736,7 +4088,7 @@ func file_powergate_admin_v1_admin_proto_init() {
 			}
 		}
 		file_powergate_admin_v1_admin_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetUpdatedStorageDealRecordsSinceResponse); i {
+			switch v := v.(*SetUserBudgetRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2748,7 +4100,7 @@ func file_powergate_admin_v1_admin_proto_init() {
 			}
 		}
 		file_powergate_admin_v1_admin_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetUpdatedRetrievalRecordsSinceRequest); i {
+			switch v := v.(*SetUserBudgetResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2760,7 +4112,7 @@ func file_powergate_admin_v1_admin_proto_init() {
 			}
 		}
 		file_powergate_admin_v1_admin_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetUpdatedRetrievalRecordsSinceResponse); i {
+			switch v := v.(*UserBudgetRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2772,7 +4124,7 @@ func file_powergate_admin_v1_admin_proto_init() {
 			}
 		}
 		file_powergate_admin_v1_admin_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetMinersRequest); i {
+			switch v := v.(*UserBudgetResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2784,7 +4136,7 @@ func file_powergate_admin_v1_admin_proto_init() {
 			}
 		}
 		file_powergate_admin_v1_admin_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetMinersResponse); i {
+			switch v := v.(*PruneStorageJobsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2796,7 +4148,7 @@ func file_powergate_admin_v1_admin_proto_init() {
 			}
 		}
 		file_powergate_admin_v1_admin_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FilecoinMiner); i {
+			switch v := v.(*PruneStorageJobsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2808,7 +4160,7 @@ func file_powergate_admin_v1_admin_proto_init() {
 			}
 		}
 		file_powergate_admin_v1_admin_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetMinerInfoRequest); i {
+			switch v := v.(*DrainRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2820,7 +4172,7 @@ func file_powergate_admin_v1_admin_proto_init() {
 			}
 		}
 		file_powergate_admin_v1_admin_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetMinerInfoResponse); i {
+			switch v := v.(*DrainResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2832,6 +4184,270 @@ func file_powergate_admin_v1_admin_proto_init() {
 			}
 		}
 		file_powergate_admin_v1_admin_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UndrainRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_powergate_admin_v1_admin_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UndrainResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_powergate_admin_v1_admin_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DrainStatusRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_powergate_admin_v1_admin_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DrainStatusResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_powergate_admin_v1_admin_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GCStagedRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_powergate_admin_v1_admin_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GCStagedResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_powergate_admin_v1_admin_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PinnedCidsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_powergate_admin_v1_admin_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PinnedCidsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_powergate_admin_v1_admin_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HSPinnedCid); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_powergate_admin_v1_admin_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HSPinnedCidUser); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_powergate_admin_v1_admin_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetUpdatedStorageDealRecordsSinceRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_powergate_admin_v1_admin_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetUpdatedStorageDealRecordsSinceResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_powergate_admin_v1_admin_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetUpdatedRetrievalRecordsSinceRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_powergate_admin_v1_admin_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetUpdatedRetrievalRecordsSinceResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_powergate_admin_v1_admin_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RetrievalMinerRank); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_powergate_admin_v1_admin_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RetrievalMinerRankingRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_powergate_admin_v1_admin_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RetrievalMinerRankingResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_powergate_admin_v1_admin_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetMinersRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_powergate_admin_v1_admin_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetMinersResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_powergate_admin_v1_admin_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FilecoinMiner); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_powergate_admin_v1_admin_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetMinerInfoRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_powergate_admin_v1_admin_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetMinerInfoResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_powergate_admin_v1_admin_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*MinerInfo); i {
 			case 0:
 				return &v.state
@@ -2850,7 +4466,7 @@ func file_powergate_admin_v1_admin_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_powergate_admin_v1_admin_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   37,
+			NumMessages:   59,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -31,9 +31,20 @@ type AdminServiceClient interface {
 	// Storage Jobs
 	ListStorageJobs(ctx context.Context, in *ListStorageJobsRequest, opts ...grpc.CallOption) (*ListStorageJobsResponse, error)
 	StorageJobsSummary(ctx context.Context, in *StorageJobsSummaryRequest, opts ...grpc.CallOption) (*StorageJobsSummaryResponse, error)
+	SetUserFairShare(ctx context.Context, in *SetUserFairShareRequest, opts ...grpc.CallOption) (*SetUserFairShareResponse, error)
+	RemoveUserFairShare(ctx context.Context, in *RemoveUserFairShareRequest, opts ...grpc.CallOption) (*RemoveUserFairShareResponse, error)
+	UserFairShares(ctx context.Context, in *UserFairSharesRequest, opts ...grpc.CallOption) (*UserFairSharesResponse, error)
+	SetUserBudget(ctx context.Context, in *SetUserBudgetRequest, opts ...grpc.CallOption) (*SetUserBudgetResponse, error)
+	UserBudget(ctx context.Context, in *UserBudgetRequest, opts ...grpc.CallOption) (*UserBudgetResponse, error)
+	PruneStorageJobs(ctx context.Context, in *PruneStorageJobsRequest, opts ...grpc.CallOption) (*PruneStorageJobsResponse, error)
+	// Scheduler
+	Drain(ctx context.Context, in *DrainRequest, opts ...grpc.CallOption) (*DrainResponse, error)
+	Undrain(ctx context.Context, in *UndrainRequest, opts ...grpc.CallOption) (*UndrainResponse, error)
+	DrainStatus(ctx context.Context, in *DrainStatusRequest, opts ...grpc.CallOption) (*DrainStatusResponse, error)
 	// Updated Records
 	GetUpdatedStorageDealRecordsSince(ctx context.Context, in *GetUpdatedStorageDealRecordsSinceRequest, opts ...grpc.CallOption) (*GetUpdatedStorageDealRecordsSinceResponse, error)
 	GetUpdatedRetrievalRecordsSince(ctx context.Context, in *GetUpdatedRetrievalRecordsSinceRequest, opts ...grpc.CallOption) (*GetUpdatedRetrievalRecordsSinceResponse, error)
+	RetrievalMinerRanking(ctx context.Context, in *RetrievalMinerRankingRequest, opts ...grpc.CallOption) (*RetrievalMinerRankingResponse, error)
 	GCStaged(ctx context.Context, in *GCStagedRequest, opts ...grpc.CallOption) (*GCStagedResponse, error)
 	PinnedCids(ctx context.Context, in *PinnedCidsRequest, opts ...grpc.CallOption) (*PinnedCidsResponse, error)
 	// Indices
@@ -139,6 +150,87 @@ func (c *adminServiceClient) StorageJobsSummary(ctx context.Context, in *Storage
 	return out, nil
 }
 
+func (c *adminServiceClient) SetUserFairShare(ctx context.Context, in *SetUserFairShareRequest, opts ...grpc.CallOption) (*SetUserFairShareResponse, error) {
+	out := new(SetUserFairShareResponse)
+	err := c.cc.Invoke(ctx, "/powergate.admin.v1.AdminService/SetUserFairShare", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) RemoveUserFairShare(ctx context.Context, in *RemoveUserFairShareRequest, opts ...grpc.CallOption) (*RemoveUserFairShareResponse, error) {
+	out := new(RemoveUserFairShareResponse)
+	err := c.cc.Invoke(ctx, "/powergate.admin.v1.AdminService/RemoveUserFairShare", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) UserFairShares(ctx context.Context, in *UserFairSharesRequest, opts ...grpc.CallOption) (*UserFairSharesResponse, error) {
+	out := new(UserFairSharesResponse)
+	err := c.cc.Invoke(ctx, "/powergate.admin.v1.AdminService/UserFairShares", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) SetUserBudget(ctx context.Context, in *SetUserBudgetRequest, opts ...grpc.CallOption) (*SetUserBudgetResponse, error) {
+	out := new(SetUserBudgetResponse)
+	err := c.cc.Invoke(ctx, "/powergate.admin.v1.AdminService/SetUserBudget", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) UserBudget(ctx context.Context, in *UserBudgetRequest, opts ...grpc.CallOption) (*UserBudgetResponse, error) {
+	out := new(UserBudgetResponse)
+	err := c.cc.Invoke(ctx, "/powergate.admin.v1.AdminService/UserBudget", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) PruneStorageJobs(ctx context.Context, in *PruneStorageJobsRequest, opts ...grpc.CallOption) (*PruneStorageJobsResponse, error) {
+	out := new(PruneStorageJobsResponse)
+	err := c.cc.Invoke(ctx, "/powergate.admin.v1.AdminService/PruneStorageJobs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) Drain(ctx context.Context, in *DrainRequest, opts ...grpc.CallOption) (*DrainResponse, error) {
+	out := new(DrainResponse)
+	err := c.cc.Invoke(ctx, "/powergate.admin.v1.AdminService/Drain", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) Undrain(ctx context.Context, in *UndrainRequest, opts ...grpc.CallOption) (*UndrainResponse, error) {
+	out := new(UndrainResponse)
+	err := c.cc.Invoke(ctx, "/powergate.admin.v1.AdminService/Undrain", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) DrainStatus(ctx context.Context, in *DrainStatusRequest, opts ...grpc.CallOption) (*DrainStatusResponse, error) {
+	out := new(DrainStatusResponse)
+	err := c.cc.Invoke(ctx, "/powergate.admin.v1.AdminService/DrainStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *adminServiceClient) GetUpdatedStorageDealRecordsSince(ctx context.Context, in *GetUpdatedStorageDealRecordsSinceRequest, opts ...grpc.CallOption) (*GetUpdatedStorageDealRecordsSinceResponse, error) {
 	out := new(GetUpdatedStorageDealRecordsSinceResponse)
 	err := c.cc.Invoke(ctx, "/powergate.admin.v1.AdminService/GetUpdatedStorageDealRecordsSince", in, out, opts...)
@@ -157,6 +249,15 @@ func (c *adminServiceClient) GetUpdatedRetrievalRecordsSince(ctx context.Context
 	return out, nil
 }
 
+func (c *adminServiceClient) RetrievalMinerRanking(ctx context.Context, in *RetrievalMinerRankingRequest, opts ...grpc.CallOption) (*RetrievalMinerRankingResponse, error) {
+	out := new(RetrievalMinerRankingResponse)
+	err := c.cc.Invoke(ctx, "/powergate.admin.v1.AdminService/RetrievalMinerRanking", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *adminServiceClient) GCStaged(ctx context.Context, in *GCStagedRequest, opts ...grpc.CallOption) (*GCStagedResponse, error) {
 	out := new(GCStagedResponse)
 	err := c.cc.Invoke(ctx, "/powergate.admin.v1.AdminService/GCStaged", in, out, opts...)
@@ -211,9 +312,20 @@ type AdminServiceServer interface {
 	// Storage Jobs
 	ListStorageJobs(context.Context, *ListStorageJobsRequest) (*ListStorageJobsResponse, error)
 	StorageJobsSummary(context.Context, *StorageJobsSummaryRequest) (*StorageJobsSummaryResponse, error)
+	SetUserFairShare(context.Context, *SetUserFairShareRequest) (*SetUserFairShareResponse, error)
+	RemoveUserFairShare(context.Context, *RemoveUserFairShareRequest) (*RemoveUserFairShareResponse, error)
+	UserFairShares(context.Context, *UserFairSharesRequest) (*UserFairSharesResponse, error)
+	SetUserBudget(context.Context, *SetUserBudgetRequest) (*SetUserBudgetResponse, error)
+	UserBudget(context.Context, *UserBudgetRequest) (*UserBudgetResponse, error)
+	PruneStorageJobs(context.Context, *PruneStorageJobsRequest) (*PruneStorageJobsResponse, error)
+	// Scheduler
+	Drain(context.Context, *DrainRequest) (*DrainResponse, error)
+	Undrain(context.Context, *UndrainRequest) (*UndrainResponse, error)
+	DrainStatus(context.Context, *DrainStatusRequest) (*DrainStatusResponse, error)
 	// Updated Records
 	GetUpdatedStorageDealRecordsSince(context.Context, *GetUpdatedStorageDealRecordsSinceRequest) (*GetUpdatedStorageDealRecordsSinceResponse, error)
 	GetUpdatedRetrievalRecordsSince(context.Context, *GetUpdatedRetrievalRecordsSinceRequest) (*GetUpdatedRetrievalRecordsSinceResponse, error)
+	RetrievalMinerRanking(context.Context, *RetrievalMinerRankingRequest) (*RetrievalMinerRankingResponse, error)
 	GCStaged(context.Context, *GCStagedRequest) (*GCStagedResponse, error)
 	PinnedCids(context.Context, *PinnedCidsRequest) (*PinnedCidsResponse, error)
 	// Indices
@@ -256,12 +368,42 @@ func (UnimplementedAdminServiceServer) ListStorageJobs(context.Context, *ListSto
 func (UnimplementedAdminServiceServer) StorageJobsSummary(context.Context, *StorageJobsSummaryRequest) (*StorageJobsSummaryResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method StorageJobsSummary not implemented")
 }
+func (UnimplementedAdminServiceServer) SetUserFairShare(context.Context, *SetUserFairShareRequest) (*SetUserFairShareResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetUserFairShare not implemented")
+}
+func (UnimplementedAdminServiceServer) RemoveUserFairShare(context.Context, *RemoveUserFairShareRequest) (*RemoveUserFairShareResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveUserFairShare not implemented")
+}
+func (UnimplementedAdminServiceServer) UserFairShares(context.Context, *UserFairSharesRequest) (*UserFairSharesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UserFairShares not implemented")
+}
+func (UnimplementedAdminServiceServer) SetUserBudget(context.Context, *SetUserBudgetRequest) (*SetUserBudgetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetUserBudget not implemented")
+}
+func (UnimplementedAdminServiceServer) UserBudget(context.Context, *UserBudgetRequest) (*UserBudgetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UserBudget not implemented")
+}
+func (UnimplementedAdminServiceServer) PruneStorageJobs(context.Context, *PruneStorageJobsRequest) (*PruneStorageJobsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PruneStorageJobs not implemented")
+}
+func (UnimplementedAdminServiceServer) Drain(context.Context, *DrainRequest) (*DrainResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Drain not implemented")
+}
+func (UnimplementedAdminServiceServer) Undrain(context.Context, *UndrainRequest) (*UndrainResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Undrain not implemented")
+}
+func (UnimplementedAdminServiceServer) DrainStatus(context.Context, *DrainStatusRequest) (*DrainStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DrainStatus not implemented")
+}
 func (UnimplementedAdminServiceServer) GetUpdatedStorageDealRecordsSince(context.Context, *GetUpdatedStorageDealRecordsSinceRequest) (*GetUpdatedStorageDealRecordsSinceResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetUpdatedStorageDealRecordsSince not implemented")
 }
 func (UnimplementedAdminServiceServer) GetUpdatedRetrievalRecordsSince(context.Context, *GetUpdatedRetrievalRecordsSinceRequest) (*GetUpdatedRetrievalRecordsSinceResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetUpdatedRetrievalRecordsSince not implemented")
 }
+func (UnimplementedAdminServiceServer) RetrievalMinerRanking(context.Context, *RetrievalMinerRankingRequest) (*RetrievalMinerRankingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RetrievalMinerRanking not implemented")
+}
 func (UnimplementedAdminServiceServer) GCStaged(context.Context, *GCStagedRequest) (*GCStagedResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GCStaged not implemented")
 }
@@ -467,6 +609,168 @@ func _AdminService_StorageJobsSummary_Handler(srv interface{}, ctx context.Conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AdminService_SetUserFairShare_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetUserFairShareRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).SetUserFairShare(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/powergate.admin.v1.AdminService/SetUserFairShare",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).SetUserFairShare(ctx, req.(*SetUserFairShareRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_RemoveUserFairShare_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveUserFairShareRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).RemoveUserFairShare(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/powergate.admin.v1.AdminService/RemoveUserFairShare",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).RemoveUserFairShare(ctx, req.(*RemoveUserFairShareRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_UserFairShares_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UserFairSharesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).UserFairShares(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/powergate.admin.v1.AdminService/UserFairShares",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).UserFairShares(ctx, req.(*UserFairSharesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_SetUserBudget_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetUserBudgetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).SetUserBudget(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/powergate.admin.v1.AdminService/SetUserBudget",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).SetUserBudget(ctx, req.(*SetUserBudgetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_UserBudget_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UserBudgetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).UserBudget(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/powergate.admin.v1.AdminService/UserBudget",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).UserBudget(ctx, req.(*UserBudgetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_PruneStorageJobs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PruneStorageJobsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).PruneStorageJobs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/powergate.admin.v1.AdminService/PruneStorageJobs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).PruneStorageJobs(ctx, req.(*PruneStorageJobsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_Drain_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DrainRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).Drain(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/powergate.admin.v1.AdminService/Drain",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).Drain(ctx, req.(*DrainRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_Undrain_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UndrainRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).Undrain(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/powergate.admin.v1.AdminService/Undrain",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).Undrain(ctx, req.(*UndrainRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_DrainStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DrainStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).DrainStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/powergate.admin.v1.AdminService/DrainStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).DrainStatus(ctx, req.(*DrainStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _AdminService_GetUpdatedStorageDealRecordsSince_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetUpdatedStorageDealRecordsSinceRequest)
 	if err := dec(in); err != nil {
@@ -503,6 +807,24 @@ func _AdminService_GetUpdatedRetrievalRecordsSince_Handler(srv interface{}, ctx
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AdminService_RetrievalMinerRanking_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RetrievalMinerRankingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).RetrievalMinerRanking(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/powergate.admin.v1.AdminService/RetrievalMinerRanking",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).RetrievalMinerRanking(ctx, req.(*RetrievalMinerRankingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _AdminService_GCStaged_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GCStagedRequest)
 	if err := dec(in); err != nil {
@@ -619,6 +941,42 @@ var _AdminService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "StorageJobsSummary",
 			Handler:    _AdminService_StorageJobsSummary_Handler,
 		},
+		{
+			MethodName: "SetUserFairShare",
+			Handler:    _AdminService_SetUserFairShare_Handler,
+		},
+		{
+			MethodName: "RemoveUserFairShare",
+			Handler:    _AdminService_RemoveUserFairShare_Handler,
+		},
+		{
+			MethodName: "UserFairShares",
+			Handler:    _AdminService_UserFairShares_Handler,
+		},
+		{
+			MethodName: "SetUserBudget",
+			Handler:    _AdminService_SetUserBudget_Handler,
+		},
+		{
+			MethodName: "UserBudget",
+			Handler:    _AdminService_UserBudget_Handler,
+		},
+		{
+			MethodName: "PruneStorageJobs",
+			Handler:    _AdminService_PruneStorageJobs_Handler,
+		},
+		{
+			MethodName: "Drain",
+			Handler:    _AdminService_Drain_Handler,
+		},
+		{
+			MethodName: "Undrain",
+			Handler:    _AdminService_Undrain_Handler,
+		},
+		{
+			MethodName: "DrainStatus",
+			Handler:    _AdminService_DrainStatus_Handler,
+		},
 		{
 			MethodName: "GetUpdatedStorageDealRecordsSince",
 			Handler:    _AdminService_GetUpdatedStorageDealRecordsSince_Handler,
@@ -627,6 +985,10 @@ var _AdminService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetUpdatedRetrievalRecordsSince",
 			Handler:    _AdminService_GetUpdatedRetrievalRecordsSince_Handler,
 		},
+		{
+			MethodName: "RetrievalMinerRanking",
+			Handler:    _AdminService_RetrievalMinerRanking_Handler,
+		},
 		{
 			MethodName: "GCStaged",
 			Handler:    _AdminService_GCStaged_Handler,
@@ -24,10 +24,16 @@ type UserServiceClient interface {
 	DefaultStorageConfig(ctx context.Context, in *DefaultStorageConfigRequest, opts ...grpc.CallOption) (*DefaultStorageConfigResponse, error)
 	SetDefaultStorageConfig(ctx context.Context, in *SetDefaultStorageConfigRequest, opts ...grpc.CallOption) (*SetDefaultStorageConfigResponse, error)
 	ApplyStorageConfig(ctx context.Context, in *ApplyStorageConfigRequest, opts ...grpc.CallOption) (*ApplyStorageConfigResponse, error)
+	PlanStorageConfig(ctx context.Context, in *PlanStorageConfigRequest, opts ...grpc.CallOption) (*PlanStorageConfigResponse, error)
 	Remove(ctx context.Context, in *RemoveRequest, opts ...grpc.CallOption) (*RemoveResponse, error)
 	// Data
 	Stage(ctx context.Context, opts ...grpc.CallOption) (UserService_StageClient, error)
 	StageCid(ctx context.Context, in *StageCidRequest, opts ...grpc.CallOption) (*StageCidResponse, error)
+	StageCar(ctx context.Context, opts ...grpc.CallOption) (UserService_StageCarClient, error)
+	CreateUploadSession(ctx context.Context, in *CreateUploadSessionRequest, opts ...grpc.CallOption) (*CreateUploadSessionResponse, error)
+	UploadChunk(ctx context.Context, in *UploadChunkRequest, opts ...grpc.CallOption) (*UploadChunkResponse, error)
+	UploadSessionStatus(ctx context.Context, in *UploadSessionStatusRequest, opts ...grpc.CallOption) (*UploadSessionStatusResponse, error)
+	FinalizeUploadSession(ctx context.Context, in *FinalizeUploadSessionRequest, opts ...grpc.CallOption) (*FinalizeUploadSessionResponse, error)
 	ReplaceData(ctx context.Context, in *ReplaceDataRequest, opts ...grpc.CallOption) (*ReplaceDataResponse, error)
 	Get(ctx context.Context, in *GetRequest, opts ...grpc.CallOption) (UserService_GetClient, error)
 	WatchLogs(ctx context.Context, in *WatchLogsRequest, opts ...grpc.CallOption) (UserService_WatchLogsClient, error)
@@ -53,6 +59,19 @@ type UserServiceClient interface {
 	// Deals
 	StorageDealRecords(ctx context.Context, in *StorageDealRecordsRequest, opts ...grpc.CallOption) (*StorageDealRecordsResponse, error)
 	RetrievalDealRecords(ctx context.Context, in *RetrievalDealRecordsRequest, opts ...grpc.CallOption) (*RetrievalDealRecordsResponse, error)
+	// Retrievals
+	StartRetrieval(ctx context.Context, in *StartRetrievalRequest, opts ...grpc.CallOption) (*StartRetrievalResponse, error)
+	GetRetrieval(ctx context.Context, in *GetRetrievalRequest, opts ...grpc.CallOption) (*GetRetrievalResponse, error)
+	WatchRetrievalJobs(ctx context.Context, in *WatchRetrievalJobsRequest, opts ...grpc.CallOption) (UserService_WatchRetrievalJobsClient, error)
+	CancelRetrievalJob(ctx context.Context, in *CancelRetrievalJobRequest, opts ...grpc.CallOption) (*CancelRetrievalJobResponse, error)
+	RemoveRetrieval(ctx context.Context, in *RemoveRetrievalRequest, opts ...grpc.CallOption) (*RemoveRetrievalResponse, error)
+	// Webhooks
+	RegisterWebhook(ctx context.Context, in *RegisterWebhookRequest, opts ...grpc.CallOption) (*RegisterWebhookResponse, error)
+	Webhooks(ctx context.Context, in *WebhooksRequest, opts ...grpc.CallOption) (*WebhooksResponse, error)
+	RemoveWebhook(ctx context.Context, in *RemoveWebhookRequest, opts ...grpc.CallOption) (*RemoveWebhookResponse, error)
+	// Budgets
+	SetBudget(ctx context.Context, in *SetBudgetRequest, opts ...grpc.CallOption) (*SetBudgetResponse, error)
+	Budget(ctx context.Context, in *BudgetRequest, opts ...grpc.CallOption) (*BudgetResponse, error)
 }
 
 type userServiceClient struct {
@@ -108,6 +127,15 @@ func (c *userServiceClient) ApplyStorageConfig(ctx context.Context, in *ApplySto
 	return out, nil
 }
 
+func (c *userServiceClient) PlanStorageConfig(ctx context.Context, in *PlanStorageConfigRequest, opts ...grpc.CallOption) (*PlanStorageConfigResponse, error) {
+	out := new(PlanStorageConfigResponse)
+	err := c.cc.Invoke(ctx, "/powergate.user.v1.UserService/PlanStorageConfig", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *userServiceClient) Remove(ctx context.Context, in *RemoveRequest, opts ...grpc.CallOption) (*RemoveResponse, error) {
 	out := new(RemoveResponse)
 	err := c.cc.Invoke(ctx, "/powergate.user.v1.UserService/Remove", in, out, opts...)
@@ -160,6 +188,76 @@ func (c *userServiceClient) StageCid(ctx context.Context, in *StageCidRequest, o
 	return out, nil
 }
 
+func (c *userServiceClient) StageCar(ctx context.Context, opts ...grpc.CallOption) (UserService_StageCarClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_UserService_serviceDesc.Streams[1], "/powergate.user.v1.UserService/StageCar", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &userServiceStageCarClient{stream}
+	return x, nil
+}
+
+type UserService_StageCarClient interface {
+	Send(*StageCarRequest) error
+	CloseAndRecv() (*StageCarResponse, error)
+	grpc.ClientStream
+}
+
+type userServiceStageCarClient struct {
+	grpc.ClientStream
+}
+
+func (x *userServiceStageCarClient) Send(m *StageCarRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *userServiceStageCarClient) CloseAndRecv() (*StageCarResponse, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	m := new(StageCarResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *userServiceClient) CreateUploadSession(ctx context.Context, in *CreateUploadSessionRequest, opts ...grpc.CallOption) (*CreateUploadSessionResponse, error) {
+	out := new(CreateUploadSessionResponse)
+	err := c.cc.Invoke(ctx, "/powergate.user.v1.UserService/CreateUploadSession", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) UploadChunk(ctx context.Context, in *UploadChunkRequest, opts ...grpc.CallOption) (*UploadChunkResponse, error) {
+	out := new(UploadChunkResponse)
+	err := c.cc.Invoke(ctx, "/powergate.user.v1.UserService/UploadChunk", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) UploadSessionStatus(ctx context.Context, in *UploadSessionStatusRequest, opts ...grpc.CallOption) (*UploadSessionStatusResponse, error) {
+	out := new(UploadSessionStatusResponse)
+	err := c.cc.Invoke(ctx, "/powergate.user.v1.UserService/UploadSessionStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) FinalizeUploadSession(ctx context.Context, in *FinalizeUploadSessionRequest, opts ...grpc.CallOption) (*FinalizeUploadSessionResponse, error) {
+	out := new(FinalizeUploadSessionResponse)
+	err := c.cc.Invoke(ctx, "/powergate.user.v1.UserService/FinalizeUploadSession", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *userServiceClient) ReplaceData(ctx context.Context, in *ReplaceDataRequest, opts ...grpc.CallOption) (*ReplaceDataResponse, error) {
 	out := new(ReplaceDataResponse)
 	err := c.cc.Invoke(ctx, "/powergate.user.v1.UserService/ReplaceData", in, out, opts...)
@@ -170,7 +268,7 @@ func (c *userServiceClient) ReplaceData(ctx context.Context, in *ReplaceDataRequ
 }
 
 func (c *userServiceClient) Get(ctx context.Context, in *GetRequest, opts ...grpc.CallOption) (UserService_GetClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_UserService_serviceDesc.Streams[1], "/powergate.user.v1.UserService/Get", opts...)
+	stream, err := c.cc.NewStream(ctx, &_UserService_serviceDesc.Streams[2], "/powergate.user.v1.UserService/Get", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -202,7 +300,7 @@ func (x *userServiceGetClient) Recv() (*GetResponse, error) {
 }
 
 func (c *userServiceClient) WatchLogs(ctx context.Context, in *WatchLogsRequest, opts ...grpc.CallOption) (UserService_WatchLogsClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_UserService_serviceDesc.Streams[2], "/powergate.user.v1.UserService/WatchLogs", opts...)
+	stream, err := c.cc.NewStream(ctx, &_UserService_serviceDesc.Streams[3], "/powergate.user.v1.UserService/WatchLogs", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -360,7 +458,7 @@ func (c *userServiceClient) StorageJobsSummary(ctx context.Context, in *StorageJ
 }
 
 func (c *userServiceClient) WatchStorageJobs(ctx context.Context, in *WatchStorageJobsRequest, opts ...grpc.CallOption) (UserService_WatchStorageJobsClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_UserService_serviceDesc.Streams[3], "/powergate.user.v1.UserService/WatchStorageJobs", opts...)
+	stream, err := c.cc.NewStream(ctx, &_UserService_serviceDesc.Streams[4], "/powergate.user.v1.UserService/WatchStorageJobs", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -418,6 +516,119 @@ func (c *userServiceClient) RetrievalDealRecords(ctx context.Context, in *Retrie
 	return out, nil
 }
 
+func (c *userServiceClient) StartRetrieval(ctx context.Context, in *StartRetrievalRequest, opts ...grpc.CallOption) (*StartRetrievalResponse, error) {
+	out := new(StartRetrievalResponse)
+	err := c.cc.Invoke(ctx, "/powergate.user.v1.UserService/StartRetrieval", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) GetRetrieval(ctx context.Context, in *GetRetrievalRequest, opts ...grpc.CallOption) (*GetRetrievalResponse, error) {
+	out := new(GetRetrievalResponse)
+	err := c.cc.Invoke(ctx, "/powergate.user.v1.UserService/GetRetrieval", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) WatchRetrievalJobs(ctx context.Context, in *WatchRetrievalJobsRequest, opts ...grpc.CallOption) (UserService_WatchRetrievalJobsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_UserService_serviceDesc.Streams[5], "/powergate.user.v1.UserService/WatchRetrievalJobs", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &userServiceWatchRetrievalJobsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type UserService_WatchRetrievalJobsClient interface {
+	Recv() (*WatchRetrievalJobsResponse, error)
+	grpc.ClientStream
+}
+
+type userServiceWatchRetrievalJobsClient struct {
+	grpc.ClientStream
+}
+
+func (x *userServiceWatchRetrievalJobsClient) Recv() (*WatchRetrievalJobsResponse, error) {
+	m := new(WatchRetrievalJobsResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *userServiceClient) CancelRetrievalJob(ctx context.Context, in *CancelRetrievalJobRequest, opts ...grpc.CallOption) (*CancelRetrievalJobResponse, error) {
+	out := new(CancelRetrievalJobResponse)
+	err := c.cc.Invoke(ctx, "/powergate.user.v1.UserService/CancelRetrievalJob", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) RemoveRetrieval(ctx context.Context, in *RemoveRetrievalRequest, opts ...grpc.CallOption) (*RemoveRetrievalResponse, error) {
+	out := new(RemoveRetrievalResponse)
+	err := c.cc.Invoke(ctx, "/powergate.user.v1.UserService/RemoveRetrieval", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) RegisterWebhook(ctx context.Context, in *RegisterWebhookRequest, opts ...grpc.CallOption) (*RegisterWebhookResponse, error) {
+	out := new(RegisterWebhookResponse)
+	err := c.cc.Invoke(ctx, "/powergate.user.v1.UserService/RegisterWebhook", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) Webhooks(ctx context.Context, in *WebhooksRequest, opts ...grpc.CallOption) (*WebhooksResponse, error) {
+	out := new(WebhooksResponse)
+	err := c.cc.Invoke(ctx, "/powergate.user.v1.UserService/Webhooks", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) RemoveWebhook(ctx context.Context, in *RemoveWebhookRequest, opts ...grpc.CallOption) (*RemoveWebhookResponse, error) {
+	out := new(RemoveWebhookResponse)
+	err := c.cc.Invoke(ctx, "/powergate.user.v1.UserService/RemoveWebhook", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) SetBudget(ctx context.Context, in *SetBudgetRequest, opts ...grpc.CallOption) (*SetBudgetResponse, error) {
+	out := new(SetBudgetResponse)
+	err := c.cc.Invoke(ctx, "/powergate.user.v1.UserService/SetBudget", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) Budget(ctx context.Context, in *BudgetRequest, opts ...grpc.CallOption) (*BudgetResponse, error) {
+	out := new(BudgetResponse)
+	err := c.cc.Invoke(ctx, "/powergate.user.v1.UserService/Budget", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // UserServiceServer is the server API for UserService service.
 // All implementations must embed UnimplementedUserServiceServer
 // for forward compatibility
@@ -429,10 +640,16 @@ type UserServiceServer interface {
 	DefaultStorageConfig(context.Context, *DefaultStorageConfigRequest) (*DefaultStorageConfigResponse, error)
 	SetDefaultStorageConfig(context.Context, *SetDefaultStorageConfigRequest) (*SetDefaultStorageConfigResponse, error)
 	ApplyStorageConfig(context.Context, *ApplyStorageConfigRequest) (*ApplyStorageConfigResponse, error)
+	PlanStorageConfig(context.Context, *PlanStorageConfigRequest) (*PlanStorageConfigResponse, error)
 	Remove(context.Context, *RemoveRequest) (*RemoveResponse, error)
 	// Data
 	Stage(UserService_StageServer) error
 	StageCid(context.Context, *StageCidRequest) (*StageCidResponse, error)
+	StageCar(UserService_StageCarServer) error
+	CreateUploadSession(context.Context, *CreateUploadSessionRequest) (*CreateUploadSessionResponse, error)
+	UploadChunk(context.Context, *UploadChunkRequest) (*UploadChunkResponse, error)
+	UploadSessionStatus(context.Context, *UploadSessionStatusRequest) (*UploadSessionStatusResponse, error)
+	FinalizeUploadSession(context.Context, *FinalizeUploadSessionRequest) (*FinalizeUploadSessionResponse, error)
 	ReplaceData(context.Context, *ReplaceDataRequest) (*ReplaceDataResponse, error)
 	Get(*GetRequest, UserService_GetServer) error
 	WatchLogs(*WatchLogsRequest, UserService_WatchLogsServer) error
@@ -458,6 +675,19 @@ type UserServiceServer interface {
 	// Deals
 	StorageDealRecords(context.Context, *StorageDealRecordsRequest) (*StorageDealRecordsResponse, error)
 	RetrievalDealRecords(context.Context, *RetrievalDealRecordsRequest) (*RetrievalDealRecordsResponse, error)
+	// Retrievals
+	StartRetrieval(context.Context, *StartRetrievalRequest) (*StartRetrievalResponse, error)
+	GetRetrieval(context.Context, *GetRetrievalRequest) (*GetRetrievalResponse, error)
+	WatchRetrievalJobs(*WatchRetrievalJobsRequest, UserService_WatchRetrievalJobsServer) error
+	CancelRetrievalJob(context.Context, *CancelRetrievalJobRequest) (*CancelRetrievalJobResponse, error)
+	RemoveRetrieval(context.Context, *RemoveRetrievalRequest) (*RemoveRetrievalResponse, error)
+	// Webhooks
+	RegisterWebhook(context.Context, *RegisterWebhookRequest) (*RegisterWebhookResponse, error)
+	Webhooks(context.Context, *WebhooksRequest) (*WebhooksResponse, error)
+	RemoveWebhook(context.Context, *RemoveWebhookRequest) (*RemoveWebhookResponse, error)
+	// Budgets
+	SetBudget(context.Context, *SetBudgetRequest) (*SetBudgetResponse, error)
+	Budget(context.Context, *BudgetRequest) (*BudgetResponse, error)
 	mustEmbedUnimplementedUserServiceServer()
 }
 
@@ -480,6 +710,9 @@ func (UnimplementedUserServiceServer) SetDefaultStorageConfig(context.Context, *
 func (UnimplementedUserServiceServer) ApplyStorageConfig(context.Context, *ApplyStorageConfigRequest) (*ApplyStorageConfigResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ApplyStorageConfig not implemented")
 }
+func (UnimplementedUserServiceServer) PlanStorageConfig(context.Context, *PlanStorageConfigRequest) (*PlanStorageConfigResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PlanStorageConfig not implemented")
+}
 func (UnimplementedUserServiceServer) Remove(context.Context, *RemoveRequest) (*RemoveResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Remove not implemented")
 }
@@ -489,6 +722,21 @@ func (UnimplementedUserServiceServer) Stage(UserService_StageServer) error {
 func (UnimplementedUserServiceServer) StageCid(context.Context, *StageCidRequest) (*StageCidResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method StageCid not implemented")
 }
+func (UnimplementedUserServiceServer) StageCar(UserService_StageCarServer) error {
+	return status.Errorf(codes.Unimplemented, "method StageCar not implemented")
+}
+func (UnimplementedUserServiceServer) CreateUploadSession(context.Context, *CreateUploadSessionRequest) (*CreateUploadSessionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateUploadSession not implemented")
+}
+func (UnimplementedUserServiceServer) UploadChunk(context.Context, *UploadChunkRequest) (*UploadChunkResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UploadChunk not implemented")
+}
+func (UnimplementedUserServiceServer) UploadSessionStatus(context.Context, *UploadSessionStatusRequest) (*UploadSessionStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UploadSessionStatus not implemented")
+}
+func (UnimplementedUserServiceServer) FinalizeUploadSession(context.Context, *FinalizeUploadSessionRequest) (*FinalizeUploadSessionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FinalizeUploadSession not implemented")
+}
 func (UnimplementedUserServiceServer) ReplaceData(context.Context, *ReplaceDataRequest) (*ReplaceDataResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ReplaceData not implemented")
 }
@@ -552,6 +800,36 @@ func (UnimplementedUserServiceServer) StorageDealRecords(context.Context, *Stora
 func (UnimplementedUserServiceServer) RetrievalDealRecords(context.Context, *RetrievalDealRecordsRequest) (*RetrievalDealRecordsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RetrievalDealRecords not implemented")
 }
+func (UnimplementedUserServiceServer) StartRetrieval(context.Context, *StartRetrievalRequest) (*StartRetrievalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StartRetrieval not implemented")
+}
+func (UnimplementedUserServiceServer) GetRetrieval(context.Context, *GetRetrievalRequest) (*GetRetrievalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRetrieval not implemented")
+}
+func (UnimplementedUserServiceServer) WatchRetrievalJobs(*WatchRetrievalJobsRequest, UserService_WatchRetrievalJobsServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchRetrievalJobs not implemented")
+}
+func (UnimplementedUserServiceServer) CancelRetrievalJob(context.Context, *CancelRetrievalJobRequest) (*CancelRetrievalJobResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelRetrievalJob not implemented")
+}
+func (UnimplementedUserServiceServer) RemoveRetrieval(context.Context, *RemoveRetrievalRequest) (*RemoveRetrievalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveRetrieval not implemented")
+}
+func (UnimplementedUserServiceServer) RegisterWebhook(context.Context, *RegisterWebhookRequest) (*RegisterWebhookResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterWebhook not implemented")
+}
+func (UnimplementedUserServiceServer) Webhooks(context.Context, *WebhooksRequest) (*WebhooksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Webhooks not implemented")
+}
+func (UnimplementedUserServiceServer) RemoveWebhook(context.Context, *RemoveWebhookRequest) (*RemoveWebhookResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveWebhook not implemented")
+}
+func (UnimplementedUserServiceServer) SetBudget(context.Context, *SetBudgetRequest) (*SetBudgetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetBudget not implemented")
+}
+func (UnimplementedUserServiceServer) Budget(context.Context, *BudgetRequest) (*BudgetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Budget not implemented")
+}
 func (UnimplementedUserServiceServer) mustEmbedUnimplementedUserServiceServer() {}
 
 // UnsafeUserServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -655,6 +933,24 @@ func _UserService_ApplyStorageConfig_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _UserService_PlanStorageConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PlanStorageConfigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).PlanStorageConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/powergate.user.v1.UserService/PlanStorageConfig",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).PlanStorageConfig(ctx, req.(*PlanStorageConfigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _UserService_Remove_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(RemoveRequest)
 	if err := dec(in); err != nil {
@@ -717,6 +1013,104 @@ func _UserService_StageCid_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _UserService_StageCar_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(UserServiceServer).StageCar(&userServiceStageCarServer{stream})
+}
+
+type UserService_StageCarServer interface {
+	SendAndClose(*StageCarResponse) error
+	Recv() (*StageCarRequest, error)
+	grpc.ServerStream
+}
+
+type userServiceStageCarServer struct {
+	grpc.ServerStream
+}
+
+func (x *userServiceStageCarServer) SendAndClose(m *StageCarResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *userServiceStageCarServer) Recv() (*StageCarRequest, error) {
+	m := new(StageCarRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _UserService_CreateUploadSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateUploadSessionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).CreateUploadSession(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/powergate.user.v1.UserService/CreateUploadSession",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).CreateUploadSession(ctx, req.(*CreateUploadSessionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_UploadChunk_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UploadChunkRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).UploadChunk(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/powergate.user.v1.UserService/UploadChunk",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).UploadChunk(ctx, req.(*UploadChunkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_UploadSessionStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UploadSessionStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).UploadSessionStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/powergate.user.v1.UserService/UploadSessionStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).UploadSessionStatus(ctx, req.(*UploadSessionStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_FinalizeUploadSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FinalizeUploadSessionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).FinalizeUploadSession(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/powergate.user.v1.UserService/FinalizeUploadSession",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).FinalizeUploadSession(ctx, req.(*FinalizeUploadSessionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _UserService_ReplaceData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ReplaceDataRequest)
 	if err := dec(in); err != nil {
@@ -1104,6 +1498,189 @@ func _UserService_RetrievalDealRecords_Handler(srv interface{}, ctx context.Cont
 	return interceptor(ctx, in, info, handler)
 }
 
+func _UserService_StartRetrieval_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StartRetrievalRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).StartRetrieval(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/powergate.user.v1.UserService/StartRetrieval",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).StartRetrieval(ctx, req.(*StartRetrievalRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_GetRetrieval_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRetrievalRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).GetRetrieval(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/powergate.user.v1.UserService/GetRetrieval",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).GetRetrieval(ctx, req.(*GetRetrievalRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_WatchRetrievalJobs_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchRetrievalJobsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(UserServiceServer).WatchRetrievalJobs(m, &userServiceWatchRetrievalJobsServer{stream})
+}
+
+type UserService_WatchRetrievalJobsServer interface {
+	Send(*WatchRetrievalJobsResponse) error
+	grpc.ServerStream
+}
+
+type userServiceWatchRetrievalJobsServer struct {
+	grpc.ServerStream
+}
+
+func (x *userServiceWatchRetrievalJobsServer) Send(m *WatchRetrievalJobsResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _UserService_CancelRetrievalJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelRetrievalJobRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).CancelRetrievalJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/powergate.user.v1.UserService/CancelRetrievalJob",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).CancelRetrievalJob(ctx, req.(*CancelRetrievalJobRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_RemoveRetrieval_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveRetrievalRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).RemoveRetrieval(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/powergate.user.v1.UserService/RemoveRetrieval",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).RemoveRetrieval(ctx, req.(*RemoveRetrievalRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_RegisterWebhook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RegisterWebhookRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).RegisterWebhook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/powergate.user.v1.UserService/RegisterWebhook",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).RegisterWebhook(ctx, req.(*RegisterWebhookRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_Webhooks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WebhooksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).Webhooks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/powergate.user.v1.UserService/Webhooks",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).Webhooks(ctx, req.(*WebhooksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_RemoveWebhook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveWebhookRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).RemoveWebhook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/powergate.user.v1.UserService/RemoveWebhook",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).RemoveWebhook(ctx, req.(*RemoveWebhookRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_SetBudget_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetBudgetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).SetBudget(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/powergate.user.v1.UserService/SetBudget",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).SetBudget(ctx, req.(*SetBudgetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_Budget_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BudgetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).Budget(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/powergate.user.v1.UserService/Budget",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).Budget(ctx, req.(*BudgetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _UserService_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "powergate.user.v1.UserService",
 	HandlerType: (*UserServiceServer)(nil),
@@ -1128,6 +1705,10 @@ var _UserService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ApplyStorageConfig",
 			Handler:    _UserService_ApplyStorageConfig_Handler,
 		},
+		{
+			MethodName: "PlanStorageConfig",
+			Handler:    _UserService_PlanStorageConfig_Handler,
+		},
 		{
 			MethodName: "Remove",
 			Handler:    _UserService_Remove_Handler,
@@ -1136,6 +1717,22 @@ var _UserService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "StageCid",
 			Handler:    _UserService_StageCid_Handler,
 		},
+		{
+			MethodName: "CreateUploadSession",
+			Handler:    _UserService_CreateUploadSession_Handler,
+		},
+		{
+			MethodName: "UploadChunk",
+			Handler:    _UserService_UploadChunk_Handler,
+		},
+		{
+			MethodName: "UploadSessionStatus",
+			Handler:    _UserService_UploadSessionStatus_Handler,
+		},
+		{
+			MethodName: "FinalizeUploadSession",
+			Handler:    _UserService_FinalizeUploadSession_Handler,
+		},
 		{
 			MethodName: "ReplaceData",
 			Handler:    _UserService_ReplaceData_Handler,
@@ -1208,6 +1805,42 @@ var _UserService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "RetrievalDealRecords",
 			Handler:    _UserService_RetrievalDealRecords_Handler,
 		},
+		{
+			MethodName: "StartRetrieval",
+			Handler:    _UserService_StartRetrieval_Handler,
+		},
+		{
+			MethodName: "GetRetrieval",
+			Handler:    _UserService_GetRetrieval_Handler,
+		},
+		{
+			MethodName: "CancelRetrievalJob",
+			Handler:    _UserService_CancelRetrievalJob_Handler,
+		},
+		{
+			MethodName: "RemoveRetrieval",
+			Handler:    _UserService_RemoveRetrieval_Handler,
+		},
+		{
+			MethodName: "RegisterWebhook",
+			Handler:    _UserService_RegisterWebhook_Handler,
+		},
+		{
+			MethodName: "Webhooks",
+			Handler:    _UserService_Webhooks_Handler,
+		},
+		{
+			MethodName: "RemoveWebhook",
+			Handler:    _UserService_RemoveWebhook_Handler,
+		},
+		{
+			MethodName: "SetBudget",
+			Handler:    _UserService_SetBudget_Handler,
+		},
+		{
+			MethodName: "Budget",
+			Handler:    _UserService_Budget_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -1215,6 +1848,11 @@ var _UserService_serviceDesc = grpc.ServiceDesc{
 			Handler:       _UserService_Stage_Handler,
 			ClientStreams: true,
 		},
+		{
+			StreamName:    "StageCar",
+			Handler:       _UserService_StageCar_Handler,
+			ClientStreams: true,
+		},
 		{
 			StreamName:    "Get",
 			Handler:       _UserService_Get_Handler,
@@ -1230,6 +1868,11 @@ var _UserService_serviceDesc = grpc.ServiceDesc{
 			Handler:       _UserService_WatchStorageJobs_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "WatchRetrievalJobs",
+			Handler:       _UserService_WatchRetrievalJobs_Handler,
+			ServerStreams: true,
+		},
 	},
 	Metadata: "powergate/user/v1/user.proto",
 }
@@ -0,0 +1,52 @@
+package admin
+
+import (
+	"context"
+
+	"github.com/ipfs/go-cid"
+	adminPb "github.com/textileio/powergate/v2/api/gen/powergate/admin/v1"
+	su "github.com/textileio/powergate/v2/api/server/util"
+	"github.com/textileio/powergate/v2/ffs"
+	"github.com/textileio/powergate/v2/ffs/budgets"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// SetUserBudget sets the spending limits of a user. They apply together with
+// the limits set by the user itself, using the most restrictive ones.
+func (a *Service) SetUserBudget(ctx context.Context, req *adminPb.SetUserBudgetRequest) (*adminPb.SetUserBudgetResponse, error) {
+	iid := ffs.APIID(req.UserId)
+	if !iid.Valid() {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid user id")
+	}
+	b, err := su.FromRPCBudget(req.Budget)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "parsing budget: %v", err)
+	}
+	if err := a.bm.Set(iid, budgets.Admin, b); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "setting budget: %v", err)
+	}
+	return &adminPb.SetUserBudgetResponse{}, nil
+}
+
+// UserBudget returns the spending limits of a user, and the spent and remaining
+// amounts. If a cid is provided, the amounts for it are also included.
+func (a *Service) UserBudget(ctx context.Context, req *adminPb.UserBudgetRequest) (*adminPb.UserBudgetResponse, error) {
+	iid := ffs.APIID(req.UserId)
+	if !iid.Valid() {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid user id")
+	}
+	c := cid.Undef
+	if req.Cid != "" {
+		var err error
+		c, err = cid.Decode(req.Cid)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "decoding cid: %v", err)
+		}
+	}
+	st, err := a.bm.Status(iid, c)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "getting budget status: %v", err)
+	}
+	return &adminPb.UserBudgetResponse{Status: su.ToRPCBudgetStatus(st)}, nil
+}
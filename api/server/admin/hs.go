@@ -47,6 +47,7 @@ func (a *Service) PinnedCids(ctx context.Context, req *adminProto.PinnedCidsRequ
 				UserId:    up.ID.String(),
 				Staged:    up.Staged,
 				CreatedAt: up.CreatedAt,
+				ExpiresAt: up.ExpiresAt,
 			}
 			hspc.Users[j] = upr
 		}
@@ -2,6 +2,8 @@ package admin
 
 import (
 	"context"
+	"sort"
+	"time"
 
 	"github.com/ipfs/go-cid"
 	adminPb "github.com/textileio/powergate/v2/api/gen/powergate/admin/v1"
@@ -26,6 +28,8 @@ func (a *Service) ListStorageJobs(ctx context.Context, req *adminPb.ListStorageJ
 		selector = scheduler.Final
 	case userPb.StorageJobsSelector_STORAGE_JOBS_SELECTOR_QUEUED:
 		selector = scheduler.Queued
+	case userPb.StorageJobsSelector_STORAGE_JOBS_SELECTOR_SCHEDULED:
+		selector = scheduler.Scheduled
 	case userPb.StorageJobsSelector_STORAGE_JOBS_SELECTOR_UNSPECIFIED:
 		selector = scheduler.All
 	}
@@ -78,6 +82,10 @@ func (a *Service) StorageJobsSummary(ctx context.Context, req *adminPb.StorageJo
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "listing final jobs: %v", err)
 	}
+	scheduledJobs, _, _, err := a.s.ListStorageJobs(scheduler.ListStorageJobsConfig{Select: scheduler.Scheduled, APIIDFilter: ffs.APIID(req.UserId), CidFilter: c})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "listing scheduled jobs: %v", err)
+	}
 
 	var queuedJobIDs []string
 	for _, job := range queuedJobs {
@@ -91,10 +99,82 @@ func (a *Service) StorageJobsSummary(ctx context.Context, req *adminPb.StorageJo
 	for _, job := range finalJobs {
 		finalJobIDs = append(finalJobIDs, job.ID.String())
 	}
+	var scheduledJobIDs []string
+	for _, job := range scheduledJobs {
+		scheduledJobIDs = append(scheduledJobIDs, job.ID.String())
+	}
 
 	return &adminPb.StorageJobsSummaryResponse{
 		QueuedStorageJobs:    queuedJobIDs,
 		ExecutingStorageJobs: executingJobIDs,
 		FinalStorageJobs:     finalJobIDs,
+		ScheduledStorageJobs: scheduledJobIDs,
+	}, nil
+}
+
+// SetUserFairShare sets the fair-share scheduling configuration of a user.
+func (a *Service) SetUserFairShare(ctx context.Context, req *adminPb.SetUserFairShareRequest) (*adminPb.SetUserFairShareResponse, error) {
+	iid := ffs.APIID(req.UserId)
+	if !iid.Valid() {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid user id")
+	}
+	fs := ffs.FairShare{
+		Weight:       int(req.Weight),
+		MaxExecuting: int(req.MaxExecutingJobs),
+	}
+	if err := a.s.SetFairShare(iid, fs); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "setting fair share: %v", err)
+	}
+	return &adminPb.SetUserFairShareResponse{}, nil
+}
+
+// RemoveUserFairShare removes the fair-share scheduling configuration of a user,
+// which gets the default share of execution slots.
+func (a *Service) RemoveUserFairShare(ctx context.Context, req *adminPb.RemoveUserFairShareRequest) (*adminPb.RemoveUserFairShareResponse, error) {
+	iid := ffs.APIID(req.UserId)
+	if !iid.Valid() {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid user id")
+	}
+	if err := a.s.RemoveFairShare(iid); err != nil {
+		return nil, status.Errorf(codes.Internal, "removing fair share: %v", err)
+	}
+	return &adminPb.RemoveUserFairShareResponse{}, nil
+}
+
+// UserFairShares returns the fair-share scheduling configuration of all users
+// that have one configured.
+func (a *Service) UserFairShares(ctx context.Context, req *adminPb.UserFairSharesRequest) (*adminPb.UserFairSharesResponse, error) {
+	fss := a.s.FairShares()
+	res := make([]*adminPb.UserFairShare, 0, len(fss))
+	for iid, fs := range fss {
+		res = append(res, &adminPb.UserFairShare{
+			UserId:           iid.String(),
+			Weight:           int64(fs.Weight),
+			MaxExecutingJobs: int64(fs.MaxExecuting),
+		})
+	}
+	sort.Slice(res, func(i, j int) bool {
+		return res[i].UserId < res[j].UserId
+	})
+	return &adminPb.UserFairSharesResponse{FairShares: res}, nil
+}
+
+// PruneStorageJobs deletes final storage jobs, and their logs, which aren't retained
+// by the provided retention values, or the configured ones if not provided.
+func (a *Service) PruneStorageJobs(ctx context.Context, req *adminPb.PruneStorageJobsRequest) (*adminPb.PruneStorageJobsResponse, error) {
+	if req.MaxAgeSeconds < 0 || req.MaxPerCid < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "retention values can't be negative")
+	}
+	report, err := a.s.PruneStorageJobs(ctx, time.Duration(req.MaxAgeSeconds)*time.Second, int(req.MaxPerCid))
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "pruning storage jobs: %v", err)
+	}
+	jids := make([]string, len(report.PrunedJobs))
+	for i, j := range report.PrunedJobs {
+		jids[i] = j.ID.String()
+	}
+	return &adminPb.PruneStorageJobsResponse{
+		PrunedJobIds:      jids,
+		RemovedLogEntries: int64(report.RemovedLogEntries),
 	}, nil
 }
@@ -2,11 +2,13 @@ package admin
 
 import (
 	"context"
+	"time"
 
 	adminPb "github.com/textileio/powergate/v2/api/gen/powergate/admin/v1"
 	"github.com/textileio/powergate/v2/api/server/util"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 // GetUpdatedStorageDealRecordsSince returns all the storage deal records that got created or updated
@@ -30,3 +32,32 @@ func (a *Service) GetUpdatedRetrievalRecordsSince(ctx context.Context, req *admi
 
 	return &adminPb.GetUpdatedRetrievalRecordsSinceResponse{Records: util.ToRPCRetrievalDealRecords(rs)}, nil
 }
+
+// RetrievalMinerRanking returns how miners are ranked for retrievals considering
+// their past retrieval records.
+func (a *Service) RetrievalMinerRanking(ctx context.Context, req *adminPb.RetrievalMinerRankingRequest) (*adminPb.RetrievalMinerRankingResponse, error) {
+	ranking, err := a.dm.RetrievalMinerRanking(req.Miners)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "getting retrieval miner ranking: %s", err)
+	}
+
+	res := &adminPb.RetrievalMinerRankingResponse{
+		Ranking: make([]*adminPb.RetrievalMinerRank, len(ranking)),
+	}
+	for i, r := range ranking {
+		rank := &adminPb.RetrievalMinerRank{
+			Miner:          r.Miner,
+			Successes:      int64(r.Successes),
+			Failures:       int64(r.Failures),
+			SuccessRate:    r.SuccessRate,
+			Throughput:     r.Throughput,
+			RecentlyFailed: r.RecentlyFailed,
+			Score:          r.Score,
+		}
+		if r.LastFailure > 0 {
+			rank.LastFailure = timestamppb.New(time.Unix(r.LastFailure, 0))
+		}
+		res.Ranking[i] = rank
+	}
+	return res, nil
+}
@@ -0,0 +1,43 @@
+package admin
+
+import (
+	"context"
+	"time"
+
+	adminPb "github.com/textileio/powergate/v2/api/gen/powergate/admin/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Drain stops dequeuing queued storage and retrieval jobs, letting executing
+// jobs finish, or interrupting and re-queuing them after the provided timeout.
+func (a *Service) Drain(ctx context.Context, req *adminPb.DrainRequest) (*adminPb.DrainResponse, error) {
+	if req.TimeoutSeconds < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "timeout can't be negative")
+	}
+	if err := a.s.Drain(time.Duration(req.TimeoutSeconds) * time.Second); err != nil {
+		return nil, status.Errorf(codes.Internal, "draining scheduler: %v", err)
+	}
+	return &adminPb.DrainResponse{}, nil
+}
+
+// Undrain resumes dequeuing queued storage and retrieval jobs.
+func (a *Service) Undrain(ctx context.Context, req *adminPb.UndrainRequest) (*adminPb.UndrainResponse, error) {
+	a.s.Undrain()
+	return &adminPb.UndrainResponse{}, nil
+}
+
+// DrainStatus returns the scheduler draining state.
+func (a *Service) DrainStatus(ctx context.Context, req *adminPb.DrainStatusRequest) (*adminPb.DrainStatusResponse, error) {
+	ds := a.s.DrainStatus()
+	res := &adminPb.DrainStatusResponse{
+		Draining:               ds.Draining,
+		ExecutingStorageJobs:   int64(ds.ExecutingStorageJobs),
+		ExecutingRetrievalJobs: int64(ds.ExecutingRetrievalJobs),
+	}
+	if !ds.Deadline.IsZero() {
+		res.Deadline = timestamppb.New(ds.Deadline)
+	}
+	return res, nil
+}
@@ -3,6 +3,7 @@ package admin
 import (
 	adminPb "github.com/textileio/powergate/v2/api/gen/powergate/admin/v1"
 	dealsModule "github.com/textileio/powergate/v2/deals/module"
+	"github.com/textileio/powergate/v2/ffs/budgets"
 	"github.com/textileio/powergate/v2/ffs/manager"
 	"github.com/textileio/powergate/v2/ffs/scheduler"
 	askIndex "github.com/textileio/powergate/v2/index/ask/runner"
@@ -19,10 +20,11 @@ type Service struct {
 	dm *dealsModule.Module
 	mi *minerIndex.Index
 	ai *askIndex.Runner
+	bm *budgets.Module
 }
 
 // New creates a new AdminService.
-func New(m *manager.Manager, s *scheduler.Scheduler, wm wallet.Module, dm *dealsModule.Module, mi *minerIndex.Index, ai *askIndex.Runner) *Service {
+func New(m *manager.Manager, s *scheduler.Scheduler, wm wallet.Module, dm *dealsModule.Module, mi *minerIndex.Index, ai *askIndex.Runner, bm *budgets.Module) *Service {
 	return &Service{
 		m:  m,
 		s:  s,
@@ -30,5 +32,6 @@ func New(m *manager.Manager, s *scheduler.Scheduler, wm wallet.Module, dm *deals
 		dm: dm,
 		mi: mi,
 		ai: ai,
+		bm: bm,
 	}
 }
@@ -35,6 +35,7 @@ import (
 	dealsModule "github.com/textileio/powergate/v2/deals/module"
 	"github.com/textileio/powergate/v2/fchost"
 	"github.com/textileio/powergate/v2/ffs"
+	"github.com/textileio/powergate/v2/ffs/budgets"
 	"github.com/textileio/powergate/v2/ffs/coreipfs"
 	"github.com/textileio/powergate/v2/ffs/filcold"
 	"github.com/textileio/powergate/v2/ffs/joblogger"
@@ -42,6 +43,8 @@ import (
 	"github.com/textileio/powergate/v2/ffs/minerselector/reptop"
 	"github.com/textileio/powergate/v2/ffs/minerselector/sr2"
 	"github.com/textileio/powergate/v2/ffs/scheduler"
+	"github.com/textileio/powergate/v2/ffs/uploads"
+	"github.com/textileio/powergate/v2/ffs/webhooks"
 	"github.com/textileio/powergate/v2/filchain"
 	"github.com/textileio/powergate/v2/gateway"
 	ask "github.com/textileio/powergate/v2/index/ask/runner"
@@ -94,8 +97,12 @@ type Server struct {
 
 	ffsManager *manager.Manager
 	sched      *scheduler.Scheduler
+	cs         *filcold.FilCold
 	hs         ffs.HotStorage
 	l          *joblogger.Logger
+	wh         *webhooks.Module
+	bm         *budgets.Module
+	um         *uploads.Module
 
 	grpcServer *grpc.Server
 
@@ -135,8 +142,18 @@ type Config struct {
 	FFSMinimumPieceSize          uint64
 	FFSRetrievalNextEventTimeout time.Duration
 	FFSMaxParallelDealPreparing  int
+	FFSAggregateSize             uint64
+	FFSAggregateMaxWait          time.Duration
 	FFSGCAutomaticGCInterval     time.Duration
 	FFSGCStageGracePeriod        time.Duration
+	FFSRenewalEvalFrequency      time.Duration
+	FFSRepairEvalFrequency       time.Duration
+	FFSMinEvalFrequency          time.Duration
+	FFSJobRetentionMaxAge        time.Duration
+	FFSJobRetentionMaxPerCid     int
+	FFSJobPruneInterval          time.Duration
+	FFSUploadSessionTTL          time.Duration
+	FFSWebhooksAllowPrivate      bool
 	SchedMaxParallel             int
 	MinerSelector                string
 	MinerSelectorParams          string
@@ -273,7 +290,7 @@ func NewServer(conf Config) (*Server, error) {
 
 	chain := filchain.New(clientBuilder)
 
-	ms, err := getMinerSelector(conf, rm, ai, clientBuilder)
+	ms, err := getMinerSelector(conf, rm, ai, mi, si, clientBuilder)
 	if err != nil {
 		return nil, fmt.Errorf("creating miner selector: %s", err)
 	}
@@ -282,19 +299,28 @@ func NewServer(conf Config) (*Server, error) {
 	if conf.Devnet {
 		conf.FFSMinimumPieceSize = 0
 	}
-	cs := filcold.New(ms, dm, wm, ipfs, chain, l, lsm, conf.FFSMinimumPieceSize, conf.FFSMaxParallelDealPreparing, conf.FFSRetrievalNextEventTimeout)
+	cs := filcold.New(ms, dm, wm, ipfs, chain, l, lsm, conf.FFSMinimumPieceSize, conf.FFSMaxParallelDealPreparing, conf.FFSRetrievalNextEventTimeout, conf.FFSAggregateSize, conf.FFSAggregateMaxWait)
 	hs, err := coreipfs.New(txndstr.Wrap(ds, "ffs/coreipfs"), ipfs, l)
 	if err != nil {
 		return nil, fmt.Errorf("creating coreipfs: %s", err)
 	}
 
+	wh, err := webhooks.New(txndstr.Wrap(ds, "ffs/webhooks"), conf.FFSWebhooksAllowPrivate)
+	if err != nil {
+		return nil, fmt.Errorf("creating webhooks module: %s", err)
+	}
+
+	bm := budgets.New(txndstr.Wrap(ds, "ffs/budgets"), dm)
+
 	log.Info("Starting FFS scheduler...")
 	var sr2rf func() (int, error)
 	if ms, ok := ms.(*sr2.MinerSelector); ok {
 		sr2rf = ms.GetReplicationFactor
 	}
 	gcConfig := scheduler.GCConfig{StageGracePeriod: conf.FFSGCStageGracePeriod, AutoGCInterval: conf.FFSGCAutomaticGCInterval}
-	sched, err := scheduler.New(txndstr.Wrap(ds, "ffs/scheduler"), l, hs, cs, conf.SchedMaxParallel, conf.FFSDealFinalityTimeout, sr2rf, gcConfig)
+	evalConfig := scheduler.EvalConfig{RenewalFrequency: conf.FFSRenewalEvalFrequency, RepairFrequency: conf.FFSRepairEvalFrequency, MinFrequency: conf.FFSMinEvalFrequency}
+	retentionConfig := scheduler.RetentionConfig{MaxAge: conf.FFSJobRetentionMaxAge, MaxPerCid: conf.FFSJobRetentionMaxPerCid, PruneInterval: conf.FFSJobPruneInterval}
+	sched, err := scheduler.New(txndstr.Wrap(ds, "ffs/scheduler"), l, hs, cs, conf.SchedMaxParallel, conf.FFSDealFinalityTimeout, sr2rf, gcConfig, evalConfig, retentionConfig, wh, si, bm)
 	if err != nil {
 		return nil, fmt.Errorf("creating scheduler: %s", err)
 	}
@@ -303,6 +329,12 @@ func NewServer(conf Config) (*Server, error) {
 	if err != nil {
 		return nil, fmt.Errorf("creating ffs instance: %s", err)
 	}
+	bm.SetAddrsResolver(ffsManager)
+
+	um, err := uploads.New(txndstr.Wrap(ds, "ffs/uploads"), filepath.Join(conf.RepoPath, "uploads"), conf.FFSUploadSessionTTL, hs)
+	if err != nil {
+		return nil, fmt.Errorf("creating uploads module: %s", err)
+	}
 
 	log.Info("Starting gRPC, gateway and index HTTP servers...")
 
@@ -338,8 +370,12 @@ func NewServer(conf Config) (*Server, error) {
 
 		ffsManager: ffsManager,
 		sched:      sched,
+		cs:         cs,
 		hs:         hs,
 		l:          l,
+		wh:         wh,
+		bm:         bm,
+		um:         um,
 
 		grpcServer: grpcServer,
 		webProxy:   webProxy,
@@ -432,8 +468,8 @@ func wrapGRPCServer(grpcServer *grpc.Server) *grpcweb.WrappedGrpcServer {
 }
 
 func startGRPCServices(server *grpc.Server, webProxy *http.Server, s *Server, hostNetwork string, hostAddress ma.Multiaddr) error {
-	userService := user.New(s.ffsManager, s.wm, s.hs)
-	adminService := admin.New(s.ffsManager, s.sched, s.wm, s.dm, s.mi, s.ai)
+	userService := user.New(s.ffsManager, s.wm, s.hs, s.wh, s.bm, s.um)
+	adminService := admin.New(s.ffsManager, s.sched, s.wm, s.dm, s.mi, s.ai, s.bm)
 
 	hostAddr, err := util.TCPAddrFromMultiAddr(hostAddress)
 	if err != nil {
@@ -539,6 +575,15 @@ func (s *Server) Close() {
 	if err := s.sched.Close(); err != nil {
 		log.Errorf("closing ffs scheduler: %s", err)
 	}
+	if err := s.cs.Close(); err != nil {
+		log.Errorf("closing filcold: %s", err)
+	}
+	if err := s.wh.Close(); err != nil {
+		log.Errorf("closing webhooks module: %s", err)
+	}
+	if err := s.um.Close(); err != nil {
+		log.Errorf("closing uploads module: %s", err)
+	}
 	if err := s.l.Close(); err != nil {
 		log.Errorf("closing joblogger: %s", err)
 	}
@@ -607,7 +652,7 @@ func createDatastore(conf Config, longTimeout bool) (datastore.TxnDatastore, err
 	return measure.New("powergate.datastore", ds), nil
 }
 
-func getMinerSelector(conf Config, rm *reputation.Module, ai *ask.Runner, cb lotus.ClientBuilder) (ffs.MinerSelector, error) {
+func getMinerSelector(conf Config, rm *reputation.Module, ai *ask.Runner, mi *minerIndex.Index, fi *faultsModule.Index, cb lotus.ClientBuilder) (ffs.MinerSelector, error) {
 	if conf.Devnet {
 		return reptop.New(cb, rm, ai), nil
 	}
@@ -618,7 +663,7 @@ func getMinerSelector(conf Config, rm *reputation.Module, ai *ask.Runner, cb lot
 	case "reputation":
 		ms = reptop.New(cb, rm, ai)
 	case "sr2":
-		ms, err = sr2.New(conf.MinerSelectorParams, cb)
+		ms, err = sr2.New(conf.MinerSelectorParams, cb, mi, fi)
 		if err != nil {
 			return nil, fmt.Errorf("creating sr2 miner selector: %s", err)
 		}
@@ -0,0 +1,50 @@
+package user
+
+import (
+	"context"
+
+	"github.com/ipfs/go-cid"
+	userPb "github.com/textileio/powergate/v2/api/gen/powergate/user/v1"
+	su "github.com/textileio/powergate/v2/api/server/util"
+	"github.com/textileio/powergate/v2/ffs/budgets"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// SetBudget sets the spending limits of the user. Jobs making deals that would
+// exceed the limits, or the ones set by an admin, fail before proposing them.
+func (s *Service) SetBudget(ctx context.Context, req *userPb.SetBudgetRequest) (*userPb.SetBudgetResponse, error) {
+	i, err := s.getInstanceByToken(ctx)
+	if err != nil {
+		return nil, err
+	}
+	b, err := su.FromRPCBudget(req.Budget)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "parsing budget: %v", err)
+	}
+	if err := s.bm.Set(i.ID(), budgets.User, b); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "setting budget: %v", err)
+	}
+	return &userPb.SetBudgetResponse{}, nil
+}
+
+// Budget returns the spending limits of the user, and the spent and remaining
+// amounts. If a cid is provided, the amounts for it are also included.
+func (s *Service) Budget(ctx context.Context, req *userPb.BudgetRequest) (*userPb.BudgetResponse, error) {
+	i, err := s.getInstanceByToken(ctx)
+	if err != nil {
+		return nil, err
+	}
+	c := cid.Undef
+	if req.Cid != "" {
+		c, err = cid.Decode(req.Cid)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "decoding cid: %v", err)
+		}
+	}
+	st, err := s.bm.Status(i.ID(), c)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "getting budget status: %v", err)
+	}
+	return &userPb.BudgetResponse{Status: su.ToRPCBudgetStatus(st)}, nil
+}
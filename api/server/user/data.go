@@ -30,7 +30,10 @@ func (s *Service) Stage(srv userPb.UserService_StageServer) error {
 		}
 	}()
 
-	go receiveFile(srv, writer)
+	go receiveFile(func() ([]byte, error) {
+		req, err := srv.Recv()
+		return req.GetChunk(), err
+	}, writer)
 
 	c, err := s.hot.Stage(srv.Context(), fapi.ID(), reader)
 	if err != nil {
@@ -40,6 +43,39 @@ func (s *Service) Stage(srv userPb.UserService_StageServer) error {
 	return srv.SendAndClose(&userPb.StageResponse{Cid: util.CidToString(c)})
 }
 
+// StageCar allows to import a CAR file in Hot-Storage, and stage-pin its roots in preparation
+// for pushing storage configurations.
+func (s *Service) StageCar(srv userPb.UserService_StageCarServer) error {
+	// check that an API instance exists so not just anyone can add data to the hot layer
+	fapi, err := s.getInstanceByToken(srv.Context())
+	if err != nil {
+		return err
+	}
+
+	reader, writer := io.Pipe()
+	defer func() {
+		if err := reader.Close(); err != nil {
+			log.Errorf("closing reader: %s", err)
+		}
+	}()
+
+	go receiveFile(func() ([]byte, error) {
+		req, err := srv.Recv()
+		return req.GetChunk(), err
+	}, writer)
+
+	roots, err := s.hot.StageCar(srv.Context(), fapi.ID(), reader)
+	if err != nil {
+		return fmt.Errorf("importing car file to hot storage: %s", err)
+	}
+
+	res := &userPb.StageCarResponse{Cids: make([]string, len(roots))}
+	for i, c := range roots {
+		res.Cids[i] = util.CidToString(c)
+	}
+	return srv.SendAndClose(res)
+}
+
 // StageCid allows to stage-pin a cid in Hot-Storage in preparation for pushing a storage configuration.
 func (s *Service) StageCid(ctx context.Context, req *userPb.StageCidRequest) (*userPb.StageCidResponse, error) {
 	// check that an API instance exists so not just anyone can add data to the hot layer
@@ -95,7 +131,12 @@ func (s *Service) Get(req *userPb.GetRequest, srv userPb.UserService_GetServer)
 	if err != nil {
 		return err
 	}
-	r, err := i.Get(srv.Context(), c)
+	opts := []api.GetOption{
+		api.WithGetPath(req.Path),
+		api.WithGetOffset(req.Offset),
+		api.WithGetLength(req.Length),
+	}
+	r, err := i.Get(srv.Context(), c, opts...)
 	if err != nil {
 		return err
 	}
@@ -346,9 +387,9 @@ func (s *Service) CidInfo(ctx context.Context, req *userPb.CidInfoRequest) (*use
 	return &userPb.CidInfoResponse{CidInfo: cidInfo}, nil
 }
 
-func receiveFile(srv userPb.UserService_StageServer, writer *io.PipeWriter) {
+func receiveFile(recv func() ([]byte, error), writer *io.PipeWriter) {
 	for {
-		req, err := srv.Recv()
+		chunk, err := recv()
 		if err == io.EOF {
 			_ = writer.Close()
 			break
@@ -356,7 +397,7 @@ func receiveFile(srv userPb.UserService_StageServer, writer *io.PipeWriter) {
 			_ = writer.CloseWithError(err)
 			break
 		}
-		_, writeErr := writer.Write(req.GetChunk())
+		_, writeErr := writer.Write(chunk)
 		if writeErr != nil {
 			if err := writer.CloseWithError(writeErr); err != nil {
 				log.Errorf("closing with error: %s", err)
@@ -0,0 +1,133 @@
+package user
+
+import (
+	"context"
+
+	userPb "github.com/textileio/powergate/v2/api/gen/powergate/user/v1"
+	su "github.com/textileio/powergate/v2/api/server/util"
+	"github.com/textileio/powergate/v2/ffs"
+	"github.com/textileio/powergate/v2/ffs/api"
+	"github.com/textileio/powergate/v2/util"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// StartRetrieval calls API.StartRetrieval.
+func (s *Service) StartRetrieval(ctx context.Context, req *userPb.StartRetrievalRequest) (*userPb.StartRetrievalResponse, error) {
+	i, err := s.getInstanceByToken(ctx)
+	if err != nil {
+		return nil, err
+	}
+	payloadCid, err := util.CidFromString(req.PayloadCid)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "parsing payload cid: %v", err)
+	}
+	pieceCid, err := util.CidFromString(req.PieceCid)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "parsing piece cid: %v", err)
+	}
+
+	var opts []api.RetrievalOption
+	if req.WalletAddress != "" {
+		opts = append(opts, api.WithRetrievalWalletAddress(req.WalletAddress))
+	}
+	if req.MaxPrice > 0 {
+		opts = append(opts, api.WithRetrievalMaxPrice(req.MaxPrice))
+	}
+	r, err := i.StartRetrieval(payloadCid, pieceCid, req.Selector, req.Miners, opts...)
+	if err != nil {
+		code := codes.Internal
+		if err == api.ErrSelectorNotSupported {
+			code = codes.InvalidArgument
+		}
+		return nil, status.Errorf(code, "starting retrieval: %v", err)
+	}
+	return &userPb.StartRetrievalResponse{Retrieval: toRPCRetrieval(r)}, nil
+}
+
+// GetRetrieval calls API.GetRetrieval.
+func (s *Service) GetRetrieval(ctx context.Context, req *userPb.GetRetrievalRequest) (*userPb.GetRetrievalResponse, error) {
+	i, err := s.getInstanceByToken(ctx)
+	if err != nil {
+		return nil, err
+	}
+	r, err := i.GetRetrieval(ffs.RetrievalID(req.Id))
+	if err != nil {
+		code := codes.Internal
+		if err == api.ErrNotFound {
+			code = codes.NotFound
+		}
+		return nil, status.Errorf(code, "getting retrieval: %v", err)
+	}
+	return &userPb.GetRetrievalResponse{Retrieval: toRPCRetrieval(r)}, nil
+}
+
+// WatchRetrievalJobs calls API.WatchRetrievalJobs.
+func (s *Service) WatchRetrievalJobs(req *userPb.WatchRetrievalJobsRequest, srv userPb.UserService_WatchRetrievalJobsServer) error {
+	i, err := s.getInstanceByToken(srv.Context())
+	if err != nil {
+		return err
+	}
+
+	jids := make([]ffs.JobID, len(req.JobIds))
+	for i, jid := range req.JobIds {
+		jids[i] = ffs.JobID(jid)
+	}
+
+	ch := make(chan ffs.RetrievalJob, 100)
+	go func() {
+		err = i.WatchRetrievalJobs(srv.Context(), ch, jids...)
+		close(ch)
+	}()
+	for job := range ch {
+		rpcJob, err := su.ToRPCRetrievalJob(job)
+		if err != nil {
+			return err
+		}
+		reply := &userPb.WatchRetrievalJobsResponse{
+			RetrievalJob: rpcJob,
+		}
+		if err := srv.Send(reply); err != nil {
+			return err
+		}
+	}
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+// CancelRetrievalJob calls API.CancelRetrievalJob.
+func (s *Service) CancelRetrievalJob(ctx context.Context, req *userPb.CancelRetrievalJobRequest) (*userPb.CancelRetrievalJobResponse, error) {
+	i, err := s.getInstanceByToken(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if req.JobId == "" {
+		return nil, status.Error(codes.InvalidArgument, "job id can't be empty")
+	}
+	if err := i.CancelRetrievalJob(ffs.JobID(req.JobId)); err != nil {
+		code := codes.Internal
+		if err == api.ErrNotFound {
+			code = codes.NotFound
+		}
+		return nil, status.Errorf(code, "canceling retrieval job: %v", err)
+	}
+	return &userPb.CancelRetrievalJobResponse{}, nil
+}
+
+// RemoveRetrieval calls API.RemoveRetrieval.
+func (s *Service) RemoveRetrieval(ctx context.Context, req *userPb.RemoveRetrievalRequest) (*userPb.RemoveRetrievalResponse, error) {
+	i, err := s.getInstanceByToken(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := i.RemoveRetrieval(ffs.RetrievalID(req.Id)); err != nil {
+		code := codes.Internal
+		if err == api.ErrNotFound {
+			code = codes.NotFound
+		}
+		return nil, status.Errorf(code, "removing retrieval: %v", err)
+	}
+	return &userPb.RemoveRetrievalResponse{}, nil
+}
@@ -9,7 +9,10 @@ import (
 	"github.com/textileio/powergate/v2/buildinfo"
 	"github.com/textileio/powergate/v2/ffs"
 	"github.com/textileio/powergate/v2/ffs/api"
+	"github.com/textileio/powergate/v2/ffs/budgets"
 	"github.com/textileio/powergate/v2/ffs/manager"
+	"github.com/textileio/powergate/v2/ffs/uploads"
+	"github.com/textileio/powergate/v2/ffs/webhooks"
 	"github.com/textileio/powergate/v2/wallet"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -25,14 +28,20 @@ type Service struct {
 	m   *manager.Manager
 	w   wallet.Module
 	hot ffs.HotStorage
+	wh  *webhooks.Module
+	bm  *budgets.Module
+	um  *uploads.Module
 }
 
 // New creates a new powergate Service.
-func New(m *manager.Manager, w wallet.Module, hot ffs.HotStorage) *Service {
+func New(m *manager.Manager, w wallet.Module, hot ffs.HotStorage, wh *webhooks.Module, bm *budgets.Module, um *uploads.Module) *Service {
 	return &Service{
 		m:   m,
 		w:   w,
 		hot: hot,
+		wh:  wh,
+		bm:  bm,
+		um:  um,
 	}
 }
 
@@ -2,11 +2,15 @@ package user
 
 import (
 	"context"
+	"fmt"
 
 	userPb "github.com/textileio/powergate/v2/api/gen/powergate/user/v1"
 	"github.com/textileio/powergate/v2/ffs"
 	"github.com/textileio/powergate/v2/ffs/api"
 	"github.com/textileio/powergate/v2/util"
+	"github.com/textileio/powergate/v2/wallet"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 // DefaultStorageConfig calls ffs.DefaultStorageConfig.
@@ -28,9 +32,11 @@ func (s *Service) SetDefaultStorageConfig(ctx context.Context, req *userPb.SetDe
 		return nil, err
 	}
 	defaultConfig := ffs.StorageConfig{
-		Repairable: req.Config.Repairable,
-		Hot:        fromRPCHotConfig(req.Config.Hot),
-		Cold:       fromRPCColdConfig(req.Config.Cold),
+		Repairable:    req.Config.Repairable,
+		Hot:           fromRPCHotConfig(req.Config.Hot),
+		Cold:          fromRPCColdConfig(req.Config.Cold),
+		Retry:         fromRPCRetryPolicy(req.Config.Retry),
+		EvalFrequency: int(req.Config.EvalFrequency),
 	}
 	if err := i.SetDefaultStorageConfig(defaultConfig); err != nil {
 		return nil, err
@@ -52,11 +58,14 @@ func (s *Service) ApplyStorageConfig(ctx context.Context, req *userPb.ApplyStora
 
 	options := []api.PushStorageConfigOption{}
 
+	config := i.DefaultStorageConfig()
 	if req.HasConfig {
-		config := ffs.StorageConfig{
-			Repairable: req.Config.Repairable,
-			Hot:        fromRPCHotConfig(req.Config.Hot),
-			Cold:       fromRPCColdConfig(req.Config.Cold),
+		config = ffs.StorageConfig{
+			Repairable:    req.Config.Repairable,
+			Hot:           fromRPCHotConfig(req.Config.Hot),
+			Cold:          fromRPCColdConfig(req.Config.Cold),
+			Retry:         fromRPCRetryPolicy(req.Config.Retry),
+			EvalFrequency: int(req.Config.EvalFrequency),
 		}
 		options = append(options, api.WithStorageConfig(config))
 	}
@@ -71,14 +80,72 @@ func (s *Service) ApplyStorageConfig(ctx context.Context, req *userPb.ApplyStora
 	if req.NoExec {
 		options = append(options, api.WithNoExec(true))
 	}
+	if req.Priority != userPb.JobPriority_JOB_PRIORITY_UNSPECIFIED {
+		priority, err := fromRPCJobPriority(req.Priority)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "parsing priority: %v", err)
+		}
+		if priority > ffs.PriorityHigh {
+			return nil, status.Errorf(codes.InvalidArgument, "priority %s is reserved for the scheduler", ffs.JobPriorityStr[priority])
+		}
+		options = append(options, api.WithPriority(priority))
+	}
+	if req.NotBefore != nil {
+		if err := req.NotBefore.CheckValid(); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "parsing not before: %v", err)
+		}
+		options = append(options, api.WithNotBefore(req.NotBefore.AsTime()))
+	}
 
 	jid, err := i.PushStorageConfig(c, options...)
 	if err != nil {
 		return nil, err
 	}
 
+	// The job was already pushed, so failing to check the data-cap
+	// doesn't fail the request.
+	warnings, err := s.dataCapWarnings(ctx, config)
+	if err != nil {
+		log.Errorf("checking data-cap for job %s: %s", jid, err)
+	}
+
 	return &userPb.ApplyStorageConfigResponse{
-		JobId: jid.String(),
+		JobId:    jid.String(),
+		Warnings: warnings,
+	}, nil
+}
+
+// PlanStorageConfig returns the actions that applying the provided cid storage config would take.
+func (s *Service) PlanStorageConfig(ctx context.Context, req *userPb.PlanStorageConfigRequest) (*userPb.PlanStorageConfigResponse, error) {
+	i, err := s.getInstanceByToken(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	c, err := util.CidFromString(req.Cid)
+	if err != nil {
+		return nil, err
+	}
+
+	options := []api.PushStorageConfigOption{}
+	if req.HasConfig {
+		config := ffs.StorageConfig{
+			Repairable:    req.Config.Repairable,
+			Hot:           fromRPCHotConfig(req.Config.Hot),
+			Cold:          fromRPCColdConfig(req.Config.Cold),
+			Retry:         fromRPCRetryPolicy(req.Config.Retry),
+			EvalFrequency: int(req.Config.EvalFrequency),
+		}
+		options = append(options, api.WithStorageConfig(config))
+	}
+
+	plan, err := i.PlanStorageConfig(ctx, c, options...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &userPb.PlanStorageConfigResponse{
+		Plan: toRPCStorageConfigPlan(plan),
 	}, nil
 }
 
@@ -100,3 +167,30 @@ func (s *Service) Remove(ctx context.Context, req *userPb.RemoveRequest) (*userP
 
 	return &userPb.RemoveResponse{}, nil
 }
+
+// dataCapWarnings returns warnings if new verified deals of a storage config
+// won't be covered by the DataCap of its wallet address. The piece size of the
+// data isn't known yet, so only a missing or exhausted DataCap is detected.
+func (s *Service) dataCapWarnings(ctx context.Context, config ffs.StorageConfig) ([]string, error) {
+	fc := config.Cold.Filecoin
+	if !config.Cold.Enabled || !fc.VerifiedDeal {
+		return nil, nil
+	}
+	vci, err := s.w.GetVerifiedClientInfo(ctx, fc.Addr)
+	if err != nil && err != wallet.ErrNoVerifiedClient {
+		return nil, fmt.Errorf("getting verified-client information: %s", err)
+	}
+	var cause string
+	switch {
+	case err == wallet.ErrNoVerifiedClient:
+		cause = fmt.Sprintf("Wallet address %s isn't a verified client", fc.Addr)
+	case vci.RemainingDatacapBytes.Sign() == 0:
+		cause = fmt.Sprintf("Wallet address %s has no remaining data-cap", fc.Addr)
+	default:
+		return nil, nil
+	}
+	if fc.DataCapPolicy == ffs.DataCapPolicyFail {
+		return []string{cause + ", so new deals will fail"}, nil
+	}
+	return []string{cause + ", so new deals will be unverified"}, nil
+}
@@ -67,6 +67,8 @@ func (s *Service) ListStorageJobs(ctx context.Context, req *userPb.ListStorageJo
 		selector = api.Final
 	case userPb.StorageJobsSelector_STORAGE_JOBS_SELECTOR_QUEUED:
 		selector = api.Queued
+	case userPb.StorageJobsSelector_STORAGE_JOBS_SELECTOR_SCHEDULED:
+		selector = api.Scheduled
 	case userPb.StorageJobsSelector_STORAGE_JOBS_SELECTOR_UNSPECIFIED:
 		selector = api.All
 	}
@@ -123,6 +125,10 @@ func (s *Service) StorageJobsSummary(ctx context.Context, req *userPb.StorageJob
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "listing final jobs: %v", err)
 	}
+	scheduledJobs, _, _, err := i.ListStorageJobs(api.ListStorageJobsConfig{Select: api.Scheduled, CidFilter: c})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "listing scheduled jobs: %v", err)
+	}
 
 	var queuedJobIDs []string
 	for _, job := range queuedJobs {
@@ -136,11 +142,16 @@ func (s *Service) StorageJobsSummary(ctx context.Context, req *userPb.StorageJob
 	for _, job := range finalJobs {
 		finalJobIDs = append(finalJobIDs, job.ID.String())
 	}
+	var scheduledJobIDs []string
+	for _, job := range scheduledJobs {
+		scheduledJobIDs = append(scheduledJobIDs, job.ID.String())
+	}
 
 	return &userPb.StorageJobsSummaryResponse{
 		QueuedStorageJobs:    queuedJobIDs,
 		ExecutingStorageJobs: executingJobIDs,
 		FinalStorageJobs:     finalJobIDs,
+		ScheduledStorageJobs: scheduledJobIDs,
 	}, nil
 }
 
@@ -0,0 +1,85 @@
+package user
+
+import (
+	"context"
+
+	userPb "github.com/textileio/powergate/v2/api/gen/powergate/user/v1"
+	"github.com/textileio/powergate/v2/ffs/uploads"
+	"github.com/textileio/powergate/v2/util"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// CreateUploadSession creates a session to upload data in chunks, which can be
+// resumed if interrupted, and staged in Hot-Storage when finalized.
+func (s *Service) CreateUploadSession(ctx context.Context, req *userPb.CreateUploadSessionRequest) (*userPb.CreateUploadSessionResponse, error) {
+	i, err := s.getInstanceByToken(ctx)
+	if err != nil {
+		return nil, err
+	}
+	us, err := s.um.Create(i.ID(), req.ChunkSize)
+	if err != nil {
+		return nil, status.Errorf(uploadsErrorCode(err), "creating upload session: %v", err)
+	}
+	return &userPb.CreateUploadSessionResponse{SessionId: us.ID, ChunkSize: us.ChunkSize}, nil
+}
+
+// UploadChunk uploads the next chunk of an upload session.
+func (s *Service) UploadChunk(ctx context.Context, req *userPb.UploadChunkRequest) (*userPb.UploadChunkResponse, error) {
+	i, err := s.getInstanceByToken(ctx)
+	if err != nil {
+		return nil, err
+	}
+	us, err := s.um.PutChunk(ctx, i.ID(), req.SessionId, req.Index, req.Data, req.Checksum)
+	if err != nil {
+		return nil, status.Errorf(uploadsErrorCode(err), "uploading chunk: %v", err)
+	}
+	return &userPb.UploadChunkResponse{Offset: us.Offset, NextChunk: us.NextChunk}, nil
+}
+
+// UploadSessionStatus returns how much data an upload session has received.
+func (s *Service) UploadSessionStatus(ctx context.Context, req *userPb.UploadSessionStatusRequest) (*userPb.UploadSessionStatusResponse, error) {
+	i, err := s.getInstanceByToken(ctx)
+	if err != nil {
+		return nil, err
+	}
+	us, err := s.um.Get(i.ID(), req.SessionId)
+	if err != nil {
+		return nil, status.Errorf(uploadsErrorCode(err), "getting upload session: %v", err)
+	}
+	return &userPb.UploadSessionStatusResponse{
+		ChunkSize: us.ChunkSize,
+		Offset:    us.Offset,
+		NextChunk: us.NextChunk,
+	}, nil
+}
+
+// FinalizeUploadSession stage-pins the data of an upload session in Hot-Storage in
+// preparation for pushing a storage configuration.
+func (s *Service) FinalizeUploadSession(ctx context.Context, req *userPb.FinalizeUploadSessionRequest) (*userPb.FinalizeUploadSessionResponse, error) {
+	i, err := s.getInstanceByToken(ctx)
+	if err != nil {
+		return nil, err
+	}
+	c, err := s.um.Finalize(ctx, i.ID(), req.SessionId)
+	if err != nil {
+		return nil, status.Errorf(uploadsErrorCode(err), "finalizing upload session: %v", err)
+	}
+	return &userPb.FinalizeUploadSessionResponse{Cid: util.CidToString(c)}, nil
+}
+
+// uploadsErrorCode returns the status code of an uploads module error, so
+// clients can tell which errors are worth retrying.
+func uploadsErrorCode(err error) codes.Code {
+	if _, ok := err.(uploads.ValidationError); ok {
+		return codes.InvalidArgument
+	}
+	switch err {
+	case uploads.ErrNotFound:
+		return codes.NotFound
+	case uploads.ErrQuotaExceeded, uploads.ErrSessionTooLarge:
+		return codes.ResourceExhausted
+	default:
+		return codes.Internal
+	}
+}
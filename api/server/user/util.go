@@ -1,9 +1,15 @@
 package user
 
 import (
+	"fmt"
+	"math/big"
+
 	userPb "github.com/textileio/powergate/v2/api/gen/powergate/user/v1"
 	"github.com/textileio/powergate/v2/deals"
 	"github.com/textileio/powergate/v2/ffs"
+	"github.com/textileio/powergate/v2/ffs/api"
+	"github.com/textileio/powergate/v2/ffs/webhooks"
+	"github.com/textileio/powergate/v2/util"
 )
 
 func toRPCStorageConfig(config ffs.StorageConfig) *userPb.StorageConfig {
@@ -11,6 +17,12 @@ func toRPCStorageConfig(config ffs.StorageConfig) *userPb.StorageConfig {
 		Repairable: config.Repairable,
 		Hot:        toRPCHotConfig(config.Hot),
 		Cold:       toRPCColdConfig(config.Cold),
+		Retry: &userPb.RetryPolicy{
+			MaxAttempts:    int64(config.Retry.MaxAttempts),
+			InitialBackoff: int64(config.Retry.InitialBackoff),
+			MaxBackoff:     int64(config.Retry.MaxBackoff),
+		},
+		EvalFrequency: int64(config.EvalFrequency),
 	}
 }
 
@@ -19,6 +31,7 @@ func toRPCHotConfig(config ffs.HotConfig) *userPb.HotConfig {
 		Enabled:          config.Enabled,
 		AllowUnfreeze:    config.AllowUnfreeze,
 		UnfreezeMaxPrice: config.UnfreezeMaxPrice,
+		UnfreezeCacheTtl: int64(config.UnfreezeCacheTTL),
 		Ipfs: &userPb.IpfsConfig{
 			AddTimeout: int64(config.Ipfs.AddTimeout),
 		},
@@ -37,22 +50,75 @@ func toRPCColdConfig(config ffs.ColdConfig) *userPb.ColdConfig {
 			Renew: &userPb.FilRenew{
 				Enabled:   config.Filecoin.Renew.Enabled,
 				Threshold: int64(config.Filecoin.Renew.Threshold),
+				Unfreeze:  config.Filecoin.Renew.Unfreeze,
+			},
+			Address:          config.Filecoin.Addr,
+			MaxPrice:         config.Filecoin.MaxPrice,
+			FastRetrieval:    config.Filecoin.FastRetrieval,
+			DealStartOffset:  config.Filecoin.DealStartOffset,
+			VerifiedDeal:     config.Filecoin.VerifiedDeal,
+			MinCountries:     int64(config.Filecoin.MinCountries),
+			MinRelativePower: config.Filecoin.MinRelativePower,
+			MaxFaults:        toRPCMaxFaults(config.Filecoin.MaxFaults),
+			MaxFaultyRatio:   config.Filecoin.MaxFaultyRatio,
+			SectorSize:       config.Filecoin.SectorSize,
+			PriceEscalation: &userPb.FilPriceEscalation{
+				StartPrice: config.Filecoin.PriceEscalation.StartPrice,
+				Step:       config.Filecoin.PriceEscalation.Step,
+				MaxPrice:   config.Filecoin.PriceEscalation.MaxPrice,
+			},
+			Aggregate: config.Filecoin.Aggregate,
+			Offline: &userPb.FilOffline{
+				PieceCid:  config.Filecoin.Offline.PieceCid,
+				PieceSize: config.Filecoin.Offline.PieceSize,
 			},
-			Address:         config.Filecoin.Addr,
-			MaxPrice:        config.Filecoin.MaxPrice,
-			FastRetrieval:   config.Filecoin.FastRetrieval,
-			DealStartOffset: config.Filecoin.DealStartOffset,
-			VerifiedDeal:    config.Filecoin.VerifiedDeal,
+			DataCapPolicy: toRPCDataCapPolicy(config.Filecoin.DataCapPolicy),
 		},
 	}
 }
 
+func toRPCStorageConfigPlan(plan ffs.StorageConfigPlan) *userPb.StorageConfigPlan {
+	plannedDeals := make([]*userPb.PlannedDeal, len(plan.Cold.Deals))
+	for i, d := range plan.Cold.Deals {
+		plannedDeals[i] = &userPb.PlannedDeal{
+			Miner:      d.Miner,
+			EpochPrice: d.EpochPrice,
+			Cost:       bigIntString(d.Cost),
+			Verified:   d.Verified,
+		}
+	}
+	return &userPb.StorageConfigPlan{
+		Cid: util.CidToString(plan.Cid),
+		Hot: &userPb.HotPlan{
+			Pin:      plan.Hot.Pin,
+			Unfreeze: plan.Hot.Unfreeze,
+			Unpin:    plan.Hot.Unpin,
+		},
+		Cold: &userPb.ColdPlan{
+			ActiveDeals: int64(plan.Cold.ActiveDeals),
+			PayloadSize: plan.Cold.PayloadSize,
+			PieceSize:   plan.Cold.PieceSize,
+			Deals:       plannedDeals,
+			TotalCost:   bigIntString(plan.Cold.TotalCost),
+			Warnings:    plan.Cold.Warnings,
+		},
+	}
+}
+
+func bigIntString(n *big.Int) string {
+	if n == nil {
+		return "0"
+	}
+	return n.String()
+}
+
 func fromRPCHotConfig(config *userPb.HotConfig) ffs.HotConfig {
 	res := ffs.HotConfig{}
 	if config != nil {
 		res.Enabled = config.Enabled
 		res.AllowUnfreeze = config.AllowUnfreeze
 		res.UnfreezeMaxPrice = config.UnfreezeMaxPrice
+		res.UnfreezeCacheTTL = int(config.UnfreezeCacheTtl)
 		if config.Ipfs != nil {
 			ipfs := ffs.IpfsConfig{
 				AddTimeout: int(config.Ipfs.AddTimeout),
@@ -69,30 +135,116 @@ func fromRPCColdConfig(config *userPb.ColdConfig) ffs.ColdConfig {
 		res.Enabled = config.Enabled
 		if config.Filecoin != nil {
 			filecoin := ffs.FilConfig{
-				RepFactor:       int(config.Filecoin.ReplicationFactor),
-				DealMinDuration: config.Filecoin.DealMinDuration,
-				ExcludedMiners:  config.Filecoin.ExcludedMiners,
-				CountryCodes:    config.Filecoin.CountryCodes,
-				TrustedMiners:   config.Filecoin.TrustedMiners,
-				Addr:            config.Filecoin.Address,
-				MaxPrice:        config.Filecoin.MaxPrice,
-				FastRetrieval:   config.Filecoin.FastRetrieval,
-				DealStartOffset: config.Filecoin.DealStartOffset,
-				VerifiedDeal:    config.Filecoin.VerifiedDeal,
+				RepFactor:        int(config.Filecoin.ReplicationFactor),
+				DealMinDuration:  config.Filecoin.DealMinDuration,
+				ExcludedMiners:   config.Filecoin.ExcludedMiners,
+				CountryCodes:     config.Filecoin.CountryCodes,
+				TrustedMiners:    config.Filecoin.TrustedMiners,
+				Addr:             config.Filecoin.Address,
+				MaxPrice:         config.Filecoin.MaxPrice,
+				FastRetrieval:    config.Filecoin.FastRetrieval,
+				DealStartOffset:  config.Filecoin.DealStartOffset,
+				VerifiedDeal:     config.Filecoin.VerifiedDeal,
+				MinCountries:     int(config.Filecoin.MinCountries),
+				MinRelativePower: config.Filecoin.MinRelativePower,
+				MaxFaults:        fromRPCMaxFaults(config.Filecoin.MaxFaults),
+				MaxFaultyRatio:   config.Filecoin.MaxFaultyRatio,
+				SectorSize:       config.Filecoin.SectorSize,
+				Aggregate:        config.Filecoin.Aggregate,
+				DataCapPolicy:    fromRPCDataCapPolicy(config.Filecoin.DataCapPolicy),
 			}
 			if config.Filecoin.Renew != nil {
 				renew := ffs.FilRenew{
 					Enabled:   config.Filecoin.Renew.Enabled,
 					Threshold: int(config.Filecoin.Renew.Threshold),
+					Unfreeze:  config.Filecoin.Renew.Unfreeze,
 				}
 				filecoin.Renew = renew
 			}
+			if config.Filecoin.PriceEscalation != nil {
+				filecoin.PriceEscalation = ffs.FilPriceEscalation{
+					StartPrice: config.Filecoin.PriceEscalation.StartPrice,
+					Step:       config.Filecoin.PriceEscalation.Step,
+					MaxPrice:   config.Filecoin.PriceEscalation.MaxPrice,
+				}
+			}
+			if config.Filecoin.Offline != nil {
+				filecoin.Offline = ffs.FilOffline{
+					PieceCid:  config.Filecoin.Offline.PieceCid,
+					PieceSize: config.Filecoin.Offline.PieceSize,
+				}
+			}
 			res.Filecoin = filecoin
 		}
 	}
 	return res
 }
 
+func fromRPCRetryPolicy(config *userPb.RetryPolicy) ffs.RetryPolicy {
+	res := ffs.RetryPolicy{}
+	if config != nil {
+		res.MaxAttempts = int(config.MaxAttempts)
+		res.InitialBackoff = int(config.InitialBackoff)
+		res.MaxBackoff = int(config.MaxBackoff)
+	}
+	return res
+}
+
+func fromRPCJobPriority(priority userPb.JobPriority) (ffs.JobPriority, error) {
+	switch priority {
+	case userPb.JobPriority_JOB_PRIORITY_UNSPECIFIED, userPb.JobPriority_JOB_PRIORITY_NORMAL:
+		return ffs.PriorityNormal, nil
+	case userPb.JobPriority_JOB_PRIORITY_LOW:
+		return ffs.PriorityLow, nil
+	case userPb.JobPriority_JOB_PRIORITY_HIGH:
+		return ffs.PriorityHigh, nil
+	case userPb.JobPriority_JOB_PRIORITY_URGENT:
+		return ffs.PriorityUrgent, nil
+	default:
+		return ffs.PriorityNormal, fmt.Errorf("unknown job priority: %v", priority)
+	}
+}
+
+func toRPCDataCapPolicy(policy ffs.DataCapPolicy) userPb.DataCapPolicy {
+	switch policy {
+	case ffs.DataCapPolicyFail:
+		return userPb.DataCapPolicy_DATA_CAP_POLICY_FAIL
+	case ffs.DataCapPolicyUnverified:
+		return userPb.DataCapPolicy_DATA_CAP_POLICY_UNVERIFIED
+	case ffs.DataCapPolicyPartial:
+		return userPb.DataCapPolicy_DATA_CAP_POLICY_PARTIAL
+	default:
+		return userPb.DataCapPolicy_DATA_CAP_POLICY_UNSPECIFIED
+	}
+}
+
+func fromRPCDataCapPolicy(policy userPb.DataCapPolicy) ffs.DataCapPolicy {
+	switch policy {
+	case userPb.DataCapPolicy_DATA_CAP_POLICY_UNVERIFIED:
+		return ffs.DataCapPolicyUnverified
+	case userPb.DataCapPolicy_DATA_CAP_POLICY_PARTIAL:
+		return ffs.DataCapPolicyPartial
+	default:
+		return ffs.DataCapPolicyFail
+	}
+}
+
+func toRPCMaxFaults(maxFaults *int) *int64 {
+	if maxFaults == nil {
+		return nil
+	}
+	res := int64(*maxFaults)
+	return &res
+}
+
+func fromRPCMaxFaults(maxFaults *int64) *int {
+	if maxFaults == nil {
+		return nil
+	}
+	res := int(*maxFaults)
+	return &res
+}
+
 func buildListDealRecordsOptions(conf *userPb.DealRecordsConfig) []deals.DealRecordsOption {
 	var opts []deals.DealRecordsOption
 	if conf != nil {
@@ -107,3 +259,64 @@ func buildListDealRecordsOptions(conf *userPb.DealRecordsConfig) []deals.DealRec
 	}
 	return opts
 }
+
+func toRPCRetrieval(r api.Retrieval) *userPb.Retrieval {
+	return &userPb.Retrieval{
+		Id:             r.ID.String(),
+		PayloadCid:     util.CidToString(r.PayloadCid),
+		PieceCid:       util.CidToString(r.PieceCid),
+		Selector:       r.Selector,
+		Miners:         r.Miners,
+		WalletAddress:  r.WalletAddress,
+		MaxPrice:       r.MaxPrice,
+		JobId:          r.JID.String(),
+		CreatedAt:      r.CreatedAt.UnixNano(),
+		RetrievalMiner: r.RetrievalMiner,
+		DataCid:        util.CidToString(r.DataCid),
+		Size:           r.Size,
+	}
+}
+
+func toRPCWebhook(w webhooks.Webhook) *userPb.Webhook {
+	events := make([]userPb.WebhookEvent, len(w.Events))
+	for i, e := range w.Events {
+		events[i] = toRPCWebhookEvent(e)
+	}
+	return &userPb.Webhook{
+		Id:        w.ID,
+		Url:       w.URL,
+		Events:    events,
+		Secret:    w.Secret,
+		CreatedAt: w.CreatedAt,
+	}
+}
+
+func toRPCWebhookEvent(e ffs.EventType) userPb.WebhookEvent {
+	switch e {
+	case ffs.EventJobStatus:
+		return userPb.WebhookEvent_WEBHOOK_EVENT_JOB_STATUS
+	case ffs.EventDealActive:
+		return userPb.WebhookEvent_WEBHOOK_EVENT_DEAL_ACTIVE
+	case ffs.EventDealFailed:
+		return userPb.WebhookEvent_WEBHOOK_EVENT_DEAL_FAILED
+	case ffs.EventRenewalCreated:
+		return userPb.WebhookEvent_WEBHOOK_EVENT_RENEWAL_CREATED
+	default:
+		return userPb.WebhookEvent_WEBHOOK_EVENT_UNSPECIFIED
+	}
+}
+
+func fromRPCWebhookEvent(e userPb.WebhookEvent) (ffs.EventType, error) {
+	switch e {
+	case userPb.WebhookEvent_WEBHOOK_EVENT_JOB_STATUS:
+		return ffs.EventJobStatus, nil
+	case userPb.WebhookEvent_WEBHOOK_EVENT_DEAL_ACTIVE:
+		return ffs.EventDealActive, nil
+	case userPb.WebhookEvent_WEBHOOK_EVENT_DEAL_FAILED:
+		return ffs.EventDealFailed, nil
+	case userPb.WebhookEvent_WEBHOOK_EVENT_RENEWAL_CREATED:
+		return ffs.EventRenewalCreated, nil
+	default:
+		return "", fmt.Errorf("unknown webhook event %s", e)
+	}
+}
@@ -0,0 +1,61 @@
+package user
+
+import (
+	"context"
+
+	userPb "github.com/textileio/powergate/v2/api/gen/powergate/user/v1"
+	"github.com/textileio/powergate/v2/ffs"
+	"github.com/textileio/powergate/v2/ffs/webhooks"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// RegisterWebhook registers a webhook which receives notifications of storage job and deal events.
+func (s *Service) RegisterWebhook(ctx context.Context, req *userPb.RegisterWebhookRequest) (*userPb.RegisterWebhookResponse, error) {
+	i, err := s.getInstanceByToken(ctx)
+	if err != nil {
+		return nil, err
+	}
+	events := make([]ffs.EventType, len(req.Events))
+	for j, e := range req.Events {
+		events[j], err = fromRPCWebhookEvent(e)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "parsing events: %v", err)
+		}
+	}
+	w, err := s.wh.Register(i.ID(), req.Url, events)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "registering webhook: %v", err)
+	}
+	return &userPb.RegisterWebhookResponse{Webhook: toRPCWebhook(w)}, nil
+}
+
+// Webhooks returns the registered webhooks.
+func (s *Service) Webhooks(ctx context.Context, req *userPb.WebhooksRequest) (*userPb.WebhooksResponse, error) {
+	i, err := s.getInstanceByToken(ctx)
+	if err != nil {
+		return nil, err
+	}
+	ws := s.wh.List(i.ID())
+	res := make([]*userPb.Webhook, len(ws))
+	for j, w := range ws {
+		res[j] = toRPCWebhook(w)
+	}
+	return &userPb.WebhooksResponse{Webhooks: res}, nil
+}
+
+// RemoveWebhook removes a registered webhook.
+func (s *Service) RemoveWebhook(ctx context.Context, req *userPb.RemoveWebhookRequest) (*userPb.RemoveWebhookResponse, error) {
+	i, err := s.getInstanceByToken(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.wh.Remove(i.ID(), req.Id); err != nil {
+		code := codes.Internal
+		if err == webhooks.ErrNotFound {
+			code = codes.NotFound
+		}
+		return nil, status.Errorf(code, "removing webhook: %v", err)
+	}
+	return &userPb.RemoveWebhookResponse{}, nil
+}
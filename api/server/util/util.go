@@ -2,12 +2,14 @@ package util
 
 import (
 	"fmt"
+	"math/big"
 	"time"
 
 	"github.com/ipfs/go-cid"
 	userPb "github.com/textileio/powergate/v2/api/gen/powergate/user/v1"
 	"github.com/textileio/powergate/v2/deals"
 	"github.com/textileio/powergate/v2/ffs"
+	"github.com/textileio/powergate/v2/ffs/budgets"
 	"github.com/textileio/powergate/v2/util"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
@@ -28,12 +30,17 @@ func ToRPCStorageInfo(info ffs.StorageInfo) *userPb.StorageInfo {
 		Cold: &userPb.ColdInfo{
 			Enabled: info.Cold.Enabled,
 			Filecoin: &userPb.FilInfo{
-				DataCid:   util.CidToString(info.Cold.Filecoin.DataCid),
-				Size:      info.Cold.Filecoin.Size,
-				Proposals: make([]*userPb.FilStorage, len(info.Cold.Filecoin.Proposals)),
+				DataCid:       util.CidToString(info.Cold.Filecoin.DataCid),
+				Size:          info.Cold.Filecoin.Size,
+				Proposals:     make([]*userPb.FilStorage, len(info.Cold.Filecoin.Proposals)),
+				RetrievalCost: info.Cold.Filecoin.RetrievalCost,
+				AggregatePath: info.Cold.Filecoin.AggregatePath,
 			},
 		},
 	}
+	if !info.Hot.Ipfs.CacheExpiration.IsZero() {
+		storageInfo.Hot.Ipfs.CacheExpiration = info.Hot.Ipfs.CacheExpiration.UnixNano()
+	}
 	for i, p := range info.Cold.Filecoin.Proposals {
 		var strPieceCid string
 		if p.PieceCid.Defined() {
@@ -43,6 +50,8 @@ func ToRPCStorageInfo(info ffs.StorageInfo) *userPb.StorageInfo {
 			DealId:     int64(p.DealID),
 			PieceCid:   strPieceCid,
 			Renewed:    p.Renewed,
+			Replaced:   p.Replaced,
+			Faulted:    p.Faulted,
 			Duration:   p.Duration,
 			StartEpoch: p.StartEpoch,
 			Miner:      p.Miner,
@@ -86,33 +95,74 @@ func ToRPCJob(job ffs.StorageJob) (*userPb.StorageJob, error) {
 		dealInfo = append(dealInfo, info)
 	}
 
-	var status userPb.JobStatus
-	switch job.Status {
+	status, err := toRPCJobStatus(job.Status)
+	if err != nil {
+		return nil, err
+	}
+
+	var priority userPb.JobPriority
+	switch job.Priority {
+	case ffs.PriorityLow:
+		priority = userPb.JobPriority_JOB_PRIORITY_LOW
+	case ffs.PriorityNormal:
+		priority = userPb.JobPriority_JOB_PRIORITY_NORMAL
+	case ffs.PriorityHigh:
+		priority = userPb.JobPriority_JOB_PRIORITY_HIGH
+	case ffs.PriorityUrgent:
+		priority = userPb.JobPriority_JOB_PRIORITY_URGENT
+	default:
+		return nil, fmt.Errorf("unknown job priority: %v", job.Priority)
+	}
+	return &userPb.StorageJob{
+		Id:            job.ID.String(),
+		ApiId:         job.APIID.String(),
+		Cid:           util.CidToString(job.Cid),
+		Status:        status,
+		ErrorCause:    job.ErrCause,
+		DealErrors:    toRPCDealErrors(job.DealErrors),
+		CreatedAt:     job.CreatedAt,
+		DealInfo:      dealInfo,
+		Priority:      priority,
+		Attempt:       int64(job.Attempt),
+		PreviousJobId: job.PreviousJobID.String(),
+		NotBefore:     job.NotBefore,
+	}, nil
+}
+
+// ToRPCRetrievalJob converts a retrieval job to a proto retrieval job.
+func ToRPCRetrievalJob(job ffs.RetrievalJob) (*userPb.RetrievalJob, error) {
+	status, err := toRPCJobStatus(job.Status)
+	if err != nil {
+		return nil, err
+	}
+	return &userPb.RetrievalJob{
+		Id:          job.ID.String(),
+		ApiId:       job.APIID.String(),
+		RetrievalId: job.RetrievalID.String(),
+		Status:      status,
+		ErrorCause:  job.ErrCause,
+	}, nil
+}
+
+func toRPCJobStatus(s ffs.JobStatus) (userPb.JobStatus, error) {
+	switch s {
 	case ffs.Unspecified:
-		status = userPb.JobStatus_JOB_STATUS_UNSPECIFIED
+		return userPb.JobStatus_JOB_STATUS_UNSPECIFIED, nil
 	case ffs.Queued:
-		status = userPb.JobStatus_JOB_STATUS_QUEUED
+		return userPb.JobStatus_JOB_STATUS_QUEUED, nil
 	case ffs.Executing:
-		status = userPb.JobStatus_JOB_STATUS_EXECUTING
+		return userPb.JobStatus_JOB_STATUS_EXECUTING, nil
 	case ffs.Failed:
-		status = userPb.JobStatus_JOB_STATUS_FAILED
+		return userPb.JobStatus_JOB_STATUS_FAILED, nil
 	case ffs.Canceled:
-		status = userPb.JobStatus_JOB_STATUS_CANCELED
+		return userPb.JobStatus_JOB_STATUS_CANCELED, nil
 	case ffs.Success:
-		status = userPb.JobStatus_JOB_STATUS_SUCCESS
+		return userPb.JobStatus_JOB_STATUS_SUCCESS, nil
+	case ffs.Scheduled:
+		return userPb.JobStatus_JOB_STATUS_SCHEDULED, nil
 	default:
-		return nil, fmt.Errorf("unknown job status: %v", job.Status)
+		return userPb.JobStatus_JOB_STATUS_UNSPECIFIED, fmt.Errorf("unknown job status: %v", s)
 	}
-	return &userPb.StorageJob{
-		Id:         job.ID.String(),
-		ApiId:      job.APIID.String(),
-		Cid:        util.CidToString(job.Cid),
-		Status:     status,
-		ErrorCause: job.ErrCause,
-		DealErrors: toRPCDealErrors(job.DealErrors),
-		CreatedAt:  job.CreatedAt,
-		DealInfo:   dealInfo,
-	}, nil
 }
 
 func toRPCDealErrors(des []ffs.DealError) []*userPb.DealError {
@@ -205,3 +255,59 @@ func ToRPCRetrievalDealRecords(records []deals.RetrievalDealRecord) []*userPb.Re
 	}
 	return ret
 }
+
+// ToRPCBudgetStatus converts a budgets.Status to the proto version.
+// Undefined amounts, which mean no limit, are converted to empty strings.
+func ToRPCBudgetStatus(st budgets.Status) *userPb.BudgetStatus {
+	return &userPb.BudgetStatus{
+		Admin:            ToRPCBudget(st.Admin),
+		User:             ToRPCBudget(st.User),
+		MonthlySpent:     bigIntString(st.MonthlySpent),
+		MonthlyRemaining: bigIntString(st.MonthlyRemaining),
+		CidSpent:         bigIntString(st.CidSpent),
+		CidRemaining:     bigIntString(st.CidRemaining),
+	}
+}
+
+// ToRPCBudget converts a budgets.Budget to the proto version.
+func ToRPCBudget(b budgets.Budget) *userPb.Budget {
+	return &userPb.Budget{
+		MonthlyLimit: bigIntString(b.MonthlyLimit),
+		CidLimit:     bigIntString(b.CidLimit),
+	}
+}
+
+// FromRPCBudget converts a proto Budget to a budgets.Budget. Empty
+// limits are converted to no limit.
+func FromRPCBudget(b *userPb.Budget) (budgets.Budget, error) {
+	var res budgets.Budget
+	if b == nil {
+		return res, nil
+	}
+	var err error
+	if res.MonthlyLimit, err = parseBigInt(b.MonthlyLimit); err != nil {
+		return budgets.Budget{}, fmt.Errorf("parsing monthly limit: %s", err)
+	}
+	if res.CidLimit, err = parseBigInt(b.CidLimit); err != nil {
+		return budgets.Budget{}, fmt.Errorf("parsing cid limit: %s", err)
+	}
+	return res, nil
+}
+
+func bigIntString(n *big.Int) string {
+	if n == nil {
+		return ""
+	}
+	return n.String()
+}
+
+func parseBigInt(s string) (*big.Int, error) {
+	if s == "" {
+		return nil, nil
+	}
+	n, ok := new(big.Int).SetString(s, 10)
+	if !ok {
+		return nil, fmt.Errorf("%s isn't a valid integer", s)
+	}
+	return n, nil
+}
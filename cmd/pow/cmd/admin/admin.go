@@ -3,6 +3,8 @@ package admin
 import (
 	"github.com/spf13/cobra"
 	"github.com/textileio/powergate/v2/cmd/pow/cmd/admin/data"
+	"github.com/textileio/powergate/v2/cmd/pow/cmd/admin/retrievals"
+	"github.com/textileio/powergate/v2/cmd/pow/cmd/admin/scheduler"
 	"github.com/textileio/powergate/v2/cmd/pow/cmd/admin/storageinfo"
 	"github.com/textileio/powergate/v2/cmd/pow/cmd/admin/storagejobs"
 	"github.com/textileio/powergate/v2/cmd/pow/cmd/admin/users"
@@ -14,6 +16,8 @@ func init() {
 
 	Cmd.AddCommand(
 		data.Cmd,
+		retrievals.Cmd,
+		scheduler.Cmd,
 		storagejobs.Cmd,
 		storageinfo.Cmd,
 		users.Cmd,
@@ -0,0 +1,34 @@
+package ranking
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	c "github.com/textileio/powergate/v2/cmd/pow/common"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// Cmd is the command.
+var Cmd = &cobra.Command{
+	Use:   "ranking [miner...]",
+	Short: "Returns how miners are ranked for retrievals based on past retrievals, for the provided miners or all miners with past retrievals.",
+	Long:  `Returns how miners are ranked for retrievals based on past retrievals, for the provided miners or all miners with past retrievals.`,
+	PreRun: func(cmd *cobra.Command, args []string) {
+		err := viper.BindPFlags(cmd.Flags())
+		c.CheckErr(err)
+	},
+	Run: func(cmd *cobra.Command, args []string) {
+		ctx, cancel := context.WithTimeout(context.Background(), c.CmdTimeout)
+		defer cancel()
+
+		res, err := c.PowClient.Admin.Records.RetrievalMinerRanking(c.AdminAuthCtx(ctx), args)
+		c.CheckErr(err)
+
+		json, err := protojson.MarshalOptions{Multiline: true, Indent: "  ", EmitUnpopulated: true}.Marshal(res)
+		c.CheckErr(err)
+
+		fmt.Println(string(json))
+	},
+}
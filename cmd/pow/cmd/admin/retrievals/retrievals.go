@@ -0,0 +1,17 @@
+package retrievals
+
+import (
+	"github.com/spf13/cobra"
+	"github.com/textileio/powergate/v2/cmd/pow/cmd/admin/retrievals/ranking"
+)
+
+func init() {
+	Cmd.AddCommand(ranking.Cmd)
+}
+
+// Cmd is the command.
+var Cmd = &cobra.Command{
+	Use:   "retrievals",
+	Short: "Provides admin retrievals commands",
+	Long:  `Provides admin retrievals commands`,
+}
@@ -0,0 +1,32 @@
+package drain
+
+import (
+	"context"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	c "github.com/textileio/powergate/v2/cmd/pow/common"
+)
+
+func init() {
+	Cmd.Flags().DurationP("timeout", "d", 0, "interrupt and re-queue jobs still executing after this duration, 0 means never")
+}
+
+// Cmd is the command.
+var Cmd = &cobra.Command{
+	Use:   "drain",
+	Short: "Stop executing queued storage and retrieval jobs",
+	Long:  `Stop executing queued storage and retrieval jobs, letting executing jobs finish. Use drain-status to know when there're no executing jobs.`,
+	Args:  cobra.NoArgs,
+	PreRun: func(cmd *cobra.Command, args []string) {
+		err := viper.BindPFlags(cmd.Flags())
+		c.CheckErr(err)
+	},
+	Run: func(cmd *cobra.Command, args []string) {
+		ctx, cancel := context.WithTimeout(context.Background(), c.CmdTimeout)
+		defer cancel()
+
+		_, err := c.PowClient.Admin.Scheduler.Drain(c.AdminAuthCtx(ctx), viper.GetDuration("timeout"))
+		c.CheckErr(err)
+	},
+}
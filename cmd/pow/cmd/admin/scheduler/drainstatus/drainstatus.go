@@ -0,0 +1,48 @@
+package drainstatus
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	adminPb "github.com/textileio/powergate/v2/api/gen/powergate/admin/v1"
+	c "github.com/textileio/powergate/v2/cmd/pow/common"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+func init() {
+	Cmd.Flags().BoolP("wait", "w", false, "wait until there're no executing jobs")
+}
+
+// Cmd is the command.
+var Cmd = &cobra.Command{
+	Use:   "drain-status",
+	Short: "Show the scheduler draining state and the number of executing jobs",
+	Long:  `Show the scheduler draining state and the number of executing jobs`,
+	Args:  cobra.NoArgs,
+	PreRun: func(cmd *cobra.Command, args []string) {
+		err := viper.BindPFlags(cmd.Flags())
+		c.CheckErr(err)
+	},
+	Run: func(cmd *cobra.Command, args []string) {
+		var res *adminPb.DrainStatusResponse
+		for {
+			ctx, cancel := context.WithTimeout(context.Background(), c.CmdTimeout)
+			var err error
+			res, err = c.PowClient.Admin.Scheduler.DrainStatus(c.AdminAuthCtx(ctx))
+			cancel()
+			c.CheckErr(err)
+			if !viper.GetBool("wait") || res.ExecutingStorageJobs+res.ExecutingRetrievalJobs == 0 {
+				break
+			}
+			time.Sleep(time.Second)
+		}
+
+		json, err := protojson.MarshalOptions{Multiline: true, Indent: "  ", EmitUnpopulated: true}.Marshal(res)
+		c.CheckErr(err)
+
+		fmt.Println(string(json))
+	},
+}
@@ -0,0 +1,19 @@
+package scheduler
+
+import (
+	"github.com/spf13/cobra"
+	"github.com/textileio/powergate/v2/cmd/pow/cmd/admin/scheduler/drain"
+	"github.com/textileio/powergate/v2/cmd/pow/cmd/admin/scheduler/drainstatus"
+	"github.com/textileio/powergate/v2/cmd/pow/cmd/admin/scheduler/undrain"
+)
+
+func init() {
+	Cmd.AddCommand(drain.Cmd, drainstatus.Cmd, undrain.Cmd)
+}
+
+// Cmd is the command.
+var Cmd = &cobra.Command{
+	Use:   "scheduler",
+	Short: "Provides admin scheduler commands",
+	Long:  `Provides admin scheduler commands`,
+}
@@ -0,0 +1,28 @@
+package undrain
+
+import (
+	"context"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	c "github.com/textileio/powergate/v2/cmd/pow/common"
+)
+
+// Cmd is the command.
+var Cmd = &cobra.Command{
+	Use:   "undrain",
+	Short: "Resume executing queued storage and retrieval jobs",
+	Long:  `Resume executing queued storage and retrieval jobs`,
+	Args:  cobra.NoArgs,
+	PreRun: func(cmd *cobra.Command, args []string) {
+		err := viper.BindPFlags(cmd.Flags())
+		c.CheckErr(err)
+	},
+	Run: func(cmd *cobra.Command, args []string) {
+		ctx, cancel := context.WithTimeout(context.Background(), c.CmdTimeout)
+		defer cancel()
+
+		_, err := c.PowClient.Admin.Scheduler.Undrain(c.AdminAuthCtx(ctx))
+		c.CheckErr(err)
+	},
+}
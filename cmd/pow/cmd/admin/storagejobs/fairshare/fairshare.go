@@ -0,0 +1,48 @@
+package fairshare
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	c "github.com/textileio/powergate/v2/cmd/pow/common"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+func init() {
+	Cmd.Flags().Int64P("weight", "w", 1, "relative share of storage job execution slots compared to other users")
+	Cmd.Flags().Int64P("max-executing", "m", 0, "max number of concurrently executing storage jobs, 0 means no limit")
+	Cmd.Flags().Bool("remove", false, "remove the configuration, so the user gets the default share")
+}
+
+// Cmd is the command.
+var Cmd = &cobra.Command{
+	Use:   "fair-share [user-id]",
+	Short: "Set the fair-share storage job scheduling configuration of a user",
+	Long:  `Set the fair-share storage job scheduling configuration of a user`,
+	Args:  cobra.ExactArgs(1),
+	PreRun: func(cmd *cobra.Command, args []string) {
+		err := viper.BindPFlags(cmd.Flags())
+		c.CheckErr(err)
+	},
+	Run: func(cmd *cobra.Command, args []string) {
+		ctx, cancel := context.WithTimeout(context.Background(), c.CmdTimeout)
+		defer cancel()
+
+		var res proto.Message
+		var err error
+		if viper.GetBool("remove") {
+			res, err = c.PowClient.Admin.StorageJobs.RemoveUserFairShare(c.AdminAuthCtx(ctx), args[0])
+		} else {
+			res, err = c.PowClient.Admin.StorageJobs.SetUserFairShare(c.AdminAuthCtx(ctx), args[0], viper.GetInt64("weight"), viper.GetInt64("max-executing"))
+		}
+		c.CheckErr(err)
+
+		json, err := protojson.MarshalOptions{Multiline: true, Indent: "  ", EmitUnpopulated: true}.Marshal(res)
+		c.CheckErr(err)
+
+		fmt.Println(string(json))
+	},
+}
@@ -0,0 +1,35 @@
+package fairshares
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	c "github.com/textileio/powergate/v2/cmd/pow/common"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// Cmd is the command.
+var Cmd = &cobra.Command{
+	Use:   "fair-shares",
+	Short: "List the fair-share storage job scheduling configuration of users",
+	Long:  `List the fair-share storage job scheduling configuration of users`,
+	Args:  cobra.NoArgs,
+	PreRun: func(cmd *cobra.Command, args []string) {
+		err := viper.BindPFlags(cmd.Flags())
+		c.CheckErr(err)
+	},
+	Run: func(cmd *cobra.Command, args []string) {
+		ctx, cancel := context.WithTimeout(context.Background(), c.CmdTimeout)
+		defer cancel()
+
+		res, err := c.PowClient.Admin.StorageJobs.UserFairShares(c.AdminAuthCtx(ctx))
+		c.CheckErr(err)
+
+		json, err := protojson.MarshalOptions{Multiline: true, Indent: "  ", EmitUnpopulated: true}.Marshal(res)
+		c.CheckErr(err)
+
+		fmt.Println(string(json))
+	},
+}
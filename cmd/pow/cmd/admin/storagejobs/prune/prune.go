@@ -0,0 +1,40 @@
+package prune
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	c "github.com/textileio/powergate/v2/cmd/pow/common"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+func init() {
+	Cmd.Flags().DurationP("max-age", "a", 0, "max age of final storage jobs, 0 uses the powergate configured value")
+	Cmd.Flags().Int64P("max-per-cid", "m", 0, "max number of final storage jobs per user and cid, 0 uses the powergate configured value")
+}
+
+// Cmd is the command.
+var Cmd = &cobra.Command{
+	Use:   "prune",
+	Short: "Delete old final storage jobs and their logs",
+	Long:  `Delete old final storage jobs and their logs. The last successful storage job of each user and cid is always kept.`,
+	Args:  cobra.NoArgs,
+	PreRun: func(cmd *cobra.Command, args []string) {
+		err := viper.BindPFlags(cmd.Flags())
+		c.CheckErr(err)
+	},
+	Run: func(cmd *cobra.Command, args []string) {
+		ctx, cancel := context.WithTimeout(context.Background(), c.CmdTimeout)
+		defer cancel()
+
+		res, err := c.PowClient.Admin.StorageJobs.Prune(c.AdminAuthCtx(ctx), viper.GetDuration("max-age"), viper.GetInt64("max-per-cid"))
+		c.CheckErr(err)
+
+		json, err := protojson.MarshalOptions{Multiline: true, Indent: "  ", EmitUnpopulated: true}.Marshal(res)
+		c.CheckErr(err)
+
+		fmt.Println(string(json))
+	},
+}
@@ -2,12 +2,15 @@ package storagejobs
 
 import (
 	"github.com/spf13/cobra"
+	"github.com/textileio/powergate/v2/cmd/pow/cmd/admin/storagejobs/fairshare"
+	"github.com/textileio/powergate/v2/cmd/pow/cmd/admin/storagejobs/fairshares"
 	"github.com/textileio/powergate/v2/cmd/pow/cmd/admin/storagejobs/list"
+	"github.com/textileio/powergate/v2/cmd/pow/cmd/admin/storagejobs/prune"
 	"github.com/textileio/powergate/v2/cmd/pow/cmd/admin/storagejobs/summary"
 )
 
 func init() {
-	Cmd.AddCommand(summary.Cmd, list.Cmd)
+	Cmd.AddCommand(summary.Cmd, list.Cmd, fairshare.Cmd, fairshares.Cmd, prune.Cmd)
 }
 
 // Cmd is the command.
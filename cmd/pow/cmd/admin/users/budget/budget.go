@@ -0,0 +1,40 @@
+package budget
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	c "github.com/textileio/powergate/v2/cmd/pow/common"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// Cmd is the command.
+var Cmd = &cobra.Command{
+	Use:   "budget [user-id] [optional cid]",
+	Short: "Get the spending limits of a user, and the spent and remaining amounts",
+	Long:  `Get the spending limits of a user, and the spent and remaining amounts. If a cid is provided, the amounts for it are also included.`,
+	Args:  cobra.RangeArgs(1, 2),
+	PreRun: func(cmd *cobra.Command, args []string) {
+		err := viper.BindPFlags(cmd.Flags())
+		c.CheckErr(err)
+	},
+	Run: func(cmd *cobra.Command, args []string) {
+		ctx, cancel := context.WithTimeout(context.Background(), c.CmdTimeout)
+		defer cancel()
+
+		var cid string
+		if len(args) == 2 {
+			cid = args[1]
+		}
+
+		res, err := c.PowClient.Admin.Users.Budget(c.AdminAuthCtx(ctx), args[0], cid)
+		c.CheckErr(err)
+
+		json, err := protojson.MarshalOptions{Multiline: true, Indent: "  ", EmitUnpopulated: true}.Marshal(res.Status)
+		c.CheckErr(err)
+
+		fmt.Println(string(json))
+	},
+}
@@ -0,0 +1,40 @@
+package setbudget
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	c "github.com/textileio/powergate/v2/cmd/pow/common"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+func init() {
+	Cmd.Flags().StringP("monthly", "m", "", "max attoFIL spent in deals per calendar month, empty means no limit")
+	Cmd.Flags().StringP("cid", "c", "", "max attoFIL spent in deals per cid lifetime, empty means no limit")
+}
+
+// Cmd is the command.
+var Cmd = &cobra.Command{
+	Use:   "set-budget [user-id]",
+	Short: "Set the spending limits of Filecoin deals of a user",
+	Long:  `Set the spending limits of Filecoin deals of a user. They apply together with the limits set by the user, using the most restrictive ones. Deals are charged for their whole duration in the calendar month they're proposed.`,
+	Args:  cobra.ExactArgs(1),
+	PreRun: func(cmd *cobra.Command, args []string) {
+		err := viper.BindPFlags(cmd.Flags())
+		c.CheckErr(err)
+	},
+	Run: func(cmd *cobra.Command, args []string) {
+		ctx, cancel := context.WithTimeout(context.Background(), c.CmdTimeout)
+		defer cancel()
+
+		res, err := c.PowClient.Admin.Users.SetBudget(c.AdminAuthCtx(ctx), args[0], viper.GetString("monthly"), viper.GetString("cid"))
+		c.CheckErr(err)
+
+		json, err := protojson.MarshalOptions{Multiline: true, Indent: "  ", EmitUnpopulated: true}.Marshal(res)
+		c.CheckErr(err)
+
+		fmt.Println(string(json))
+	},
+}
@@ -2,13 +2,15 @@ package users
 
 import (
 	"github.com/spf13/cobra"
+	"github.com/textileio/powergate/v2/cmd/pow/cmd/admin/users/budget"
 	"github.com/textileio/powergate/v2/cmd/pow/cmd/admin/users/create"
 	"github.com/textileio/powergate/v2/cmd/pow/cmd/admin/users/list"
 	"github.com/textileio/powergate/v2/cmd/pow/cmd/admin/users/regenerate"
+	"github.com/textileio/powergate/v2/cmd/pow/cmd/admin/users/setbudget"
 )
 
 func init() {
-	Cmd.AddCommand(create.Cmd, list.Cmd, regenerate.Cmd)
+	Cmd.AddCommand(budget.Cmd, create.Cmd, list.Cmd, regenerate.Cmd, setbudget.Cmd)
 }
 
 // Cmd is the command.
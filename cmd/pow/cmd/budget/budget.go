@@ -0,0 +1,18 @@
+package budget
+
+import (
+	"github.com/spf13/cobra"
+	"github.com/textileio/powergate/v2/cmd/pow/cmd/budget/get"
+	"github.com/textileio/powergate/v2/cmd/pow/cmd/budget/set"
+)
+
+func init() {
+	Cmd.AddCommand(get.Cmd, set.Cmd)
+}
+
+// Cmd is the command.
+var Cmd = &cobra.Command{
+	Use:   "budget",
+	Short: "Provides commands to manage spending limits of Filecoin deals",
+	Long:  `Provides commands to manage spending limits of Filecoin deals. Deals are charged for their whole duration in the calendar month they're proposed.`,
+}
@@ -0,0 +1,40 @@
+package get
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	c "github.com/textileio/powergate/v2/cmd/pow/common"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// Cmd is the command.
+var Cmd = &cobra.Command{
+	Use:   "get [optional cid]",
+	Short: "Get the spending limits, and the spent and remaining amounts",
+	Long:  `Get the spending limits, and the spent and remaining amounts. If a cid is provided, the amounts for it are also included.`,
+	Args:  cobra.MaximumNArgs(1),
+	PreRun: func(cmd *cobra.Command, args []string) {
+		err := viper.BindPFlags(cmd.Flags())
+		c.CheckErr(err)
+	},
+	Run: func(cmd *cobra.Command, args []string) {
+		ctx, cancel := context.WithTimeout(context.Background(), c.CmdTimeout)
+		defer cancel()
+
+		var cid string
+		if len(args) == 1 {
+			cid = args[0]
+		}
+
+		res, err := c.PowClient.Budgets.Get(c.MustAuthCtx(ctx), cid)
+		c.CheckErr(err)
+
+		json, err := protojson.MarshalOptions{Multiline: true, Indent: "  ", EmitUnpopulated: true}.Marshal(res.Status)
+		c.CheckErr(err)
+
+		fmt.Println(string(json))
+	},
+}
@@ -0,0 +1,40 @@
+package set
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	c "github.com/textileio/powergate/v2/cmd/pow/common"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+func init() {
+	Cmd.Flags().StringP("monthly", "m", "", "max attoFIL spent in deals per calendar month, empty means no limit")
+	Cmd.Flags().StringP("cid", "c", "", "max attoFIL spent in deals per cid lifetime, empty means no limit")
+}
+
+// Cmd is the command.
+var Cmd = &cobra.Command{
+	Use:   "set",
+	Short: "Set the spending limits of Filecoin deals",
+	Long:  `Set the spending limits of Filecoin deals. Storage jobs making deals that would exceed these limits, or the ones set by an admin, fail before proposing them. Deals are charged for their whole duration in the calendar month they're proposed.`,
+	Args:  cobra.NoArgs,
+	PreRun: func(cmd *cobra.Command, args []string) {
+		err := viper.BindPFlags(cmd.Flags())
+		c.CheckErr(err)
+	},
+	Run: func(cmd *cobra.Command, args []string) {
+		ctx, cancel := context.WithTimeout(context.Background(), c.CmdTimeout)
+		defer cancel()
+
+		res, err := c.PowClient.Budgets.Set(c.MustAuthCtx(ctx), viper.GetString("monthly"), viper.GetString("cid"))
+		c.CheckErr(err)
+
+		json, err := protojson.MarshalOptions{Multiline: true, Indent: "  ", EmitUnpopulated: true}.Marshal(res)
+		c.CheckErr(err)
+
+		fmt.Println(string(json))
+	},
+}
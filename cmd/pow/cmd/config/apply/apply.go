@@ -20,6 +20,12 @@ import (
 
 var (
 	log = logging.Logger("apply")
+
+	priorities = map[string]userPb.JobPriority{
+		"low":    userPb.JobPriority_JOB_PRIORITY_LOW,
+		"normal": userPb.JobPriority_JOB_PRIORITY_NORMAL,
+		"high":   userPb.JobPriority_JOB_PRIORITY_HIGH,
+	}
 )
 
 func init() {
@@ -28,6 +34,8 @@ func init() {
 	Cmd.Flags().BoolP("noexec", "e", false, "If set, it doesn't create a job to ensure the new configuration")
 	Cmd.Flags().BoolP("watch", "w", false, "Watch the progress of the resulting job")
 	Cmd.Flags().StringSliceP("import-deals", "i", nil, "Comma-separated list of deal ids to import")
+	Cmd.Flags().StringP("priority", "p", "", "Priority of the resulting job in the queue (low, normal or high)")
+	Cmd.Flags().String("not-before", "", "RFC3339 time before which the resulting job won't be queued for execution")
 }
 
 // Cmd is the command.
@@ -97,6 +105,20 @@ var Cmd = &cobra.Command{
 			options = append(options, client.WithImportDealIDs(dealIDs))
 		}
 
+		if viper.IsSet("priority") {
+			priority, ok := priorities[viper.GetString("priority")]
+			if !ok {
+				c.Fatal(fmt.Errorf("unknown priority %s", viper.GetString("priority")))
+			}
+			options = append(options, client.WithPriority(priority))
+		}
+
+		if viper.IsSet("not-before") {
+			notBefore, err := time.Parse(time.RFC3339, viper.GetString("not-before"))
+			c.CheckErr(err)
+			options = append(options, client.WithNotBefore(notBefore))
+		}
+
 		res, err := c.PowClient.StorageConfig.Apply(c.MustAuthCtx(ctx), args[0], options...)
 		c.CheckErr(err)
 
@@ -4,12 +4,13 @@ import (
 	"github.com/spf13/cobra"
 	"github.com/textileio/powergate/v2/cmd/pow/cmd/config/apply"
 	"github.com/textileio/powergate/v2/cmd/pow/cmd/config/getdefault"
+	"github.com/textileio/powergate/v2/cmd/pow/cmd/config/plan"
 	"github.com/textileio/powergate/v2/cmd/pow/cmd/config/remove"
 	"github.com/textileio/powergate/v2/cmd/pow/cmd/config/setdefault"
 )
 
 func init() {
-	Cmd.AddCommand(apply.Cmd, getdefault.Cmd, remove.Cmd, setdefault.Cmd)
+	Cmd.AddCommand(apply.Cmd, getdefault.Cmd, plan.Cmd, remove.Cmd, setdefault.Cmd)
 }
 
 // Cmd is the command.
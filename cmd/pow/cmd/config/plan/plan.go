@@ -0,0 +1,80 @@
+package plan
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	logging "github.com/ipfs/go-log/v2"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	userPb "github.com/textileio/powergate/v2/api/gen/powergate/user/v1"
+	c "github.com/textileio/powergate/v2/cmd/pow/common"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+var (
+	log = logging.Logger("plan")
+)
+
+func init() {
+	Cmd.Flags().StringP("conf", "c", "", "Optional path to a file containing storage config json, falls back to stdin, uses the user default by default")
+}
+
+// Cmd is the command.
+var Cmd = &cobra.Command{
+	Use:   "plan [cid]",
+	Short: "Show what applying the default or provided storage config to the specified cid would do",
+	Long:  `Show what applying the default or provided storage config to the specified cid would do, including selected miners and estimated deal costs, without creating a job`,
+	Args:  cobra.ExactArgs(1),
+	PreRun: func(cmd *cobra.Command, args []string) {
+		err := viper.BindPFlags(cmd.Flags())
+		c.CheckErr(err)
+	},
+	Run: func(cmd *cobra.Command, args []string) {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second*60)
+		defer cancel()
+
+		configPath := viper.GetString("conf")
+
+		var reader io.Reader
+		if len(configPath) > 0 {
+			file, err := os.Open(configPath)
+			defer func() {
+				if err := file.Close(); err != nil {
+					log.Errorf("closing config file: %s", err)
+				}
+			}()
+			reader = file
+			c.CheckErr(err)
+		} else {
+			stat, _ := os.Stdin.Stat()
+			// stdin is being piped in (not being read from terminal)
+			if (stat.Mode() & os.ModeCharDevice) == 0 {
+				reader = cmd.InOrStdin()
+			}
+		}
+
+		var config *userPb.StorageConfig
+		if reader != nil {
+			buf := new(bytes.Buffer)
+			_, err := buf.ReadFrom(reader)
+			c.CheckErr(err)
+
+			config = &userPb.StorageConfig{}
+			err = protojson.UnmarshalOptions{}.Unmarshal(buf.Bytes(), config)
+			c.CheckErr(err)
+		}
+
+		res, err := c.PowClient.StorageConfig.Plan(c.MustAuthCtx(ctx), args[0], config)
+		c.CheckErr(err)
+
+		json, err := protojson.MarshalOptions{Multiline: true, Indent: "  ", EmitUnpopulated: true}.Marshal(res)
+		c.CheckErr(err)
+
+		fmt.Println(string(json))
+	},
+}
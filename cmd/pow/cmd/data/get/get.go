@@ -10,12 +10,16 @@ import (
 	"github.com/caarlos0/spin"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
+	"github.com/textileio/powergate/v2/api/client"
 	c "github.com/textileio/powergate/v2/cmd/pow/common"
 )
 
 func init() {
 	Cmd.Flags().String("ipfsrevproxy", "localhost:6002", "Powergate IPFS reverse proxy DNS address. If port 443, is assumed is a HTTPS endpoint.")
 	Cmd.Flags().BoolP("folder", "f", false, "Indicates that the retrieved Cid is a folder")
+	Cmd.Flags().String("path", "", "UnixFS path inside the Cid to retrieve")
+	Cmd.Flags().Int64("offset", 0, "Byte offset from which data is retrieved")
+	Cmd.Flags().Int64("length", 0, "Maximum number of bytes to retrieve, zero retrieves until the end")
 }
 
 // Cmd is the command.
@@ -40,7 +44,12 @@ var Cmd = &cobra.Command{
 			err := c.PowClient.Data.GetFolder(c.MustAuthCtx(ctx), viper.GetString("ipfsrevproxy"), args[0], args[1])
 			c.CheckErr(err)
 		} else {
-			reader, err := c.PowClient.Data.Get(c.MustAuthCtx(ctx), args[0])
+			opts := []client.GetOption{
+				client.WithGetPath(viper.GetString("path")),
+				client.WithGetOffset(viper.GetInt64("offset")),
+				client.WithGetLength(viper.GetInt64("length")),
+			}
+			reader, err := c.PowClient.Data.Get(c.MustAuthCtx(ctx), args[0], opts...)
 			c.CheckErr(err)
 
 			dir := path.Dir(args[1])
@@ -0,0 +1,92 @@
+package stage
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+)
+
+// uploadSessionsPath returns the path of the file persisting the upload
+// sessions of files being staged, so interrupted uploads are resumed by
+// staging the same file again.
+func uploadSessionsPath() (string, error) {
+	dir, err := os.UserConfigDir()
+	if err != nil {
+		return "", fmt.Errorf("getting user config dir: %s", err)
+	}
+	return filepath.Join(dir, "pow", "uploads.json"), nil
+}
+
+// uploadSessionKey identifies a file by its absolute path, size and
+// modification time, so a modified file isn't resumed.
+func uploadSessionKey(path string, fi os.FileInfo) (string, error) {
+	abs, err := filepath.Abs(path)
+	if err != nil {
+		return "", fmt.Errorf("getting absolute path: %s", err)
+	}
+	return fmt.Sprintf("%s:%d:%d", abs, fi.Size(), fi.ModTime().UnixNano()), nil
+}
+
+func loadUploadSessions() (map[string]string, error) {
+	path, err := uploadSessionsPath()
+	if err != nil {
+		return nil, err
+	}
+	sessions := make(map[string]string)
+	buf, err := ioutil.ReadFile(path)
+	if os.IsNotExist(err) {
+		return sessions, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("reading upload sessions: %s", err)
+	}
+	if err := json.Unmarshal(buf, &sessions); err != nil {
+		return nil, fmt.Errorf("unmarshaling upload sessions: %s", err)
+	}
+	return sessions, nil
+}
+
+func saveUploadSessions(sessions map[string]string) error {
+	path, err := uploadSessionsPath()
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+		return fmt.Errorf("creating upload sessions dir: %s", err)
+	}
+	buf, err := json.Marshal(sessions)
+	if err != nil {
+		return fmt.Errorf("marshaling upload sessions: %s", err)
+	}
+	if err := ioutil.WriteFile(path, buf, 0600); err != nil {
+		return fmt.Errorf("writing upload sessions: %s", err)
+	}
+	return nil
+}
+
+// getUploadSession returns the upload session of a file, or an empty
+// string if there isn't one.
+func getUploadSession(key string) (string, error) {
+	sessions, err := loadUploadSessions()
+	if err != nil {
+		return "", err
+	}
+	return sessions[key], nil
+}
+
+// putUploadSession saves the upload session of a file. An empty
+// session id removes it.
+func putUploadSession(key, sessionID string) error {
+	sessions, err := loadUploadSessions()
+	if err != nil {
+		return err
+	}
+	if sessionID == "" {
+		delete(sessions, key)
+	} else {
+		sessions[key] = sessionID
+	}
+	return saveUploadSessions(sessions)
+}
@@ -13,18 +13,27 @@ import (
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 	c "github.com/textileio/powergate/v2/cmd/pow/common"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
 )
 
+// minResumableSize is the minimum size of files uploaded using an
+// upload session. Smaller files are uploaded in a single request.
+const minResumableSize = 1 << 20
+
 func init() {
 	Cmd.Flags().String("ipfsrevproxy", "127.0.0.1:6002", "Powergate IPFS reverse proxy multiaddr")
+	Cmd.Flags().Bool("car", false, "Indicates that the file is a CAR file to be imported preserving its DAG and roots")
+	Cmd.Flags().String("upload-session", "", "Resumes the upload of a file using an existing upload session")
 }
 
 // Cmd is the command.
 var Cmd = &cobra.Command{
 	Use:   "stage [path|url]",
 	Short: "Temporarily stage data in Hot Storage in preparation for applying a cid storage config",
-	Long:  `Temporarily stage data in Hot Storage in preparation for applying a cid storage config`,
+	Long:  `Temporarily stage data in Hot Storage in preparation for applying a cid storage config. Large files are uploaded in chunks using an upload session, which is saved locally so an interrupted upload is resumed by staging the same file again.`,
 	Args:  cobra.ExactArgs(1),
 	PreRun: func(cmd *cobra.Command, args []string) {
 		err := viper.BindPFlags(cmd.Flags())
@@ -50,6 +59,9 @@ var Cmd = &cobra.Command{
 			c.Fatal(fmt.Errorf("getting file/folder information: %s", err))
 		}
 		if fi.IsDir() {
+			if viper.GetBool("car") {
+				c.Fatal(errors.New("car path should be a file"))
+			}
 			cid, err := c.PowClient.Data.StageFolder(c.MustAuthCtx(ctx), viper.GetString("ipfsrevproxy"), args[0])
 			c.CheckErr(err)
 			c.Success("Staged folder with cid: %s", cid)
@@ -57,13 +69,69 @@ var Cmd = &cobra.Command{
 			f, err := os.Open(args[0])
 			c.CheckErr(err)
 			defer func() { c.CheckErr(f.Close()) }()
-			stageReader(ctx, f)
+			if viper.GetBool("car") || (viper.GetString("upload-session") == "" && fi.Size() <= minResumableSize) {
+				stageReader(ctx, f)
+			} else {
+				stageResumable(ctx, args[0], fi, f)
+			}
 		}
 	},
 }
 
+// stageResumable uploads a file using an upload session, so the upload can
+// be resumed if it's interrupted. The session is saved locally and reused
+// when staging the same unmodified file again, or can be provided with the
+// --upload-session flag. If the server doesn't support upload sessions, the
+// file is staged in a single request.
+func stageResumable(ctx context.Context, path string, fi os.FileInfo, f *os.File) {
+	key, err := uploadSessionKey(path, fi)
+	c.CheckErr(err)
+
+	sessionID := viper.GetString("upload-session")
+	if sessionID == "" {
+		sessionID, err = getUploadSession(key)
+		c.CheckErr(err)
+		if sessionID != "" {
+			_, err := c.PowClient.Data.UploadSessionStatus(c.MustAuthCtx(ctx), sessionID)
+			if status.Code(err) == codes.NotFound {
+				sessionID = ""
+			} else {
+				c.CheckErr(err)
+				c.Message("Resuming upload session %s", sessionID)
+			}
+		}
+	}
+	if sessionID == "" {
+		us, err := c.PowClient.Data.CreateUploadSession(c.MustAuthCtx(ctx), 0)
+		if status.Code(err) == codes.Unimplemented {
+			stageReader(ctx, f)
+			return
+		}
+		c.CheckErr(err)
+		sessionID = us.SessionId
+	}
+	c.CheckErr(putUploadSession(key, sessionID))
+
+	res, err := c.PowClient.Data.StageResumable(c.MustAuthCtx(ctx), sessionID, f)
+	if err != nil {
+		c.Fatal(fmt.Errorf("uploading with session %s, resume it running 'pow data stage --upload-session %s %s': %s", sessionID, sessionID, path, err))
+	}
+	c.CheckErr(putUploadSession(key, ""))
+
+	json, err := protojson.MarshalOptions{Multiline: true, Indent: "  ", EmitUnpopulated: true}.Marshal(res)
+	c.CheckErr(err)
+
+	fmt.Println(string(json))
+}
+
 func stageReader(ctx context.Context, reader io.Reader) {
-	res, err := c.PowClient.Data.Stage(c.MustAuthCtx(ctx), reader)
+	var res proto.Message
+	var err error
+	if viper.GetBool("car") {
+		res, err = c.PowClient.Data.StageCar(c.MustAuthCtx(ctx), reader)
+	} else {
+		res, err = c.PowClient.Data.Stage(c.MustAuthCtx(ctx), reader)
+	}
 	c.CheckErr(err)
 
 	json, err := protojson.MarshalOptions{Multiline: true, Indent: "  ", EmitUnpopulated: true}.Marshal(res)
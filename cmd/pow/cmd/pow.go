@@ -9,15 +9,18 @@ import (
 	"github.com/spf13/viper"
 	client "github.com/textileio/powergate/v2/api/client"
 	"github.com/textileio/powergate/v2/cmd/pow/cmd/admin"
+	"github.com/textileio/powergate/v2/cmd/pow/cmd/budget"
 	"github.com/textileio/powergate/v2/cmd/pow/cmd/config"
 	"github.com/textileio/powergate/v2/cmd/pow/cmd/data"
 	"github.com/textileio/powergate/v2/cmd/pow/cmd/deals"
 	"github.com/textileio/powergate/v2/cmd/pow/cmd/id"
 	"github.com/textileio/powergate/v2/cmd/pow/cmd/prepare"
+	"github.com/textileio/powergate/v2/cmd/pow/cmd/retrievals"
 	"github.com/textileio/powergate/v2/cmd/pow/cmd/storageinfo"
 	"github.com/textileio/powergate/v2/cmd/pow/cmd/storagejobs"
 	"github.com/textileio/powergate/v2/cmd/pow/cmd/version"
 	"github.com/textileio/powergate/v2/cmd/pow/cmd/wallet"
+	"github.com/textileio/powergate/v2/cmd/pow/cmd/webhooks"
 	c "github.com/textileio/powergate/v2/cmd/pow/common"
 )
 
@@ -27,7 +30,7 @@ func init() {
 	Cmd.PersistentFlags().String("serverAddress", "127.0.0.1:5002", "address of the powergate service api")
 	Cmd.PersistentFlags().StringP("token", "t", "", "user auth token")
 
-	Cmd.AddCommand(admin.Cmd, config.Cmd, data.Cmd, deals.Cmd, id.Cmd, storageinfo.Cmd, storagejobs.Cmd, version.Cmd, wallet.Cmd, docsCmd, prepare.Cmd)
+	Cmd.AddCommand(admin.Cmd, budget.Cmd, config.Cmd, data.Cmd, deals.Cmd, id.Cmd, retrievals.Cmd, storageinfo.Cmd, storagejobs.Cmd, version.Cmd, wallet.Cmd, webhooks.Cmd, docsCmd, prepare.Cmd)
 }
 
 func initConfig() {
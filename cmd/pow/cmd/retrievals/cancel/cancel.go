@@ -0,0 +1,28 @@
+package cancel
+
+import (
+	"context"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	c "github.com/textileio/powergate/v2/cmd/pow/common"
+)
+
+// Cmd is the command.
+var Cmd = &cobra.Command{
+	Use:   "cancel [jobid]",
+	Short: "Cancel a queued or executing retrieval job",
+	Long:  `Cancel a queued or executing retrieval job`,
+	Args:  cobra.ExactArgs(1),
+	PreRun: func(cmd *cobra.Command, args []string) {
+		err := viper.BindPFlags(cmd.Flags())
+		c.CheckErr(err)
+	},
+	Run: func(cmd *cobra.Command, args []string) {
+		ctx, cancel := context.WithTimeout(context.Background(), c.CmdTimeout)
+		defer cancel()
+
+		_, err := c.PowClient.Retrievals.Cancel(c.MustAuthCtx(ctx), args[0])
+		c.CheckErr(err)
+	},
+}
@@ -0,0 +1,21 @@
+package retrievals
+
+import (
+	"github.com/spf13/cobra"
+	"github.com/textileio/powergate/v2/cmd/pow/cmd/retrievals/cancel"
+	"github.com/textileio/powergate/v2/cmd/pow/cmd/retrievals/get"
+	"github.com/textileio/powergate/v2/cmd/pow/cmd/retrievals/remove"
+	"github.com/textileio/powergate/v2/cmd/pow/cmd/retrievals/start"
+	"github.com/textileio/powergate/v2/cmd/pow/cmd/retrievals/watch"
+)
+
+func init() {
+	Cmd.AddCommand(cancel.Cmd, get.Cmd, remove.Cmd, start.Cmd, watch.Cmd)
+}
+
+// Cmd is the command.
+var Cmd = &cobra.Command{
+	Use:   "retrievals",
+	Short: "Provides commands to manage Filecoin retrievals",
+	Long:  `Provides commands to manage Filecoin retrievals`,
+}
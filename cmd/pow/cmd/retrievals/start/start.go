@@ -0,0 +1,62 @@
+package start
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"github.com/textileio/powergate/v2/api/client"
+	c "github.com/textileio/powergate/v2/cmd/pow/common"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+func init() {
+	Cmd.Flags().StringP("selector", "s", "", "selector to retrieve a subset of the data (not supported yet)")
+	Cmd.Flags().StringSliceP("miners", "m", nil, "miners to retrieve the data from")
+	Cmd.Flags().StringP("wallet", "a", "", "wallet address paying for the retrieval (default is the default storage config wallet address)")
+	Cmd.Flags().Uint64P("max-price", "p", 0, "maximum price to pay for the retrieval")
+	Cmd.Flags().BoolP("watch", "w", false, "watch the progress of the resulting retrieval job")
+}
+
+// Cmd is the command.
+var Cmd = &cobra.Command{
+	Use:   "start [payload-cid] [piece-cid]",
+	Short: "Start a retrieval of data from the Filecoin network",
+	Long:  `Start a retrieval of data from the Filecoin network. The retrieved data is pinned in hot storage when the retrieval job succeeds.`,
+	Args:  cobra.ExactArgs(2),
+	PreRun: func(cmd *cobra.Command, args []string) {
+		err := viper.BindPFlags(cmd.Flags())
+		c.CheckErr(err)
+	},
+	Run: func(cmd *cobra.Command, args []string) {
+		ctx, cancel := context.WithTimeout(context.Background(), c.CmdTimeout)
+		defer cancel()
+
+		var opts []client.StartRetrievalOption
+		if viper.IsSet("selector") {
+			opts = append(opts, client.WithRetrievalSelector(viper.GetString("selector")))
+		}
+		if viper.IsSet("miners") {
+			opts = append(opts, client.WithRetrievalMiners(viper.GetStringSlice("miners")))
+		}
+		if viper.IsSet("wallet") {
+			opts = append(opts, client.WithRetrievalWalletAddress(viper.GetString("wallet")))
+		}
+		if viper.IsSet("max-price") {
+			opts = append(opts, client.WithRetrievalMaxPrice(viper.GetUint64("max-price")))
+		}
+
+		res, err := c.PowClient.Retrievals.Start(c.MustAuthCtx(ctx), args[0], args[1], opts...)
+		c.CheckErr(err)
+
+		json, err := protojson.MarshalOptions{Multiline: true, Indent: "  ", EmitUnpopulated: true}.Marshal(res.Retrieval)
+		c.CheckErr(err)
+
+		fmt.Println(string(json))
+
+		if viper.GetBool("watch") {
+			c.WatchRetrievalJobIds(res.Retrieval.JobId)
+		}
+	},
+}
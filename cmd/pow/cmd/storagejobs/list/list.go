@@ -15,7 +15,7 @@ func init() {
 	Cmd.Flags().StringP("cid", "c", "", "return results only for the specified cid")
 	Cmd.Flags().Uint64P("limit", "l", 0, "limit the number of results returned")
 	Cmd.Flags().BoolP("ascending", "a", false, "sort results ascending by time")
-	Cmd.Flags().StringP("select", "s", "all", "return only results using the specified selector: all, queued, executing, final")
+	Cmd.Flags().StringP("select", "s", "all", "return only results using the specified selector: all, queued, executing, final, scheduled")
 }
 
 // Cmd is the command.
@@ -43,6 +43,8 @@ var Cmd = &cobra.Command{
 			sel = client.Executing
 		case "final":
 			sel = client.Final
+		case "scheduled":
+			sel = client.Scheduled
 		default:
 			c.CheckErr(fmt.Errorf("invalid option for --select: %s", selIn))
 		}
@@ -0,0 +1,56 @@
+package register
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	userPb "github.com/textileio/powergate/v2/api/gen/powergate/user/v1"
+	c "github.com/textileio/powergate/v2/cmd/pow/common"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+var events = map[string]userPb.WebhookEvent{
+	"job.status":      userPb.WebhookEvent_WEBHOOK_EVENT_JOB_STATUS,
+	"deal.active":     userPb.WebhookEvent_WEBHOOK_EVENT_DEAL_ACTIVE,
+	"deal.failed":     userPb.WebhookEvent_WEBHOOK_EVENT_DEAL_FAILED,
+	"renewal.created": userPb.WebhookEvent_WEBHOOK_EVENT_RENEWAL_CREATED,
+}
+
+func init() {
+	Cmd.Flags().StringSliceP("events", "e", nil, "events to be notified of: job.status, deal.active, deal.failed, renewal.created (default all)")
+}
+
+// Cmd is the command.
+var Cmd = &cobra.Command{
+	Use:   "register [url]",
+	Short: "Register a webhook to be notified of storage job and deal events",
+	Long:  `Register a webhook to be notified of storage job and deal events. Notifications are POSTed as JSON to the url, and signed with the returned secret as a hex encoded HMAC-SHA256 of the body in the X-Powergate-Signature header.`,
+	Args:  cobra.ExactArgs(1),
+	PreRun: func(cmd *cobra.Command, args []string) {
+		err := viper.BindPFlags(cmd.Flags())
+		c.CheckErr(err)
+	},
+	Run: func(cmd *cobra.Command, args []string) {
+		ctx, cancel := context.WithTimeout(context.Background(), c.CmdTimeout)
+		defer cancel()
+
+		var evs []userPb.WebhookEvent
+		for _, e := range viper.GetStringSlice("events") {
+			ev, ok := events[e]
+			if !ok {
+				c.CheckErr(fmt.Errorf("invalid option for --events: %s", e))
+			}
+			evs = append(evs, ev)
+		}
+
+		res, err := c.PowClient.Webhooks.Register(c.MustAuthCtx(ctx), args[0], evs...)
+		c.CheckErr(err)
+
+		json, err := protojson.MarshalOptions{Multiline: true, Indent: "  ", EmitUnpopulated: true}.Marshal(res.Webhook)
+		c.CheckErr(err)
+
+		fmt.Println(string(json))
+	},
+}
@@ -0,0 +1,19 @@
+package webhooks
+
+import (
+	"github.com/spf13/cobra"
+	"github.com/textileio/powergate/v2/cmd/pow/cmd/webhooks/list"
+	"github.com/textileio/powergate/v2/cmd/pow/cmd/webhooks/register"
+	"github.com/textileio/powergate/v2/cmd/pow/cmd/webhooks/remove"
+)
+
+func init() {
+	Cmd.AddCommand(list.Cmd, register.Cmd, remove.Cmd)
+}
+
+// Cmd is the command.
+var Cmd = &cobra.Command{
+	Use:   "webhooks",
+	Short: "Provides commands to manage webhook notifications of storage job and deal events",
+	Long:  `Provides commands to manage webhook notifications of storage job and deal events`,
+}
@@ -153,7 +153,8 @@ func jobsComplete(state map[string]*client.WatchStorageJobsEvent) bool {
 		processing := false
 		if event == nil ||
 			event.Res.StorageJob.Status == userPb.JobStatus_JOB_STATUS_EXECUTING ||
-			event.Res.StorageJob.Status == userPb.JobStatus_JOB_STATUS_QUEUED {
+			event.Res.StorageJob.Status == userPb.JobStatus_JOB_STATUS_QUEUED ||
+			event.Res.StorageJob.Status == userPb.JobStatus_JOB_STATUS_SCHEDULED {
 			processing = true
 		}
 		if processing && event != nil && event.Err == nil {
@@ -163,6 +164,84 @@ func jobsComplete(state map[string]*client.WatchStorageJobsEvent) bool {
 	return true
 }
 
+// WatchRetrievalJobIds watches retrieval jobs.
+func WatchRetrievalJobIds(jobIds ...string) {
+	state := make(map[string]*client.WatchRetrievalJobsEvent, len(jobIds))
+	for _, jobID := range jobIds {
+		state[jobID] = nil
+	}
+
+	writer := goterminal.New(os.Stdout)
+
+	updateRetrievalJobsOutput(writer, state)
+
+	ch := make(chan client.WatchRetrievalJobsEvent)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	err := PowClient.Retrievals.Watch(MustAuthCtx(ctx), ch, jobIds...)
+	CheckErr(err)
+
+	c := make(chan os.Signal)
+	signal.Notify(c, os.Interrupt, syscall.SIGTERM)
+	go func() {
+		<-c
+		cancel()
+		os.Exit(0)
+	}()
+
+	for {
+		event, ok := <-ch
+		if !ok {
+			break
+		}
+		CheckErr(event.Err)
+		state[event.Res.RetrievalJob.Id] = &event
+		updateRetrievalJobsOutput(writer, state)
+		if retrievalJobsComplete(state) {
+			break
+		}
+	}
+}
+
+func updateRetrievalJobsOutput(writer *goterminal.Writer, state map[string]*client.WatchRetrievalJobsEvent) {
+	keys := make([]string, 0, len(state))
+	for k := range state {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	var data [][]string
+	for _, k := range keys {
+		if state[k] != nil {
+			job := state[k].Res.RetrievalJob
+			val := job.Status.String()
+			if job.Status == userPb.JobStatus_JOB_STATUS_FAILED {
+				val = fmt.Sprintf("%v %v", job.Status.String(), job.ErrorCause)
+			}
+			data = append(data, []string{k, job.RetrievalId, val})
+		} else {
+			data = append(data, []string{k, "", "awaiting state"})
+		}
+	}
+
+	RenderTable(writer, []string{"Job id", "Retrieval id", "Status"}, data)
+
+	writer.Clear()
+	_ = writer.Print()
+}
+
+func retrievalJobsComplete(state map[string]*client.WatchRetrievalJobsEvent) bool {
+	for _, event := range state {
+		if event == nil ||
+			event.Res.RetrievalJob.Status == userPb.JobStatus_JOB_STATUS_EXECUTING ||
+			event.Res.RetrievalJob.Status == userPb.JobStatus_JOB_STATUS_QUEUED {
+			return false
+		}
+	}
+	return true
+}
+
 // MustAuthCtx returns the auth context built from viper token.
 func MustAuthCtx(ctx context.Context) context.Context {
 	token := viper.GetString("token")
@@ -137,8 +137,18 @@ func configFromFlags() (server.Config, error) {
 	ffsMinimumPieceSize := config.GetUint64("ffsminimumpiecesize")
 	ffsRetrievalNextEventTimeout := config.GetDuration("ffsretrievalnexteventtimeout")
 	ffsMaxParallelDealPreparing := config.GetInt("ffsmaxparalleldealpreparing")
+	ffsAggregateSize := config.GetUint64("ffsaggregatesize")
+	ffsAggregateMaxWait := config.GetDuration("ffsaggregatemaxwait")
 	ffsGCInterval := time.Minute * time.Duration(config.GetInt("ffsgcinterval"))
 	ffsGCStagedGracePeriod := time.Minute * time.Duration(config.GetInt("ffsgcstagedgraceperiod"))
+	ffsRenewalEvalFrequency := time.Minute * time.Duration(config.GetInt("ffsrenewalevalfrequency"))
+	ffsRepairEvalFrequency := time.Minute * time.Duration(config.GetInt("ffsrepairevalfrequency"))
+	ffsMinEvalFrequency := time.Minute * time.Duration(config.GetInt("ffsminevalfrequency"))
+	ffsJobRetentionMaxAge := time.Minute * time.Duration(config.GetInt("ffsjobretentionmaxage"))
+	ffsJobRetentionMaxPerCid := config.GetInt("ffsjobretentionmaxpercid")
+	ffsJobPruneInterval := time.Minute * time.Duration(config.GetInt("ffsjobpruneinterval"))
+	ffsUploadSessionTTL := config.GetDuration("ffsuploadsessionttl")
+	ffsWebhooksAllowPrivate := config.GetBool("ffswebhooksallowprivate")
 	dealWatchPollDuration := time.Second * time.Duration(config.GetInt("dealwatchpollduration"))
 	askIndexQueryAskTimeout := time.Second * time.Duration(config.GetInt("askindexqueryasktimeout"))
 	askIndexRefreshInterval := time.Minute * time.Duration(config.GetInt("askindexrefreshinterval"))
@@ -180,8 +190,18 @@ func configFromFlags() (server.Config, error) {
 		FFSMinimumPieceSize:          ffsMinimumPieceSize,
 		FFSRetrievalNextEventTimeout: ffsRetrievalNextEventTimeout,
 		FFSMaxParallelDealPreparing:  ffsMaxParallelDealPreparing,
+		FFSAggregateSize:             ffsAggregateSize,
+		FFSAggregateMaxWait:          ffsAggregateMaxWait,
 		FFSGCAutomaticGCInterval:     ffsGCInterval,
 		FFSGCStageGracePeriod:        ffsGCStagedGracePeriod,
+		FFSRenewalEvalFrequency:      ffsRenewalEvalFrequency,
+		FFSRepairEvalFrequency:       ffsRepairEvalFrequency,
+		FFSMinEvalFrequency:          ffsMinEvalFrequency,
+		FFSJobRetentionMaxAge:        ffsJobRetentionMaxAge,
+		FFSJobRetentionMaxPerCid:     ffsJobRetentionMaxPerCid,
+		FFSJobPruneInterval:          ffsJobPruneInterval,
+		FFSUploadSessionTTL:          ffsUploadSessionTTL,
+		FFSWebhooksAllowPrivate:      ffsWebhooksAllowPrivate,
 		AutocreateMasterAddr:         autocreateMasterAddr,
 		MinerSelector:                minerSelector,
 		MinerSelectorParams:          minerSelectorParams,
@@ -402,8 +422,18 @@ func setupFlags() error {
 	pflag.String("ffsschedmaxparallel", "1000", "Maximum amount of Jobs executed in parallel.")
 	pflag.String("ffsdealfinalitytimeout", "4320", "Deadline in minutes in which a deal must prove liveness changing status before considered abandoned.")
 	pflag.String("ffsmaxparalleldealpreparing", "2", "Max parallel deal preparing tasks.")
+	pflag.String("ffsaggregatesize", "67108864", "Size in bytes of data aggregates. Smaller data is aggregated with other data if enabled in its storage config.")
+	pflag.Duration("ffsaggregatemaxwait", time.Hour*6, "Maximum amount of time to wait for a data aggregate to reach the aggregate size before storing it.")
 	pflag.String("ffsgcinterval", "60", "Interval in minutes of Hot Storage GC for staged data; zero is never.")
 	pflag.String("ffsgcstagedgraceperiod", "60", "Duration in minutes where a staged Cid will be considered GCable if scheduled in a Job.")
+	pflag.String("ffsrenewalevalfrequency", "1440", "Frequency in minutes in which renewable storage configs are evaluated, unless configured in the storage config.")
+	pflag.String("ffsrepairevalfrequency", "1440", "Frequency in minutes in which repairable storage configs are evaluated, unless configured in the storage config.")
+	pflag.String("ffsminevalfrequency", "60", "Minimum frequency in minutes allowed in the eval frequency of storage configs.")
+	pflag.String("ffsjobretentionmaxage", "0", "Maximum age in minutes of final storage jobs before being pruned; zero is no limit.")
+	pflag.String("ffsjobretentionmaxpercid", "0", "Maximum number of final storage jobs kept per user and cid; zero is no limit.")
+	pflag.String("ffsjobpruneinterval", "60", "Interval in minutes of final storage jobs pruning; zero is never.")
+	pflag.Duration("ffsuploadsessionttl", time.Hour*24, "Amount of time without activity after which a resumable upload session is expired.")
+	pflag.Bool("ffswebhooksallowprivate", false, "Allow webhooks targeting loopback, private or link-local addresses.")
 	pflag.String("dealwatchpollduration", "900", "Poll interval in seconds used by Deals Module watch to detect state changes.")
 
 	pflag.String("askindexqueryasktimeout", "15", "Timeout in seconds for a query ask.")
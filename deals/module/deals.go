@@ -31,7 +31,8 @@ var (
 // Store create Deal Proposals with all miners indicated in dcfgs. The epoch price
 // is automatically calculated considering each miner epoch price and piece size.
 // The data of dataCid should be already imported to the Filecoin Client or should be
-// accessible to it. (e.g: is integrated with an IPFS node).
+// accessible to it. (e.g: is integrated with an IPFS node), unless deals are offline
+// deals where the data is transferred manually to miners.
 func (m *Module) Store(ctx context.Context, waddr string, dataCid cid.Cid, dataSize int64, pieceSize abi.PaddedPieceSize, pieceCid cid.Cid, dcfgs []deals.StorageDealConfig, minDuration uint64) ([]deals.StoreResult, error) {
 	if minDuration < util.MinDealDuration {
 		return nil, fmt.Errorf("duration %d should be greater or equal to %d", minDuration, util.MinDealDuration)
@@ -63,9 +64,13 @@ func (m *Module) Store(ctx context.Context, waddr string, dataCid cid.Cid, dataS
 		if dealStartOffset == 0 {
 			dealStartOffset = defaultDealStartOffset
 		}
+		transferType := storagemarket.TTGraphsync
+		if c.Offline {
+			transferType = storagemarket.TTManual
+		}
 		params := &api.StartDealParams{
 			Data: &storagemarket.DataRef{
-				TransferType: storagemarket.TTGraphsync,
+				TransferType: transferType,
 				Root:         dataCid,
 				PieceCid:     &pieceCid,
 				PieceSize:    pieceSize.Unpadded(),
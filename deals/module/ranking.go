@@ -0,0 +1,170 @@
+package module
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/filecoin-project/lotus/api"
+	"github.com/textileio/powergate/v2/deals"
+)
+
+const (
+	// recentFailureWindow is the time window in which a miner with a
+	// failed last retrieval is skipped for new retrievals.
+	recentFailureWindow = 24 * time.Hour
+
+	weightSuccessRate = 0.5
+	weightThroughput  = 0.3
+	weightPrice       = 0.2
+)
+
+// RetrievalMinerRanking returns how miners are ranked for retrievals considering
+// their past retrieval deal records. Since no offers are involved, prices aren't
+// considered. If no miners are provided, all miners with past retrievals are ranked.
+func (m *Module) RetrievalMinerRanking(miners []string) ([]deals.RetrievalMinerRank, error) {
+	rs, err := m.store.GetRetrievals()
+	if err != nil {
+		return nil, fmt.Errorf("getting retrievals: %s", err)
+	}
+	if len(miners) == 0 {
+		seen := make(map[string]struct{})
+		for _, r := range rs {
+			if _, ok := seen[r.DealInfo.Miner]; !ok {
+				seen[r.DealInfo.Miner] = struct{}{}
+				miners = append(miners, r.DealInfo.Miner)
+			}
+		}
+	}
+	prices := make(map[string]uint64, len(miners))
+	for _, mi := range miners {
+		prices[mi] = 0
+	}
+	return rankRetrievalMiners(rs, prices, time.Now()), nil
+}
+
+// rankRetrievalOffers sorts retrieval offers by the rank of their miners. Offers
+// from miners that recently failed are dropped, unless all of them did.
+func (m *Module) rankRetrievalOffers(offers []api.QueryOffer) ([]api.QueryOffer, error) {
+	rs, err := m.store.GetRetrievals()
+	if err != nil {
+		return nil, fmt.Errorf("getting retrievals: %s", err)
+	}
+	offersByMiner := make(map[string]api.QueryOffer, len(offers))
+	prices := make(map[string]uint64, len(offers))
+	for _, o := range offers {
+		mi := o.MinerPeer.Address.String()
+		if curr, ok := offersByMiner[mi]; ok && curr.MinPrice.LessThanEqual(o.MinPrice) {
+			continue
+		}
+		offersByMiner[mi] = o
+		prices[mi] = o.MinPrice.Uint64()
+	}
+
+	ranking := rankRetrievalMiners(rs, prices, time.Now())
+	var ranked, recentlyFailed []api.QueryOffer
+	for _, r := range ranking {
+		log.Infof("retrieval miner %s ranked with score %.3f (successes %d, failures %d, throughput %.0f B/s, price %d, recently failed %v)",
+			r.Miner, r.Score, r.Successes, r.Failures, r.Throughput, r.Price, r.RecentlyFailed)
+		if r.RecentlyFailed {
+			recentlyFailed = append(recentlyFailed, offersByMiner[r.Miner])
+			continue
+		}
+		ranked = append(ranked, offersByMiner[r.Miner])
+	}
+	if len(ranked) == 0 {
+		log.Infof("all miners recently failed retrievals, trying them anyway")
+		return recentlyFailed, nil
+	}
+	return ranked, nil
+}
+
+// rankRetrievalMiners ranks the miners which are keys of prices considering
+// their past retrieval records. The score is a weighted sum of the smoothed
+// success rate, the throughput relative to the best miner, and the price
+// relative to the most expensive miner. The result is sorted by rank.
+func rankRetrievalMiners(rs []deals.RetrievalDealRecord, prices map[string]uint64, now time.Time) []deals.RetrievalMinerRank {
+	type minerStats struct {
+		deals.RetrievalMinerRank
+		lastTime     int64
+		lastFailed   bool
+		bytes        uint64
+		transferSecs int64
+	}
+	stats := make(map[string]*minerStats, len(prices))
+	for mi, price := range prices {
+		s := &minerStats{}
+		s.Miner = mi
+		s.Price = price
+		stats[mi] = s
+	}
+	for _, r := range rs {
+		s, ok := stats[r.DealInfo.Miner]
+		if !ok {
+			continue
+		}
+		failed := r.ErrMsg != ""
+		if failed {
+			s.Failures++
+			if r.Time > s.LastFailure {
+				s.LastFailure = r.Time
+			}
+		} else {
+			s.Successes++
+			secs := r.DataTransferEnd - r.DataTransferStart
+			if secs <= 0 {
+				secs = 1
+			}
+			s.bytes += r.BytesReceived
+			s.transferSecs += secs
+		}
+		if r.Time >= s.lastTime {
+			s.lastTime = r.Time
+			s.lastFailed = failed
+		}
+	}
+
+	var maxThroughput float64
+	var maxPrice uint64
+	for _, s := range stats {
+		s.SuccessRate = float64(s.Successes+1) / float64(s.Successes+s.Failures+2)
+		if s.transferSecs > 0 {
+			s.Throughput = float64(s.bytes) / float64(s.transferSecs)
+		}
+		s.RecentlyFailed = s.lastFailed && now.Sub(time.Unix(s.lastTime, 0)) < recentFailureWindow
+		if s.Throughput > maxThroughput {
+			maxThroughput = s.Throughput
+		}
+		if s.Price > maxPrice {
+			maxPrice = s.Price
+		}
+	}
+
+	res := make([]deals.RetrievalMinerRank, 0, len(stats))
+	for _, s := range stats {
+		score := weightSuccessRate * s.SuccessRate
+		if maxThroughput > 0 {
+			score += weightThroughput * s.Throughput / maxThroughput
+		}
+		if maxPrice > 0 {
+			score += weightPrice * (1 - float64(s.Price)/float64(maxPrice))
+		} else {
+			score += weightPrice
+		}
+		s.Score = score
+		res = append(res, s.RetrievalMinerRank)
+	}
+	sort.Slice(res, func(i, j int) bool {
+		if res[i].RecentlyFailed != res[j].RecentlyFailed {
+			return !res[i].RecentlyFailed
+		}
+		if res[i].Score != res[j].Score {
+			return res[i].Score > res[j].Score
+		}
+		if res[i].Price != res[j].Price {
+			return res[i].Price < res[j].Price
+		}
+		return res[i].Miner < res[j].Miner
+	})
+	return res
+}
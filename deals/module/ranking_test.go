@@ -0,0 +1,64 @@
+package module
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/textileio/powergate/v2/deals"
+)
+
+func TestRankRetrievalMiners(t *testing.T) {
+	t.Parallel()
+	now := time.Now()
+	record := func(miner string, ago time.Duration, bytes uint64, secs int64, errMsg string) deals.RetrievalDealRecord {
+		ts := now.Add(-ago).Unix()
+		return deals.RetrievalDealRecord{
+			DealInfo:          deals.RetrievalDealInfo{Miner: miner},
+			Time:              ts,
+			DataTransferStart: ts - secs,
+			DataTransferEnd:   ts,
+			BytesReceived:     bytes,
+			ErrMsg:            errMsg,
+		}
+	}
+	rs := []deals.RetrievalDealRecord{
+		// f01 is reliable and fast.
+		record("f01", 3*time.Hour, 1000, 1, ""),
+		record("f01", 2*time.Hour, 1000, 1, ""),
+		// f02 is reliable but slow.
+		record("f02", 3*time.Hour, 1000, 10, ""),
+		record("f02", 2*time.Hour, 1000, 10, ""),
+		// f03 was fast, but failed its last retrieval recently.
+		record("f03", 3*time.Hour, 1000, 1, ""),
+		record("f03", time.Hour, 0, 0, "failed"),
+		// f04 failed its last retrieval a long time ago.
+		record("f04", 72*time.Hour, 1000, 1, ""),
+		record("f04", 48*time.Hour, 0, 0, "failed"),
+		// f06 isn't a candidate.
+		record("f06", time.Hour, 1000, 1, ""),
+	}
+	prices := map[string]uint64{"f01": 10, "f02": 10, "f03": 10, "f04": 10, "f05": 10}
+
+	ranking := rankRetrievalMiners(rs, prices, now)
+	require.Len(t, ranking, 5)
+	var order []string
+	for _, r := range ranking {
+		order = append(order, r.Miner)
+	}
+	require.Equal(t, []string{"f01", "f04", "f02", "f05", "f03"}, order)
+
+	require.Equal(t, 2, ranking[0].Successes)
+	require.Equal(t, 0, ranking[0].Failures)
+	require.Equal(t, float64(1000), ranking[0].Throughput)
+	require.True(t, ranking[4].RecentlyFailed)
+	require.False(t, ranking[1].RecentlyFailed)
+	require.NotZero(t, ranking[1].LastFailure)
+
+	// A cheaper offer is preferred between equally ranked miners.
+	prices = map[string]uint64{"f01": 10, "f05": 100, "f07": 1}
+	ranking = rankRetrievalMiners(rs[:2], prices, now)
+	require.Equal(t, "f01", ranking[0].Miner)
+	require.Equal(t, "f07", ranking[1].Miner)
+	require.Equal(t, "f05", ranking[2].Miner)
+}
@@ -8,11 +8,11 @@ import (
 	"io/ioutil"
 	"os"
 	"path/filepath"
-	"sort"
 	"time"
 
 	"github.com/filecoin-project/go-address"
 	"github.com/filecoin-project/go-fil-markets/retrievalmarket"
+	"github.com/filecoin-project/go-state-types/big"
 	"github.com/filecoin-project/lotus/api"
 	marketevents "github.com/filecoin-project/lotus/markets/loggers"
 	"github.com/ipfs/go-cid"
@@ -26,14 +26,15 @@ var (
 
 // Fetch fetches deal data to the underlying blockstore of the Filecoin client.
 // This API is meant for clients that use external implementations of blockstores with
-// their own API, e.g: IPFS.
-func (m *Module) Fetch(ctx context.Context, waddr string, payloadCid cid.Cid, pieceCid *cid.Cid, miners []string) (string, <-chan marketevents.RetrievalEvent, error) {
+// their own API, e.g: IPFS. If maxPrice isn't zero, only offers with a total price
+// of at most maxPrice attoFIL are considered.
+func (m *Module) Fetch(ctx context.Context, waddr string, payloadCid cid.Cid, pieceCid *cid.Cid, miners []string, maxPrice uint64) (string, <-chan marketevents.RetrievalEvent, error) {
 	lapi, cls, err := m.clientBuilder(ctx)
 	if err != nil {
 		return "", nil, fmt.Errorf("creating lotus client: %s", err)
 	}
 
-	miner, events, err := m.retrieve(ctx, lapi, cls, waddr, payloadCid, pieceCid, miners, nil)
+	miner, events, err := m.retrieve(ctx, lapi, cls, waddr, payloadCid, pieceCid, miners, maxPrice, nil)
 	if err != nil {
 		return "", nil, err
 	}
@@ -56,7 +57,7 @@ func (m *Module) Retrieve(ctx context.Context, waddr string, payloadCid cid.Cid,
 	if err != nil {
 		return "", nil, fmt.Errorf("creating lotus client: %s", err)
 	}
-	miner, events, err := m.retrieve(ctx, lapi, cls, waddr, payloadCid, pieceCid, miners, &ref)
+	miner, events, err := m.retrieve(ctx, lapi, cls, waddr, payloadCid, pieceCid, miners, 0, &ref)
 	if err != nil {
 		return "", nil, fmt.Errorf("retrieving from lotus: %s", err)
 	}
@@ -73,30 +74,48 @@ func (m *Module) Retrieve(ctx context.Context, waddr string, payloadCid cid.Cid,
 	return miner, &autodeleteFile{File: f}, nil
 }
 
-func (m *Module) retrieve(ctx context.Context, lapi *api.FullNodeStruct, lapiCls func(), waddr string, payloadCid cid.Cid, pieceCid *cid.Cid, miners []string, ref *api.FileRef) (string, <-chan marketevents.RetrievalEvent, error) {
+func (m *Module) retrieve(ctx context.Context, lapi *api.FullNodeStruct, lapiCls func(), waddr string, payloadCid cid.Cid, pieceCid *cid.Cid, miners []string, maxPrice uint64, ref *api.FileRef) (string, <-chan marketevents.RetrievalEvent, error) {
 	addr, err := address.NewFromString(waddr)
 	if err != nil {
 		return "", nil, fmt.Errorf("parsing wallet address: %s", err)
 	}
 
-	sortedOffers := getRetrievalOffers(ctx, lapi, payloadCid, pieceCid, miners)
-	if len(sortedOffers) == 0 {
+	offers := getRetrievalOffers(ctx, lapi, payloadCid, pieceCid, miners)
+	if len(offers) == 0 {
+		lapiCls()
 		return "", nil, ErrRetrievalNoAvailableProviders
 	}
+	offers = filterOffersByPrice(offers, maxPrice)
+	if len(offers) == 0 {
+		lapiCls()
+		return "", nil, fmt.Errorf("no retrieval offers with a price of at most %d attoFIL", maxPrice)
+	}
+	sortedOffers, err := m.rankRetrievalOffers(offers)
+	if err != nil {
+		lapiCls()
+		return "", nil, fmt.Errorf("ranking retrieval offers: %s", err)
+	}
 
 	var events <-chan marketevents.RetrievalEvent
 
-	// Try to make the retrieval in the specified offers order, until we
-	// find one accepting providing the data.
+	// Try to make the retrieval in the ranked offers order, until we
+	// find one accepting providing the data. Failed attempts are recorded
+	// so they're considered in future rankings.
 	var o api.QueryOffer
 	for _, o = range sortedOffers {
 		events, err = lapi.ClientRetrieveWithEvents(ctx, o.Order(addr), ref)
 		if err != nil {
 			log.Infof("fetching/retrieving cid %s from %s: %s", payloadCid, o.Miner, err)
+			now := time.Now()
+			m.recordRetrieval(waddr, o, 0, now, now, err.Error())
 			continue
 		}
 		break
 	}
+	if err != nil {
+		lapiCls()
+		return "", nil, fmt.Errorf("no miner accepted the retrieval, last error: %s", err)
+	}
 
 	out := make(chan marketevents.RetrievalEvent, 1)
 	go func() {
@@ -167,6 +186,22 @@ func (m *Module) retrieve(ctx context.Context, lapi *api.FullNodeStruct, lapiCls
 	return o.MinerPeer.Address.String(), out, nil
 }
 
+// filterOffersByPrice returns the offers with a total price of at most
+// maxPrice. If maxPrice is zero, all the offers are returned.
+func filterOffersByPrice(offers []api.QueryOffer, maxPrice uint64) []api.QueryOffer {
+	if maxPrice == 0 {
+		return offers
+	}
+	max := big.NewIntUnsigned(maxPrice)
+	var res []api.QueryOffer
+	for _, o := range offers {
+		if o.MinPrice.LessThanEqual(max) {
+			res = append(res, o)
+		}
+	}
+	return res
+}
+
 func getRetrievalOffers(ctx context.Context, lapi *api.FullNodeStruct, payloadCid cid.Cid, pieceCid *cid.Cid, miners []string) []api.QueryOffer {
 	// Ask each miner about costs and information about retrieving this data.
 	var offers []api.QueryOffer
@@ -183,8 +218,5 @@ func getRetrievalOffers(ctx context.Context, lapi *api.FullNodeStruct, payloadCi
 		offers = append(offers, qo)
 	}
 
-	// Sort received options by price.
-	sort.Slice(offers, func(a, b int) bool { return offers[a].MinPrice.LessThan(offers[b].MinPrice) })
-
 	return offers
 }
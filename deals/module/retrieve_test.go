@@ -0,0 +1,23 @@
+package module
+
+import (
+	"testing"
+
+	"github.com/filecoin-project/go-state-types/big"
+	"github.com/filecoin-project/lotus/api"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFilterOffersByPrice(t *testing.T) {
+	t.Parallel()
+	offers := []api.QueryOffer{
+		{Size: 1, MinPrice: big.NewInt(10)},
+		{Size: 2, MinPrice: big.NewInt(20)},
+		{Size: 3, MinPrice: big.NewInt(30)},
+	}
+
+	// A zero max price doesn't filter offers.
+	require.Equal(t, offers, filterOffersByPrice(offers, 0))
+	require.Equal(t, offers[:2], filterOffersByPrice(offers, 20))
+	require.Empty(t, filterOffersByPrice(offers, 5))
+}
@@ -11,6 +11,7 @@ type StorageDealConfig struct {
 	FastRetrieval   bool
 	DealStartOffset int64
 	VerifiedDeal    bool
+	Offline         bool
 }
 
 // StoreResult contains information about Executing deals.
@@ -79,3 +80,19 @@ type RetrievalDealRecord struct {
 	ErrMsg            string
 	UpdatedAt         int64
 }
+
+// RetrievalMinerRank describes how a miner is ranked for retrievals
+// considering its past retrieval deal records and its offered price.
+// Miners with a higher Score are tried first, and miners that
+// RecentlyFailed are only tried if no other miner is available.
+type RetrievalMinerRank struct {
+	Miner          string
+	Successes      int
+	Failures       int
+	SuccessRate    float64
+	Throughput     float64
+	Price          uint64
+	LastFailure    int64
+	RecentlyFailed bool
+	Score          float64
+}
@@ -31,6 +31,9 @@ var (
 	// To retrieve the data, is necessary to call unfreeze by enabling the Enabled flag in
 	// hot storage for that Cid.
 	ErrHotStorageDisabled = errors.New("cid disabled in hot storage")
+	// ErrSelectorNotSupported returned when starting a retrieval with a selector.
+	// Only complete payloads can be retrieved for now.
+	ErrSelectorNotSupported = errors.New("retrieval selectors aren't supported yet")
 )
 
 // API is an Api instance, which owns a Lotus Address and allows to
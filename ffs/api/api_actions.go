@@ -46,7 +46,7 @@ func (i *API) PushStorageConfig(c cid.Cid, opts ...PushStorageConfigOption) (ffs
 	var jid ffs.JobID
 	var err error
 	if !cfg.noExec {
-		jid, err = i.sched.PushConfig(i.cfg.ID, c, cfg.config)
+		jid, err = i.sched.PushConfig(i.cfg.ID, c, cfg.config, cfg.priority, cfg.notBefore)
 		if err != nil {
 			return ffs.EmptyJobID, fmt.Errorf("scheduling cid %s: %s", c, err)
 		}
@@ -57,6 +57,23 @@ func (i *API) PushStorageConfig(c cid.Cid, opts ...PushStorageConfigOption) (ffs
 	return jid, nil
 }
 
+// PlanStorageConfig returns the actions that pushing a configuration for the Cid
+// would take, without pushing it. Only the WithStorageConfig option is considered,
+// falling back to the default storage config.
+func (i *API) PlanStorageConfig(ctx context.Context, c cid.Cid, opts ...PushStorageConfigOption) (ffs.StorageConfigPlan, error) {
+	cfg, err := i.planConfig(opts...)
+	if err != nil {
+		return ffs.StorageConfigPlan{}, err
+	}
+
+	// Planning might take a while, so it's done unguarded.
+	plan, err := i.sched.PlanConfig(ctx, i.cfg.ID, c, cfg.config)
+	if err != nil {
+		return ffs.StorageConfigPlan{}, fmt.Errorf("planning cid %s: %s", c, err)
+	}
+	return plan, nil
+}
+
 // Remove removes a Cid from being tracked as an active storage. The Cid should have
 // both Hot and Cold storage disabled, if that isn't the case it will return ErrActiveInStorage.
 func (i *API) Remove(c cid.Cid) error {
@@ -118,19 +135,39 @@ func (i *API) Replace(c1 cid.Cid, c2 cid.Cid) (ffs.JobID, error) {
 	return jid, nil
 }
 
-// Get returns an io.Reader for reading a stored Cid from hot storage.
-func (i *API) Get(ctx context.Context, c cid.Cid) (io.Reader, error) {
+// Get returns an io.Reader for reading a stored Cid from hot storage. Data
+// with hot storage disabled can be read while it is cached after an unfreeze.
+// Options allow reading a path in the Cid, or only a byte range.
+func (i *API) Get(ctx context.Context, c cid.Cid, opts ...GetOption) (io.Reader, error) {
 	if !c.Defined() {
 		return nil, fmt.Errorf("cid is undefined")
 	}
+	var gc getConfig
+	for _, o := range opts {
+		o(&gc)
+	}
+	if gc.offset < 0 {
+		return nil, fmt.Errorf("offset should be greater or equal to zero")
+	}
+	if gc.length < 0 {
+		return nil, fmt.Errorf("length should be greater or equal to zero")
+	}
 	cfgs, err := i.is.getStorageConfigs(c)
 	if err != nil {
 		return nil, fmt.Errorf("getting cid config: %s", err)
 	}
 	if !cfgs[c].Hot.Enabled {
-		return nil, ErrHotStorageDisabled
+		// Data unfrozen from cold storage might be
+		// still cached in hot storage.
+		exp, err := i.sched.HotCacheExpiration(ctx, i.cfg.ID, c)
+		if err != nil {
+			return nil, fmt.Errorf("getting hot storage cache expiration: %s", err)
+		}
+		if exp.IsZero() {
+			return nil, ErrHotStorageDisabled
+		}
 	}
-	r, err := i.sched.GetCidFromHot(ctx, c)
+	r, err := i.sched.GetCidFromHot(ctx, c, gc.path, gc.offset, gc.length)
 	if err != nil {
 		return nil, fmt.Errorf("getting from hot storage %s: %s", c, err)
 	}
@@ -146,6 +183,25 @@ func (i *API) CancelJob(jid ffs.JobID) error {
 	return nil
 }
 
+func (i *API) planConfig(opts ...PushStorageConfigOption) (pushStorageConfigConfig, error) {
+	i.lock.Lock()
+	defer i.lock.Unlock()
+
+	cfg := pushStorageConfigConfig{config: i.cfg.DefaultStorageConfig}
+	for _, opt := range opts {
+		if err := opt(&cfg); err != nil {
+			return pushStorageConfigConfig{}, fmt.Errorf("config option: %s", err)
+		}
+	}
+	if err := cfg.config.Validate(); err != nil {
+		return pushStorageConfigConfig{}, err
+	}
+	if err := i.ensureValidColdCfg(cfg.config.Cold); err != nil {
+		return pushStorageConfigConfig{}, err
+	}
+	return cfg, nil
+}
+
 func (i *API) ensureValidColdCfg(cfg ffs.ColdConfig) error {
 	if cfg.Enabled && !i.isManagedAddress(cfg.Filecoin.Addr) {
 		return fmt.Errorf("%v is not managed by ffs instance", cfg.Filecoin.Addr)
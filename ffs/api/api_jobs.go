@@ -81,6 +81,8 @@ const (
 	Executing
 	// Final lists final StorageJobs.
 	Final
+	// Scheduled lists scheduled StorageJobs.
+	Scheduled
 )
 
 // ListStorageJobsConfig controls the behavior for listing StorageJobs.
@@ -1,11 +1,13 @@
 package api
 
 import (
+	"context"
 	"fmt"
 	"time"
 
 	"github.com/ipfs/go-cid"
 	"github.com/textileio/powergate/v2/ffs"
+	"github.com/textileio/powergate/v2/ffs/scheduler"
 )
 
 type retrievalConfig struct {
@@ -45,6 +47,9 @@ func (i *API) StartRetrieval(payloadCid, pieceCid cid.Cid, selector string, mine
 	}
 	// ToDo: whenever text-based selectors are ready
 	// we should try checking the prc.Selector is well-formated.
+	if selector != "" {
+		return Retrieval{}, ErrSelectorNotSupported
+	}
 
 	defWalletAddress := i.cfg.DefaultStorageConfig.Cold.Filecoin.Addr
 	rc := retrievalConfig{walletAddress: defWalletAddress}
@@ -55,8 +60,11 @@ func (i *API) StartRetrieval(payloadCid, pieceCid cid.Cid, selector string, mine
 		return Retrieval{}, fmt.Errorf("%s isn't a managed address", rc.walletAddress)
 	}
 
+	// Retrieved data is cached in hot storage as unfrozen data
+	// of the default storage config.
+	cacheTTL := i.cfg.DefaultStorageConfig.Hot.UnfreezeCacheTTL
 	rID := ffs.NewRetrievalID()
-	jid, err := i.sched.StartRetrieval(i.cfg.ID, rID, payloadCid, pieceCid, selector, miners, rc.walletAddress, rc.maxPrice)
+	jid, err := i.sched.StartRetrieval(i.cfg.ID, rID, payloadCid, pieceCid, selector, miners, rc.walletAddress, rc.maxPrice, cacheTTL)
 	if err != nil {
 		return Retrieval{}, fmt.Errorf("starting retrieval in scheduler: %s", err)
 	}
@@ -82,26 +90,106 @@ func (i *API) GetRetrieval(prID ffs.RetrievalID) (Retrieval, error) {
 	}
 	r := retrievalRequestToRetrieval(rr)
 	ri, err := i.sched.GetRetrievalInfo(r.ID)
+	if err == scheduler.ErrNotFound {
+		// The retrieval wasn't executed successfully yet.
+		return r, nil
+	}
 	if err != nil {
 		return Retrieval{}, fmt.Errorf("getting retrieval info: %s", err)
 	}
 	r.RetrievalMiner = ri.MinerAddr
 	r.DataCid = ri.DataCid
+	r.Size = uint64(ri.Size)
 
 	return r, nil
 }
 
+// GetRetrievalJob returns the current state of a RetrievalJob.
+func (i *API) GetRetrievalJob(jid ffs.JobID) (ffs.RetrievalJob, error) {
+	j, err := i.sched.RetrievalJob(jid)
+	if err == scheduler.ErrNotFound || (err == nil && j.APIID != i.cfg.ID) {
+		return ffs.RetrievalJob{}, ErrNotFound
+	}
+	if err != nil {
+		return ffs.RetrievalJob{}, fmt.Errorf("getting current job state: %s", err)
+	}
+	return j, nil
+}
+
+// WatchRetrievalJobs subscribes to RetrievalJob status changes. If jids is empty,
+// it subscribes to all RetrievalJob status changes corresponding to the instance.
+// If jids is not empty, it immediately sends current state of those RetrievalJobs.
+func (i *API) WatchRetrievalJobs(ctx context.Context, c chan<- ffs.RetrievalJob, jids ...ffs.JobID) error {
+	var jobs []ffs.RetrievalJob
+	for _, jid := range jids {
+		j, err := i.GetRetrievalJob(jid)
+		if err != nil {
+			return err
+		}
+		jobs = append(jobs, j)
+	}
+	for _, j := range jobs {
+		c <- j
+	}
+
+	ch := make(chan ffs.RetrievalJob, 10)
+	var err error
+	go func() {
+		err = i.sched.WatchRetrievalJobs(ctx, ch, i.cfg.ID)
+		close(ch)
+	}()
+	for j := range ch {
+		if len(jids) == 0 {
+			c <- j
+			continue
+		}
+		for _, jid := range jids {
+			if jid == j.ID {
+				c <- j
+				break
+			}
+		}
+	}
+	if err != nil {
+		return fmt.Errorf("scheduler listener: %s", err)
+	}
+	return nil
+}
+
+// CancelRetrievalJob cancels a queued or executing RetrievalJob.
+func (i *API) CancelRetrievalJob(jid ffs.JobID) error {
+	if _, err := i.GetRetrievalJob(jid); err != nil {
+		return err
+	}
+	return i.CancelJob(jid)
+}
+
 // RemoveRetrieval removes information and data from a executed retrieval.
-func (i *API) RemoveRetrieval(partialCid cid.Cid) error {
+// Retrievals with a queued or executing RetrievalJob can't be removed.
+func (i *API) RemoveRetrieval(prID ffs.RetrievalID) error {
 	i.lock.Lock()
 	defer i.lock.Unlock()
 
-	// ToDo:
-	// Check retrieval exists
-	// Check retrieval ID was done by this API
-	// Remove from scheduler (reference counting)
-	// Remove from instance-store
-
+	rr, err := i.is.getRetrievalRequest(prID)
+	if err == ErrNotFound {
+		return err
+	}
+	if err != nil {
+		return fmt.Errorf("getting retrieval request from store: %s", err)
+	}
+	j, err := i.sched.RetrievalJob(rr.JID)
+	if err != nil && err != scheduler.ErrNotFound {
+		return fmt.Errorf("getting retrieval job: %s", err)
+	}
+	if err == nil && (j.Status == ffs.Queued || j.Status == ffs.Executing) {
+		return fmt.Errorf("retrieval job %s is %s, it should be canceled first", j.ID, ffs.JobStatusStr[j.Status])
+	}
+	if err := i.sched.RemoveRetrieval(context.Background(), i.cfg.ID, prID); err != nil {
+		return fmt.Errorf("removing retrieval from scheduler: %s", err)
+	}
+	if err := i.is.removeRetrievalRequest(prID); err != nil {
+		return fmt.Errorf("removing retrieval request: %s", err)
+	}
 	return nil
 }
 
@@ -209,6 +209,13 @@ func (s *instanceStore) getRetrievalRequest(rid ffs.RetrievalID) (retrievalReque
 	return rr, nil
 }
 
+func (s *instanceStore) removeRetrievalRequest(rid ffs.RetrievalID) error {
+	if err := s.ds.Delete(makeRetrievalRequestKey(rid)); err != nil {
+		return fmt.Errorf("deleting retrieval request from datastore: %s", err)
+	}
+	return nil
+}
+
 func makeRetrievalRequestKey(rid ffs.RetrievalID) datastore.Key {
 	return dsBaseRetrievalRequest.ChildString(rid.String())
 }
@@ -2,6 +2,7 @@ package api
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/textileio/powergate/v2/ffs"
 )
@@ -14,6 +15,8 @@ type pushStorageConfigConfig struct {
 	overrideConfig bool
 	dealIDs        []uint64
 	noExec         bool
+	priority       ffs.JobPriority
+	notBefore      time.Time
 }
 
 // WithStorageConfig overrides the Api default Cid configuration.
@@ -50,6 +53,31 @@ func WithNoExec(noExec bool) PushStorageConfigOption {
 	}
 }
 
+// WithPriority sets the priority of the created Job in the
+// Scheduler queue. By default, ffs.PriorityNormal is used. Priorities
+// above ffs.PriorityHigh are reserved for the Scheduler.
+func WithPriority(priority ffs.JobPriority) PushStorageConfigOption {
+	return func(o *pushStorageConfigConfig) error {
+		if _, ok := ffs.JobPriorityStr[priority]; !ok {
+			return fmt.Errorf("unknown job priority %d", priority)
+		}
+		if priority > ffs.PriorityHigh {
+			return fmt.Errorf("job priority %s is reserved for the scheduler", ffs.JobPriorityStr[priority])
+		}
+		o.priority = priority
+		return nil
+	}
+}
+
+// WithNotBefore defers the execution of the created Job until
+// the provided time. Meanwhile, the Job has Scheduled status.
+func WithNotBefore(notBefore time.Time) PushStorageConfigOption {
+	return func(o *pushStorageConfigConfig) error {
+		o.notBefore = notBefore
+		return nil
+	}
+}
+
 // Validate validates a PushStorageConfigConfig.
 func (pc pushStorageConfigConfig) Validate() error {
 	if err := pc.config.Validate(); err != nil {
@@ -112,3 +140,35 @@ func WithRetrievalMaxPrice(maxPrice uint64) RetrievalOption {
 		prc.maxPrice = maxPrice
 	}
 }
+
+// GetOption mutates a get configuration.
+type GetOption func(*getConfig)
+
+type getConfig struct {
+	path   string
+	offset int64
+	length int64
+}
+
+// WithGetPath indicates a UnixFS path to be resolved from the Cid,
+// so only the data of that path is read.
+func WithGetPath(path string) GetOption {
+	return func(gc *getConfig) {
+		gc.path = path
+	}
+}
+
+// WithGetOffset indicates the byte offset from which data is read.
+func WithGetOffset(offset int64) GetOption {
+	return func(gc *getConfig) {
+		gc.offset = offset
+	}
+}
+
+// WithGetLength indicates the maximum number of bytes to read.
+// If zero, data is read until the end.
+func WithGetLength(length int64) GetOption {
+	return func(gc *getConfig) {
+		gc.length = length
+	}
+}
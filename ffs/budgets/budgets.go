@@ -0,0 +1,497 @@
+package budgets
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"sync"
+	"time"
+
+	"github.com/ipfs/go-cid"
+	"github.com/ipfs/go-datastore"
+	logging "github.com/ipfs/go-log/v2"
+	"github.com/textileio/powergate/v2/deals"
+	"github.com/textileio/powergate/v2/ffs"
+	"github.com/textileio/powergate/v2/util"
+)
+
+/**
+/budget/<api-id>/<source>: Stores Budget data by api-id and source
+*/
+
+var (
+	log = logging.Logger("ffs-budgets")
+
+	dsBaseBudget = datastore.NewKey("budget")
+
+	// SpentCacheTTL is the duration that the spending of an APIID is
+	// cached before listing its deal records again.
+	SpentCacheTTL = time.Minute
+
+	// maxReserveAttempts is the maximum number of times that the spending
+	// of an APIID is calculated for a reservation, if reservations are
+	// committed concurrently.
+	maxReserveAttempts = 5
+)
+
+// Source indicates who set a Budget.
+type Source string
+
+const (
+	// Admin is a Budget set by a Powergate admin.
+	Admin Source = "admin"
+	// User is a Budget set by the user itself.
+	User Source = "user"
+)
+
+// Budget limits the FIL spent by an APIID in deals. Limits are in
+// attoFIL, and a nil or zero limit means no limit.
+type Budget struct {
+	// MonthlyLimit is the maximum amount spent in a calendar month (UTC).
+	// The cost of storage deals for their whole duration is charged in
+	// the month they're proposed, not spread over the months they last.
+	MonthlyLimit *big.Int
+	// CidLimit is the maximum amount spent for a Cid during its lifetime.
+	CidLimit *big.Int
+}
+
+// Status describes the spending of an APIID considering its Budgets.
+type Status struct {
+	// Admin is the Budget set by an admin.
+	Admin Budget
+	// User is the Budget set by the user.
+	User Budget
+	// MonthlySpent is the amount spent in the current calendar month.
+	MonthlySpent *big.Int
+	// MonthlyRemaining is the amount that can still be spent in the current
+	// calendar month. It's nil if there's no monthly limit.
+	MonthlyRemaining *big.Int
+	// CidSpent is the amount spent for the Cid. It's nil if no Cid was
+	// provided.
+	CidSpent *big.Int
+	// CidRemaining is the amount that can still be spent for the Cid.
+	// It's nil if no Cid was provided or there's no per-Cid limit.
+	CidRemaining *big.Int
+}
+
+// AddrsResolver resolves the wallet addresses of an APIID.
+type AddrsResolver interface {
+	// WalletAddrs returns the wallet addresses of an APIID.
+	WalletAddrs(ffs.APIID) ([]string, error)
+}
+
+// Module keeps track of Budgets of APIIDs, and calculates their spending
+// from storage and retrieval deal records.
+type Module struct {
+	ds  datastore.Datastore
+	drm ffs.DealRecordsManager
+
+	lock         sync.Mutex
+	ar           AddrsResolver
+	reservations map[*reservation]struct{}
+	spentCache   map[spentKey]cachedSpent
+	// commits counts the committed reservations of each APIID, so spending
+	// calculated from deal records listed before a commit isn't cached.
+	commits map[ffs.APIID]uint64
+}
+
+type spentKey struct {
+	iid   ffs.APIID
+	c     cid.Cid
+	since int64
+}
+
+type cachedSpent struct {
+	amount *big.Int
+	at     time.Time
+}
+
+var _ ffs.BudgetTracker = (*Module)(nil)
+
+// New returns a new Module. An AddrsResolver should be set with
+// SetAddrsResolver before calculating spending.
+func New(ds datastore.Datastore, drm ffs.DealRecordsManager) *Module {
+	return &Module{
+		ds:           ds,
+		drm:          drm,
+		reservations: make(map[*reservation]struct{}),
+		spentCache:   make(map[spentKey]cachedSpent),
+		commits:      make(map[ffs.APIID]uint64),
+	}
+}
+
+// SetAddrsResolver sets the AddrsResolver used to know which deal records
+// belong to an APIID.
+func (m *Module) SetAddrsResolver(ar AddrsResolver) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	m.ar = ar
+}
+
+// Set sets the Budget of iid for a Source.
+func (m *Module) Set(iid ffs.APIID, src Source, b Budget) error {
+	if src != Admin && src != User {
+		return fmt.Errorf("unknown budget source %s", src)
+	}
+	if (b.MonthlyLimit != nil && b.MonthlyLimit.Sign() < 0) || (b.CidLimit != nil && b.CidLimit.Sign() < 0) {
+		return fmt.Errorf("budget limits can't be negative")
+	}
+	buf, err := json.Marshal(b)
+	if err != nil {
+		return fmt.Errorf("marshaling budget: %s", err)
+	}
+	if err := m.ds.Put(makeBudgetKey(iid, src), buf); err != nil {
+		return fmt.Errorf("saving budget in datastore: %s", err)
+	}
+	return nil
+}
+
+// Get returns the Budget of iid for a Source. If no Budget was set, it
+// returns a Budget without limits.
+func (m *Module) Get(iid ffs.APIID, src Source) (Budget, error) {
+	buf, err := m.ds.Get(makeBudgetKey(iid, src))
+	if err == datastore.ErrNotFound {
+		return Budget{}, nil
+	}
+	if err != nil {
+		return Budget{}, fmt.Errorf("getting budget from datastore: %s", err)
+	}
+	var b Budget
+	if err := json.Unmarshal(buf, &b); err != nil {
+		return Budget{}, fmt.Errorf("unmarshaling budget: %s", err)
+	}
+	return b, nil
+}
+
+// Status returns the spending of iid considering its Budgets. If c is
+// defined, the spending for the Cid is also included.
+func (m *Module) Status(iid ffs.APIID, c cid.Cid) (Status, error) {
+	admin, err := m.Get(iid, Admin)
+	if err != nil {
+		return Status{}, fmt.Errorf("getting admin budget: %s", err)
+	}
+	user, err := m.Get(iid, User)
+	if err != nil {
+		return Status{}, fmt.Errorf("getting user budget: %s", err)
+	}
+	monthlyReserved, cidReserved := m.reserved(iid, c)
+	monthlySpent, err := m.spent(iid, cid.Undef, startOfMonth(time.Now()))
+	if err != nil {
+		return Status{}, fmt.Errorf("calculating monthly spending: %s", err)
+	}
+	res := Status{
+		Admin:            admin,
+		User:             user,
+		MonthlySpent:     monthlySpent,
+		MonthlyRemaining: remaining(minLimit(admin.MonthlyLimit, user.MonthlyLimit), new(big.Int).Add(monthlySpent, monthlyReserved)),
+	}
+	if c.Defined() {
+		res.CidSpent, err = m.spent(iid, c, time.Time{})
+		if err != nil {
+			return Status{}, fmt.Errorf("calculating cid spending: %s", err)
+		}
+		res.CidRemaining = remaining(minLimit(admin.CidLimit, user.CidLimit), new(big.Int).Add(res.CidSpent, cidReserved))
+	}
+	return res, nil
+}
+
+// Remaining returns the amount that iid can still spend in deals for c
+// considering its Budgets and reservations. A nil result means no limit.
+func (m *Module) Remaining(iid ffs.APIID, c cid.Cid) (*big.Int, error) {
+	monthlyLimit, cidLimit, err := m.limits(iid)
+	if err != nil {
+		return nil, err
+	}
+	// Reservations are read before calculating the spending, so deals of
+	// reservations committed meanwhile are counted twice at worst.
+	monthlyReserved, cidReserved := m.reserved(iid, c)
+	monthlySpent, cidSpent, err := m.limitedSpent(iid, c, monthlyLimit, cidLimit)
+	if err != nil {
+		return nil, err
+	}
+	return remainingBudget(monthlyLimit, cidLimit, monthlySpent, cidSpent, monthlyReserved, cidReserved), nil
+}
+
+// Reserve reserves an amount of the remaining budget of iid for c. The
+// reserved amount is considered spent until the reservation is committed
+// or released. It returns an error if the remaining budget isn't enough.
+func (m *Module) Reserve(iid ffs.APIID, c cid.Cid, amount *big.Int) (ffs.BudgetReservation, error) {
+	if amount.Sign() < 0 {
+		return nil, fmt.Errorf("reserved amount can't be negative")
+	}
+	for i := 0; i < maxReserveAttempts; i++ {
+		r, err := m.tryReserve(iid, c, amount)
+		if err != nil {
+			return nil, err
+		}
+		if r != nil {
+			return r, nil
+		}
+	}
+	return nil, fmt.Errorf("reservations of %s were committed concurrently %d times", iid, maxReserveAttempts)
+}
+
+// tryReserve calculates the spending of iid without blocking other
+// reservations, and reserves the amount if the remaining budget is
+// enough. Checking the remaining budget and reserving from it is atomic.
+// If a reservation of iid was committed while calculating the spending,
+// its deals might not be considered, so it returns a nil reservation to
+// try again.
+func (m *Module) tryReserve(iid ffs.APIID, c cid.Cid, amount *big.Int) (*reservation, error) {
+	monthlyLimit, cidLimit, err := m.limits(iid)
+	if err != nil {
+		return nil, err
+	}
+	m.lock.Lock()
+	commits := m.commits[iid]
+	m.lock.Unlock()
+	monthlySpent, cidSpent, err := m.limitedSpent(iid, c, monthlyLimit, cidLimit)
+	if err != nil {
+		return nil, err
+	}
+
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	if m.commits[iid] != commits {
+		return nil, nil
+	}
+	monthlyReserved, cidReserved := m.reservedLocked(iid, c)
+	rem := remainingBudget(monthlyLimit, cidLimit, monthlySpent, cidSpent, monthlyReserved, cidReserved)
+	if rem != nil && amount.Cmp(rem) > 0 {
+		return nil, fmt.Errorf("%s attoFIL exceed the remaining budget of %s attoFIL", amount, rem)
+	}
+	r := &reservation{m: m, iid: iid, c: c, amount: new(big.Int).Set(amount)}
+	m.reservations[r] = struct{}{}
+	return r, nil
+}
+
+// limits returns the most restrictive monthly and per-Cid limits of the
+// Budgets of iid, where nil means no limit.
+func (m *Module) limits(iid ffs.APIID) (*big.Int, *big.Int, error) {
+	admin, err := m.Get(iid, Admin)
+	if err != nil {
+		return nil, nil, fmt.Errorf("getting admin budget: %s", err)
+	}
+	user, err := m.Get(iid, User)
+	if err != nil {
+		return nil, nil, fmt.Errorf("getting user budget: %s", err)
+	}
+	return minLimit(admin.MonthlyLimit, user.MonthlyLimit), minLimit(admin.CidLimit, user.CidLimit), nil
+}
+
+// limitedSpent returns the amounts spent by iid in the current month, and
+// by iid for c. Each amount is only calculated if there's a limit for it.
+func (m *Module) limitedSpent(iid ffs.APIID, c cid.Cid, monthlyLimit, cidLimit *big.Int) (*big.Int, *big.Int, error) {
+	var monthly, forCid *big.Int
+	var err error
+	if monthlyLimit != nil {
+		monthly, err = m.spent(iid, cid.Undef, startOfMonth(time.Now()))
+		if err != nil {
+			return nil, nil, fmt.Errorf("calculating monthly spending: %s", err)
+		}
+	}
+	if cidLimit != nil && c.Defined() {
+		forCid, err = m.spent(iid, c, time.Time{})
+		if err != nil {
+			return nil, nil, fmt.Errorf("calculating cid spending: %s", err)
+		}
+	}
+	return monthly, forCid, nil
+}
+
+// reserved returns the amounts reserved by iid, and by iid for c.
+func (m *Module) reserved(iid ffs.APIID, c cid.Cid) (*big.Int, *big.Int) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	return m.reservedLocked(iid, c)
+}
+
+func (m *Module) reservedLocked(iid ffs.APIID, c cid.Cid) (*big.Int, *big.Int) {
+	monthly, forCid := big.NewInt(0), big.NewInt(0)
+	for r := range m.reservations {
+		if r.iid != iid {
+			continue
+		}
+		monthly.Add(monthly, r.amount)
+		if c.Defined() && r.c.Equals(c) {
+			forCid.Add(forCid, r.amount)
+		}
+	}
+	return monthly, forCid
+}
+
+// reservation is an amount reserved from the budget of an APIID.
+type reservation struct {
+	m      *Module
+	iid    ffs.APIID
+	c      cid.Cid
+	amount *big.Int
+}
+
+var _ ffs.BudgetReservation = (*reservation)(nil)
+
+// Commit removes the reservation, and discards the cached spending of
+// its APIID, so the deal records of proposed deals are considered.
+func (r *reservation) Commit() {
+	r.m.lock.Lock()
+	defer r.m.lock.Unlock()
+	if _, ok := r.m.reservations[r]; !ok {
+		return
+	}
+	delete(r.m.reservations, r)
+	r.m.commits[r.iid]++
+	for k := range r.m.spentCache {
+		if k.iid == r.iid {
+			delete(r.m.spentCache, k)
+		}
+	}
+}
+
+// Release removes the reservation.
+func (r *reservation) Release() {
+	r.m.lock.Lock()
+	defer r.m.lock.Unlock()
+	delete(r.m.reservations, r)
+}
+
+// spent returns the amount spent by iid in storage and retrieval deals
+// made since a time. If c is defined, only deals for the Cid are considered.
+// Failed deals aren't considered, since they don't spend funds. Results are
+// cached for SpentCacheTTL, since listing deal records is expensive.
+func (m *Module) spent(iid ffs.APIID, c cid.Cid, since time.Time) (*big.Int, error) {
+	key := spentKey{iid: iid, c: c, since: since.Unix()}
+	m.lock.Lock()
+	ar := m.ar
+	cached, ok := m.spentCache[key]
+	commits := m.commits[iid]
+	m.lock.Unlock()
+	if ok && time.Since(cached.at) < SpentCacheTTL {
+		return new(big.Int).Set(cached.amount), nil
+	}
+	res, err := m.listSpent(ar, iid, c, since)
+	if err != nil {
+		return nil, err
+	}
+
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	if m.commits[iid] == commits {
+		now := time.Now()
+		for k, v := range m.spentCache {
+			if now.Sub(v.at) >= SpentCacheTTL {
+				delete(m.spentCache, k)
+			}
+		}
+		m.spentCache[key] = cachedSpent{amount: new(big.Int).Set(res), at: now}
+	}
+	return res, nil
+}
+
+// listSpent calculates the amount spent by iid since a time from its
+// deal records.
+func (m *Module) listSpent(ar AddrsResolver, iid ffs.APIID, c cid.Cid, since time.Time) (*big.Int, error) {
+	if ar == nil {
+		return nil, fmt.Errorf("addresses resolver isn't set")
+	}
+	addrs, err := ar.WalletAddrs(iid)
+	if err != nil {
+		return nil, fmt.Errorf("getting wallet addresses: %s", err)
+	}
+	res := big.NewInt(0)
+	// No addresses means no filtering in deal records, so
+	// avoid listing deal records of other APIIDs.
+	if len(addrs) == 0 {
+		return res, nil
+	}
+
+	opts := []deals.DealRecordsOption{deals.WithFromAddrs(addrs...)}
+	if c.Defined() {
+		opts = append(opts, deals.WithDataCids(util.CidToString(c)))
+	}
+	srs, err := m.drm.ListStorageDealRecords(append(opts, deals.WithIncludePending(true), deals.WithIncludeFinal(true))...)
+	if err != nil {
+		return nil, fmt.Errorf("listing storage deal records: %s", err)
+	}
+	for _, r := range srs {
+		if r.ErrMsg != "" || r.Time < since.Unix() {
+			continue
+		}
+		cost := new(big.Int).SetUint64(r.DealInfo.PricePerEpoch)
+		res.Add(res, cost.Mul(cost, new(big.Int).SetUint64(r.DealInfo.Duration)))
+	}
+	rrs, err := m.drm.ListRetrievalDealRecords(opts...)
+	if err != nil {
+		return nil, fmt.Errorf("listing retrieval deal records: %s", err)
+	}
+	for _, r := range rrs {
+		if r.ErrMsg != "" || r.Time < since.Unix() {
+			continue
+		}
+		res.Add(res, new(big.Int).SetUint64(r.DealInfo.MinPrice))
+	}
+	log.Debugf("%s spent %s attoFIL since %s", iid, res, since)
+	return res, nil
+}
+
+// minLimit returns the most restrictive limit, where nil or zero
+// means no limit.
+func minLimit(a, b *big.Int) *big.Int {
+	if a == nil || a.Sign() == 0 {
+		a = nil
+	}
+	if b == nil || b.Sign() == 0 {
+		return a
+	}
+	if a == nil || b.Cmp(a) < 0 {
+		return b
+	}
+	return a
+}
+
+// remainingBudget returns the amount that can still be spent considering
+// the monthly and per-Cid limits, and what was spent and reserved for them.
+// A nil result means no limit.
+func remainingBudget(monthlyLimit, cidLimit, monthlySpent, cidSpent, monthlyReserved, cidReserved *big.Int) *big.Int {
+	var res *big.Int
+	if monthlyLimit != nil {
+		res = remaining(monthlyLimit, new(big.Int).Add(monthlySpent, monthlyReserved))
+	}
+	if cidLimit != nil && cidSpent != nil {
+		res = minRemaining(res, remaining(cidLimit, new(big.Int).Add(cidSpent, cidReserved)))
+	}
+	return res
+}
+
+// minRemaining returns the smallest remaining amount, where nil means
+// no limit.
+func minRemaining(a, b *big.Int) *big.Int {
+	if a == nil {
+		return b
+	}
+	if b == nil || a.Cmp(b) < 0 {
+		return a
+	}
+	return b
+}
+
+// remaining returns what's left of a limit after spending, never being
+// negative. A nil limit means no limit, so it returns nil.
+func remaining(limit, spent *big.Int) *big.Int {
+	if limit == nil {
+		return nil
+	}
+	res := new(big.Int).Sub(limit, spent)
+	if res.Sign() < 0 {
+		res.SetInt64(0)
+	}
+	return res
+}
+
+func startOfMonth(t time.Time) time.Time {
+	t = t.UTC()
+	return time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, time.UTC)
+}
+
+func makeBudgetKey(iid ffs.APIID, src Source) datastore.Key {
+	return dsBaseBudget.ChildString(iid.String()).ChildString(string(src))
+}
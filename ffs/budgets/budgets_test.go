@@ -0,0 +1,280 @@
+package budgets
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ipfs/go-cid"
+	"github.com/stretchr/testify/require"
+	"github.com/textileio/powergate/v2/deals"
+	"github.com/textileio/powergate/v2/ffs"
+	"github.com/textileio/powergate/v2/tests"
+	"github.com/textileio/powergate/v2/util"
+)
+
+var (
+	c1, _ = util.CidFromString("QmbPwnn3FqvGyuH1esVpzBT8HhWdx8eCFT2jFKQAsc2Wdb")
+	c2, _ = util.CidFromString("QmSBhymbFdKvzohGCd2shd6GLb4k5q8NDwR1rghLSVSyGb")
+)
+
+func TestSetGet(t *testing.T) {
+	t.Parallel()
+	m, _ := newModule(t)
+	iid := ffs.NewAPIID()
+
+	b, err := m.Get(iid, Admin)
+	require.NoError(t, err)
+	require.Equal(t, Budget{}, b)
+
+	b = Budget{MonthlyLimit: big.NewInt(100), CidLimit: big.NewInt(10)}
+	require.NoError(t, m.Set(iid, Admin, b))
+	got, err := m.Get(iid, Admin)
+	require.NoError(t, err)
+	require.Equal(t, 0, b.MonthlyLimit.Cmp(got.MonthlyLimit))
+	require.Equal(t, 0, b.CidLimit.Cmp(got.CidLimit))
+
+	got, err = m.Get(iid, User)
+	require.NoError(t, err)
+	require.Equal(t, Budget{}, got)
+
+	require.Error(t, m.Set(iid, User, Budget{MonthlyLimit: big.NewInt(-1)}))
+	require.Error(t, m.Set(iid, Source("other"), Budget{}))
+}
+
+func TestRemaining(t *testing.T) {
+	t.Parallel()
+	m, drm := newModule(t)
+	iid := ffs.NewAPIID()
+
+	now := time.Now().Unix()
+	lastMonth := startOfMonth(time.Now()).Add(-time.Hour).Unix()
+	drm.storage = []deals.StorageDealRecord{
+		{RootCid: c1, Addr: "addr1", Time: now, DealInfo: deals.StorageDealInfo{PricePerEpoch: 2, Duration: 10}},
+		{RootCid: c1, Addr: "addr1", Time: now, Pending: true, DealInfo: deals.StorageDealInfo{PricePerEpoch: 1, Duration: 10}},
+		{RootCid: c1, Addr: "addr1", Time: now, ErrMsg: "failed", DealInfo: deals.StorageDealInfo{PricePerEpoch: 100, Duration: 10}},
+		{RootCid: c2, Addr: "addr1", Time: lastMonth, DealInfo: deals.StorageDealInfo{PricePerEpoch: 5, Duration: 10}},
+		{RootCid: c2, Addr: "other", Time: now, DealInfo: deals.StorageDealInfo{PricePerEpoch: 100, Duration: 10}},
+	}
+	drm.retrieval = []deals.RetrievalDealRecord{
+		{Addr: "addr1", Time: now, DealInfo: deals.RetrievalDealInfo{RootCid: c2, MinPrice: 5}},
+	}
+
+	// No budgets means no limit.
+	rem, err := m.Remaining(iid, c1)
+	require.NoError(t, err)
+	require.Nil(t, rem)
+
+	// Spent this month: 20 + 10 + 5 = 35.
+	require.NoError(t, m.Set(iid, Admin, Budget{MonthlyLimit: big.NewInt(100)}))
+	rem, err = m.Remaining(iid, c1)
+	require.NoError(t, err)
+	require.Equal(t, int64(65), rem.Int64())
+
+	// The user budget is more restrictive.
+	require.NoError(t, m.Set(iid, User, Budget{MonthlyLimit: big.NewInt(50)}))
+	rem, err = m.Remaining(iid, c1)
+	require.NoError(t, err)
+	require.Equal(t, int64(15), rem.Int64())
+
+	// Spent for c2 in its lifetime: 50 + 5 = 55.
+	require.NoError(t, m.Set(iid, Admin, Budget{CidLimit: big.NewInt(60)}))
+	rem, err = m.Remaining(iid, c2)
+	require.NoError(t, err)
+	require.Equal(t, int64(5), rem.Int64())
+
+	// Exhausted budgets never go negative.
+	require.NoError(t, m.Set(iid, User, Budget{MonthlyLimit: big.NewInt(10)}))
+	rem, err = m.Remaining(iid, c2)
+	require.NoError(t, err)
+	require.Equal(t, int64(0), rem.Int64())
+
+	st, err := m.Status(iid, c2)
+	require.NoError(t, err)
+	require.Equal(t, int64(35), st.MonthlySpent.Int64())
+	require.Equal(t, int64(0), st.MonthlyRemaining.Int64())
+	require.Equal(t, int64(55), st.CidSpent.Int64())
+	require.Equal(t, int64(5), st.CidRemaining.Int64())
+}
+
+func TestReserve(t *testing.T) {
+	t.Parallel()
+	m, drm := newModule(t)
+	iid := ffs.NewAPIID()
+	now := time.Now().Unix()
+	drm.storage = []deals.StorageDealRecord{
+		{RootCid: c1, Addr: "addr1", Time: now, DealInfo: deals.StorageDealInfo{PricePerEpoch: 2, Duration: 10}},
+	}
+	require.NoError(t, m.Set(iid, Admin, Budget{MonthlyLimit: big.NewInt(100), CidLimit: big.NewInt(50)}))
+
+	// Reservations are considered spent.
+	r1, err := m.Reserve(iid, c1, big.NewInt(20))
+	require.NoError(t, err)
+	rem, err := m.Remaining(iid, c1)
+	require.NoError(t, err)
+	require.Equal(t, int64(10), rem.Int64())
+	rem, err = m.Remaining(iid, c2)
+	require.NoError(t, err)
+	require.Equal(t, int64(50), rem.Int64())
+	_, err = m.Reserve(iid, c1, big.NewInt(11))
+	require.Error(t, err)
+	r2, err := m.Reserve(iid, c2, big.NewInt(50))
+	require.NoError(t, err)
+	_, err = m.Reserve(iid, c2, big.NewInt(1))
+	require.Error(t, err)
+
+	// Released reservations don't count anymore.
+	r2.Release()
+	rem, err = m.Remaining(iid, c2)
+	require.NoError(t, err)
+	require.Equal(t, int64(50), rem.Int64())
+
+	// Committed reservations are replaced by the deal records of proposed
+	// deals, even if the spending was cached.
+	drm.storage = append(drm.storage, deals.StorageDealRecord{RootCid: c1, Addr: "addr1", Time: now, Pending: true, DealInfo: deals.StorageDealInfo{PricePerEpoch: 1, Duration: 20}})
+	r1.Commit()
+	r1.Release()
+	rem, err = m.Remaining(iid, c1)
+	require.NoError(t, err)
+	require.Equal(t, int64(10), rem.Int64())
+	st, err := m.Status(iid, c1)
+	require.NoError(t, err)
+	require.Equal(t, int64(40), st.MonthlySpent.Int64())
+	require.Equal(t, int64(60), st.MonthlyRemaining.Int64())
+}
+
+func TestReserveConcurrentCommit(t *testing.T) {
+	t.Parallel()
+	m, drm := newModule(t)
+	iid := ffs.NewAPIID()
+	require.NoError(t, m.Set(iid, Admin, Budget{MonthlyLimit: big.NewInt(100)}))
+	r1, err := m.Reserve(iid, c1, big.NewInt(60))
+	require.NoError(t, err)
+	// Committing an empty reservation discards the cached spending.
+	r0, err := m.Reserve(iid, c1, big.NewInt(0))
+	require.NoError(t, err)
+	r0.Commit()
+
+	// A reservation committed while listing deal records isn't included
+	// in the listed spending, so the spending is calculated again.
+	drm.afterList = func() {
+		drm.afterList = nil
+		drm.storage = append(drm.storage, deals.StorageDealRecord{RootCid: c1, Addr: "addr1", Time: time.Now().Unix(), Pending: true, DealInfo: deals.StorageDealInfo{PricePerEpoch: 6, Duration: 10}})
+		r1.Commit()
+	}
+	_, err = m.Reserve(iid, c2, big.NewInt(50))
+	require.Error(t, err)
+	r2, err := m.Reserve(iid, c2, big.NewInt(40))
+	require.NoError(t, err)
+	r2.Release()
+}
+
+func TestSpentCache(t *testing.T) {
+	t.Parallel()
+	m, drm := newModule(t)
+	iid := ffs.NewAPIID()
+	require.NoError(t, m.Set(iid, Admin, Budget{MonthlyLimit: big.NewInt(100)}))
+
+	for i := 0; i < 3; i++ {
+		rem, err := m.Remaining(iid, cid.Undef)
+		require.NoError(t, err)
+		require.Equal(t, int64(100), rem.Int64())
+	}
+	require.Equal(t, 1, drm.storageLists)
+
+	// Committing a reservation discards the cached spending.
+	r, err := m.Reserve(iid, c1, big.NewInt(10))
+	require.NoError(t, err)
+	r.Commit()
+	_, err = m.Remaining(iid, cid.Undef)
+	require.NoError(t, err)
+	require.Equal(t, 2, drm.storageLists)
+}
+
+func TestNoAddrs(t *testing.T) {
+	t.Parallel()
+	m, drm := newModule(t)
+	m.SetAddrsResolver(addrsResolver{})
+	iid := ffs.NewAPIID()
+	drm.storage = []deals.StorageDealRecord{
+		{RootCid: c1, Addr: "other", Time: time.Now().Unix(), DealInfo: deals.StorageDealInfo{PricePerEpoch: 1, Duration: 10}},
+	}
+
+	st, err := m.Status(iid, cid.Undef)
+	require.NoError(t, err)
+	require.Equal(t, int64(0), st.MonthlySpent.Int64())
+	require.Nil(t, st.MonthlyRemaining)
+	require.Nil(t, st.CidSpent)
+}
+
+func newModule(t *testing.T) (*Module, *dealRecordsManager) {
+	drm := &dealRecordsManager{}
+	m := New(tests.NewTxMapDatastore(), drm)
+	m.SetAddrsResolver(addrsResolver{addrs: []string{"addr1"}})
+	return m, drm
+}
+
+type addrsResolver struct {
+	addrs []string
+}
+
+func (ar addrsResolver) WalletAddrs(ffs.APIID) ([]string, error) {
+	return ar.addrs, nil
+}
+
+// dealRecordsManager is a fake ffs.DealRecordsManager which only
+// filters by address and data cid.
+type dealRecordsManager struct {
+	storage   []deals.StorageDealRecord
+	retrieval []deals.RetrievalDealRecord
+	// storageLists counts the calls to ListStorageDealRecords.
+	storageLists int
+	// afterList, if not nil, is called after listing storage deal
+	// records, e.g: to simulate concurrent changes.
+	afterList func()
+}
+
+func (drm *dealRecordsManager) ListStorageDealRecords(opts ...deals.DealRecordsOption) ([]deals.StorageDealRecord, error) {
+	drm.storageLists++
+	c := deals.DealRecordsConfig{}
+	for _, opt := range opts {
+		opt(&c)
+	}
+	var res []deals.StorageDealRecord
+	for _, r := range drm.storage {
+		if included(c, r.Addr, r.RootCid) {
+			res = append(res, r)
+		}
+	}
+	if drm.afterList != nil {
+		drm.afterList()
+	}
+	return res, nil
+}
+
+func (drm *dealRecordsManager) ListRetrievalDealRecords(opts ...deals.DealRecordsOption) ([]deals.RetrievalDealRecord, error) {
+	c := deals.DealRecordsConfig{}
+	for _, opt := range opts {
+		opt(&c)
+	}
+	var res []deals.RetrievalDealRecord
+	for _, r := range drm.retrieval {
+		if included(c, r.Addr, r.DealInfo.RootCid) {
+			res = append(res, r)
+		}
+	}
+	return res, nil
+}
+
+func included(c deals.DealRecordsConfig, addr string, dataCid cid.Cid) bool {
+	return contains(c.FromAddrs, addr) && (len(c.DataCids) == 0 || contains(c.DataCids, util.CidToString(dataCid)))
+}
+
+func contains(l []string, s string) bool {
+	for _, e := range l {
+		if e == s {
+			return true
+		}
+	}
+	return false
+}
@@ -1,6 +1,7 @@
 package coreipfs
 
 import (
+	"bytes"
 	"context"
 	"errors"
 	"fmt"
@@ -8,6 +9,7 @@ import (
 	"sync"
 	"time"
 
+	"github.com/ipfs/go-car"
 	"github.com/ipfs/go-cid"
 	"github.com/ipfs/go-datastore"
 	ipfsfiles "github.com/ipfs/go-ipfs-files"
@@ -86,16 +88,76 @@ func (ci *CoreIpfs) StageCid(ctx context.Context, iid ffs.APIID, c cid.Cid) erro
 	return nil
 }
 
-// Get retrieves a cid data from the IPFS node.
-func (ci *CoreIpfs) Get(ctx context.Context, c cid.Cid) (io.Reader, error) {
-	n, err := ci.ipfs.Unixfs().Get(ctx, path.IpfsPath(c))
+// StageCar imports the blocks of a CARv1 file into the IPFS node, and stage-pins its
+// roots. The import fails if any block data doesn't match its cid, or if any root
+// isn't included in the CAR file.
+func (ci *CoreIpfs) StageCar(ctx context.Context, iid ffs.APIID, r io.Reader) ([]cid.Cid, error) {
+	cr, err := car.NewCarReader(r)
 	if err != nil {
-		return nil, fmt.Errorf("getting cid %s from ipfs: %s", c, err)
+		return nil, fmt.Errorf("reading car header: %s", err)
+	}
+	if len(cr.Header.Roots) == 0 {
+		return nil, fmt.Errorf("car file has no roots")
+	}
+	imported := make(map[cid.Cid]struct{})
+	for {
+		b, err := cr.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("reading car block: %s", err)
+		}
+		if err := ci.putBlock(ctx, b.Cid(), b.RawData()); err != nil {
+			return nil, fmt.Errorf("importing car block: %s", err)
+		}
+		imported[b.Cid()] = struct{}{}
+	}
+	for _, root := range cr.Header.Roots {
+		if _, ok := imported[root]; !ok {
+			return nil, fmt.Errorf("root %s isn't included in the car file", root)
+		}
+	}
+
+	ci.lock.Lock()
+	defer ci.lock.Unlock()
+
+	for _, root := range cr.Header.Roots {
+		if err := ci.ipfs.Pin().Add(ctx, path.IpfsPath(root), options.Pin.Recursive(true)); err != nil {
+			return nil, fmt.Errorf("pinning root %s: %s", root, err)
+		}
+		if err := ci.ps.AddStaged(iid, root); err != nil {
+			return nil, fmt.Errorf("saving new pin in pinstore: %s", err)
+		}
+	}
+
+	return cr.Header.Roots, nil
+}
+
+// Get retrieves a cid data from the IPFS node. If subPath isn't empty, it's
+// resolved as a UnixFS path from the cid. The data is read from offset, and
+// up to length bytes if length is greater than zero.
+func (ci *CoreIpfs) Get(ctx context.Context, c cid.Cid, subPath string, offset, length int64) (io.Reader, error) {
+	var p path.Path = path.IpfsPath(c)
+	if subPath != "" {
+		p = path.Join(p, subPath)
+	}
+	n, err := ci.ipfs.Unixfs().Get(ctx, p)
+	if err != nil {
+		return nil, fmt.Errorf("getting %s from ipfs: %s", p, err)
 	}
 	file := ipfsfiles.ToFile(n)
 	if file == nil {
 		return nil, fmt.Errorf("node is a directory")
 	}
+	if offset > 0 {
+		if _, err := file.Seek(offset, io.SeekStart); err != nil {
+			return nil, fmt.Errorf("seeking to offset %d: %s", offset, err)
+		}
+	}
+	if length > 0 {
+		return io.LimitReader(file, length), nil
+	}
 	return file, nil
 }
 
@@ -195,6 +257,36 @@ func (ci *CoreIpfs) IsPinned(ctx context.Context, iid ffs.APIID, c cid.Cid) (boo
 	return ci.ps.IsPinnedBy(iid, c), nil
 }
 
+// Cache pins a Cid for an APIID with a cache-pin, which is considered by GCStaged()
+// to be unpinned after the ttl. If the Cid was already pinned by APIID, the pin
+// becomes a cache-pin.
+func (ci *CoreIpfs) Cache(ctx context.Context, iid ffs.APIID, c cid.Cid, ttl time.Duration) (int, error) {
+	ci.lock.Lock()
+	defer ci.lock.Unlock()
+
+	p := path.IpfsPath(c)
+	if !ci.ps.IsPinned(c) {
+		if err := ci.ipfs.Pin().Add(ctx, p, options.Pin.Recursive(true)); err != nil {
+			return 0, fmt.Errorf("pinning cid %s: %s", c, err)
+		}
+	}
+	s, err := ci.ipfs.Object().Stat(ctx, p)
+	if err != nil {
+		return 0, fmt.Errorf("getting stats of cid %s: %s", c, err)
+	}
+	if err := ci.ps.AddCached(iid, c, time.Now().Add(ttl)); err != nil {
+		return 0, fmt.Errorf("saving new cache pin in pinstore: %s", err)
+	}
+
+	return s.CumulativeSize, nil
+}
+
+// CacheExpiration returns when the cache-pin of c by iid expires. If c isn't
+// cache-pinned by iid, it returns zero time.
+func (ci *CoreIpfs) CacheExpiration(ctx context.Context, iid ffs.APIID, c cid.Cid) (time.Time, error) {
+	return ci.ps.CacheExpiration(iid, c), nil
+}
+
 // GCStaged unpins Cids that are only pinned by Stage() calls and all pins satisfy the filters.
 func (ci *CoreIpfs) GCStaged(ctx context.Context, exclude []cid.Cid, olderThan time.Time) ([]cid.Cid, error) {
 	ci.lock.Lock()
@@ -235,6 +327,7 @@ func (ci *CoreIpfs) PinnedCids(ctx context.Context) ([]ffs.PinnedCid, error) {
 				ID:        upc.APIID,
 				Staged:    upc.Staged,
 				CreatedAt: upc.CreatedAt,
+				ExpiresAt: upc.ExpiresAt,
 			}
 		}
 		res[i] = npc
@@ -254,6 +347,7 @@ func (ci *CoreIpfs) getGCCandidates(exclude []cid.Cid, olderThan time.Time) ([]c
 		excludeMap[c] = struct{}{}
 	}
 
+	now := time.Now().Unix()
 	var unpinList []cid.Cid
 Loop:
 	for _, stagedPin := range lst {
@@ -270,8 +364,15 @@ Loop:
 		}
 		// A Cid is only safe to GC if all existing stage-pin are older than
 		// specified parameter. If any iid stage-pined the Cid more recently than olderThan
-		// we still have to wait a bit more to consider it for GC.
+		// we still have to wait a bit more to consider it for GC. Cache-pins are
+		// only safe to GC after they expire.
 		for _, sp := range stagedPin.Pins {
+			if sp.ExpiresAt > 0 {
+				if sp.ExpiresAt > now {
+					continue Loop
+				}
+				continue
+			}
 			if sp.CreatedAt > olderThan.Unix() {
 				continue Loop
 			}
@@ -306,6 +407,24 @@ func (ci *CoreIpfs) removeAndUnpinIfApplies(ctx context.Context, iid ffs.APIID,
 	return nil
 }
 
+// putBlock puts a block in the IPFS node, verifying
+// that the block data matches the provided cid.
+func (ci *CoreIpfs) putBlock(ctx context.Context, c cid.Cid, data []byte) error {
+	prefix := c.Prefix()
+	format := cid.CodecToStr[prefix.Codec]
+	if prefix.Version == 0 {
+		format = "v0"
+	}
+	stat, err := ci.ipfs.Block().Put(ctx, bytes.NewReader(data), options.Block.Format(format), options.Block.Hash(prefix.MhType, prefix.MhLength))
+	if err != nil {
+		return fmt.Errorf("putting block %s: %s", c, err)
+	}
+	if !stat.Path().Cid().Equals(c) {
+		return fmt.Errorf("block data doesn't match cid %s", c)
+	}
+	return nil
+}
+
 func (ci *CoreIpfs) unpinStaged(ctx context.Context, c cid.Cid) error {
 	count, stagedCount := ci.ps.RefCount(c)
 
@@ -3,14 +3,18 @@ package coreipfs
 import (
 	"bytes"
 	"context"
+	"io/ioutil"
 	"math/rand"
 	"sort"
 	"testing"
 	"time"
 
+	"github.com/ipfs/go-car"
 	"github.com/ipfs/go-cid"
 	ipfsfiles "github.com/ipfs/go-ipfs-files"
 	httpapi "github.com/ipfs/go-ipfs-http-client"
+	"github.com/ipfs/go-merkledag"
+	dstest "github.com/ipfs/go-merkledag/test"
 	"github.com/ipfs/interface-go-ipfs-core/options"
 	"github.com/stretchr/testify/require"
 	"github.com/textileio/powergate/v2/ffs"
@@ -103,6 +107,74 @@ func TestStagePinStage(t *testing.T) {
 	requireRefCount(t, ci, c, 1, 0)
 }
 
+func TestGet(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	r := rand.New(rand.NewSource(22))
+
+	ci, ipfs := newCoreIPFS(t)
+	data := it.RandomBytes(r, 1500)
+	iid := ffs.NewAPIID()
+
+	c, err := ci.Stage(ctx, iid, bytes.NewReader(data))
+	require.NoError(t, err)
+
+	rd, err := ci.Get(ctx, c, "", 0, 0)
+	require.NoError(t, err)
+	fetched, err := ioutil.ReadAll(rd)
+	require.NoError(t, err)
+	require.Equal(t, data, fetched)
+
+	rd, err = ci.Get(ctx, c, "", 100, 200)
+	require.NoError(t, err)
+	fetched, err = ioutil.ReadAll(rd)
+	require.NoError(t, err)
+	require.Equal(t, data[100:300], fetched)
+
+	dir := ipfsfiles.NewMapDirectory(map[string]ipfsfiles.Node{
+		"file": ipfsfiles.NewBytesFile(data),
+	})
+	p, err := ipfs.Unixfs().Add(ctx, dir, options.Unixfs.Pin(false))
+	require.NoError(t, err)
+
+	_, err = ci.Get(ctx, p.Cid(), "", 0, 0)
+	require.Error(t, err)
+
+	rd, err = ci.Get(ctx, p.Cid(), "file", 1000, 0)
+	require.NoError(t, err)
+	fetched, err = ioutil.ReadAll(rd)
+	require.NoError(t, err)
+	require.Equal(t, data[1000:], fetched)
+}
+
+func TestStageCar(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	r := rand.New(rand.NewSource(22))
+
+	ci, ipfs := newCoreIPFS(t)
+	iid := ffs.NewAPIID()
+
+	dag := dstest.Mock()
+	leaf := merkledag.NewRawNode(it.RandomBytes(r, 1500))
+	require.NoError(t, dag.Add(ctx, leaf))
+	root := &merkledag.ProtoNode{}
+	require.NoError(t, root.AddNodeLink("leaf", leaf))
+	require.NoError(t, dag.Add(ctx, root))
+	var buf bytes.Buffer
+	require.NoError(t, car.WriteCar(ctx, dag, []cid.Cid{root.Cid()}, &buf))
+
+	roots, err := ci.StageCar(ctx, iid, &buf)
+	require.NoError(t, err)
+	require.Equal(t, []cid.Cid{root.Cid()}, roots)
+	it.RequireIpfsPinnedCid(ctx, t, root.Cid(), ipfs)
+	requireCidIsGCable(t, ci, root.Cid())
+	requireRefCount(t, ci, root.Cid(), 0, 1)
+
+	_, err = ci.StageCar(ctx, iid, bytes.NewReader(it.RandomBytes(r, 100)))
+	require.Error(t, err)
+}
+
 func TestPinAndRePin(t *testing.T) {
 	t.Parallel()
 	ctx := context.Background()
@@ -532,6 +604,61 @@ func TestGCSingleAPIID(t *testing.T) {
 	})
 }
 
+func TestCache(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	r := rand.New(rand.NewSource(22))
+
+	ci, ipfs := newCoreIPFS(t)
+	data := it.RandomBytes(r, 1500)
+	iid := ffs.NewAPIID()
+
+	rd := ipfsfiles.NewReaderFile(bytes.NewReader(data))
+	p, err := ipfs.Unixfs().Add(ctx, rd, options.Unixfs.Pin(false))
+	require.NoError(t, err)
+	c := p.Cid()
+
+	// A pinned cid becomes cache-pinned.
+	_, err = ci.Pin(ctx, iid, c)
+	require.NoError(t, err)
+	exp, err := ci.CacheExpiration(ctx, iid, c)
+	require.NoError(t, err)
+	require.True(t, exp.IsZero())
+
+	size, err := ci.Cache(ctx, iid, c, time.Hour)
+	require.NoError(t, err)
+	require.Greater(t, size, 0)
+	it.RequireIpfsPinnedCid(ctx, t, c, ipfs)
+	requireRefCount(t, ci, c, 0, 1)
+	exp, err = ci.CacheExpiration(ctx, iid, c)
+	require.NoError(t, err)
+	require.True(t, exp.After(time.Now()))
+
+	// The cache-pin isn't GCable until it expires,
+	// despite the stage grace period.
+	gced, err := ci.GCStaged(ctx, nil, time.Now().Add(time.Hour))
+	require.NoError(t, err)
+	require.Len(t, gced, 0)
+
+	_, err = ci.Cache(ctx, iid, c, -time.Second)
+	require.NoError(t, err)
+	gced, err = ci.GCStaged(ctx, nil, time.Now().Add(-time.Hour))
+	require.NoError(t, err)
+	require.Len(t, gced, 1)
+	it.RequireIpfsUnpinnedCid(ctx, t, c, ipfs)
+
+	// Pinning a cache-pinned cid makes it fully-pinned.
+	_, err = ci.Cache(ctx, iid, c, time.Hour)
+	require.NoError(t, err)
+	_, err = ci.Pin(ctx, iid, c)
+	require.NoError(t, err)
+	requireRefCount(t, ci, c, 1, 0)
+	requireCidIsNotGCable(t, ci, c)
+	exp, err = ci.CacheExpiration(ctx, iid, c)
+	require.NoError(t, err)
+	require.True(t, exp.IsZero())
+}
+
 func requireCidIsGCable(t *testing.T, ci *CoreIpfs, c cid.Cid) {
 	t.Helper()
 	require.True(t, isGCable(t, ci, c))
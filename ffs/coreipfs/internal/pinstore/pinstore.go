@@ -38,10 +38,13 @@ type PinnedCid struct {
 
 // Pin describes a pin of a Cid from a APIID.
 // The Stage field indicates if the pin is a stage-pin.
+// The ExpiresAt field is set for cache-pins, which are
+// stage-pins that can be GCed after that time.
 type Pin struct {
 	APIID     ffs.APIID
 	Staged    bool
 	CreatedAt int64
+	ExpiresAt int64
 }
 
 // New returns a new Store.
@@ -136,6 +139,55 @@ func (s *Store) Add(iid ffs.APIID, c cid.Cid) error {
 	return s.persist(r)
 }
 
+// AddCached pins a Cid for APIID with a cache-pin that expires at
+// the provided time. If c is already pinned by iid with any pin type,
+// it's switched to a cache-pin.
+func (s *Store) AddCached(iid ffs.APIID, c cid.Cid, expiresAt time.Time) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	var r PinnedCid
+	if cr, ok := s.cache[c]; ok {
+		r = cr
+	} else {
+		r = PinnedCid{Cid: c}
+	}
+
+	p := Pin{
+		APIID:     iid,
+		Staged:    true,
+		CreatedAt: time.Now().Unix(),
+		ExpiresAt: expiresAt.Unix(),
+	}
+	for i := range r.Pins {
+		if r.Pins[i].APIID == iid {
+			r.Pins[i] = p
+			return s.persist(r)
+		}
+	}
+	r.Pins = append(r.Pins, p)
+
+	return s.persist(r)
+}
+
+// CacheExpiration returns the expiration of the cache-pin of c
+// for iid. If c isn't cache-pinned by iid, it returns zero time.
+func (s *Store) CacheExpiration(iid ffs.APIID, c cid.Cid) time.Time {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	r, ok := s.cache[c]
+	if !ok {
+		return time.Time{}
+	}
+	for _, p := range r.Pins {
+		if p.APIID == iid && p.Staged && p.ExpiresAt > 0 {
+			return time.Unix(p.ExpiresAt, 0)
+		}
+	}
+	return time.Time{}
+}
+
 // RefCount returns two integers (total, staged).
 // total is the total number of ref counts for the Cid.
 // staged is the total number of ref counts corresponding to
@@ -0,0 +1,306 @@
+package filcold
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/dustin/go-humanize"
+	aggregator "github.com/filecoin-project/go-dagaggregator-unixfs"
+	"github.com/filecoin-project/go-state-types/abi"
+	"github.com/ipfs/go-cid"
+	ipld "github.com/ipfs/go-ipld-format"
+	"github.com/ipfs/interface-go-ipfs-core/options"
+	"github.com/ipfs/interface-go-ipfs-core/path"
+	"github.com/textileio/powergate/v2/ffs"
+)
+
+// batch is a set of small Cids stored with the same configuration,
+// which are aggregated in a single DAG to be stored in shared deals.
+type batch struct {
+	cids []cid.Cid
+	size uint64
+	// full is closed when the batch reaches the aggregate size.
+	full chan struct{}
+	// done is closed when the batch aggregate was stored, and
+	// res is available.
+	done chan struct{}
+	res  batchResult
+}
+
+type batchResult struct {
+	root        cid.Cid
+	paths       map[cid.Cid]string
+	okDeals     []cid.Cid
+	failedDeals []ffs.DealError
+	pieceSize   abi.PaddedPieceSize
+	err         error
+}
+
+// storeBatchFunc stores the Cids of a batch using the provided configuration.
+type storeBatchFunc func(context.Context, []cid.Cid, ffs.FilConfig) batchResult
+
+// batcher accumulates small Cids stored with the same configuration in
+// batches. Each batch is stored by its own goroutine when it reaches the
+// aggregate size, or the max wait elapses, so it doesn't depend on the
+// Jobs storing the Cids.
+type batcher struct {
+	size    uint64
+	maxWait time.Duration
+	store   storeBatchFunc
+
+	ctx    context.Context
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+
+	lock    sync.Mutex
+	batches map[string]*batch
+}
+
+func newBatcher(size uint64, maxWait time.Duration, store storeBatchFunc) *batcher {
+	ctx, cancel := context.WithCancel(context.Background())
+	return &batcher{
+		size:    size,
+		maxWait: maxWait,
+		store:   store,
+		ctx:     ctx,
+		cancel:  cancel,
+		batches: make(map[string]*batch),
+	}
+}
+
+// add adds a Cid to the open batch for the configuration, and waits until
+// the batch is stored. If the batch is created, it's stored with the values
+// of ctx, but it isn't canceled with ctx.
+func (b *batcher) add(ctx context.Context, c cid.Cid, size uint64, cfg ffs.FilConfig) (batchResult, error) {
+	key, err := batchKey(cfg)
+	if err != nil {
+		return batchResult{}, fmt.Errorf("generating batch key: %s", err)
+	}
+	bt, err := b.addToBatch(ctx, key, c, size, cfg)
+	if err != nil {
+		return batchResult{}, err
+	}
+	select {
+	case <-bt.done:
+		return bt.res, nil
+	case <-ctx.Done():
+		return batchResult{}, fmt.Errorf("canceled by context")
+	}
+}
+
+// addToBatch adds a Cid to the open batch with the provided key, creating
+// it if needed.
+func (b *batcher) addToBatch(ctx context.Context, key string, c cid.Cid, size uint64, cfg ffs.FilConfig) (*batch, error) {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	if b.ctx.Err() != nil {
+		return nil, fmt.Errorf("batcher is closed")
+	}
+
+	bt, ok := b.batches[key]
+	if !ok {
+		bt = &batch{
+			full: make(chan struct{}),
+			done: make(chan struct{}),
+		}
+		b.batches[key] = bt
+		b.wg.Add(1)
+		go b.run(valuesCtx{Context: b.ctx, values: ctx}, key, bt, cfg)
+	}
+	for _, bc := range bt.cids {
+		// The same data can be stored by more than one user.
+		if bc.Equals(c) {
+			return bt, nil
+		}
+	}
+	bt.cids = append(bt.cids, c)
+	bt.size += size
+	if bt.size >= b.size {
+		// The batch is closed, so new Cids start a new batch.
+		delete(b.batches, key)
+		close(bt.full)
+	}
+	return bt, nil
+}
+
+// run waits until the batch reaches the aggregate size, or the max wait
+// elapses, and stores it.
+func (b *batcher) run(ctx context.Context, key string, bt *batch, cfg ffs.FilConfig) {
+	defer b.wg.Done()
+	timer := time.NewTimer(b.maxWait)
+	defer timer.Stop()
+	select {
+	case <-bt.full:
+	case <-timer.C:
+	case <-ctx.Done():
+	}
+
+	b.lock.Lock()
+	if b.batches[key] == bt {
+		delete(b.batches, key)
+	}
+	cids := bt.cids
+	b.lock.Unlock()
+
+	if ctx.Err() != nil {
+		bt.res = batchResult{err: fmt.Errorf("canceled by context")}
+	} else {
+		bt.res = b.store(ctx, cids, cfg)
+	}
+	close(bt.done)
+}
+
+// close cancels open batches, and waits for batches being stored.
+func (b *batcher) close() {
+	b.lock.Lock()
+	b.cancel()
+	b.lock.Unlock()
+	b.wg.Wait()
+}
+
+// valuesCtx is a context with the values of another context, so the
+// cancelation of the latter doesn't apply.
+type valuesCtx struct {
+	context.Context
+	values context.Context
+}
+
+func (c valuesCtx) Value(key interface{}) interface{} {
+	return c.values.Value(key)
+}
+
+// StoreAggregated stores a Cid in Filecoin considering the configuration provided. If the Cid
+// data is smaller than the aggregate size, it's batched with other small data stored with the
+// same configuration. The batch is stored when it reaches the aggregate size, or the max wait
+// elapses, using the Job context values of the Cid that created it. It returns the Aggregate
+// where the Cid was included, and the same results as Store for it.
+func (fc *FilCold) StoreAggregated(ctx context.Context, c cid.Cid, cfg ffs.FilConfig) (ffs.Aggregate, []cid.Cid, []ffs.DealError, abi.PaddedPieceSize, error) {
+	nd, err := fc.ipfs.Dag().Get(ctx, c)
+	if err != nil {
+		return ffs.Aggregate{}, nil, nil, 0, fmt.Errorf("getting cid node: %s", err)
+	}
+	size, err := nd.Size()
+	if err != nil {
+		return ffs.Aggregate{}, nil, nil, 0, fmt.Errorf("getting cid cummulative size: %s", err)
+	}
+	if size >= fc.aggr.size {
+		fc.l.Log(ctx, "Data size %s isn't small enough to be aggregated", humanize.IBytes(size))
+		okDeals, failedDeals, pieceSize, err := fc.Store(ctx, c, cfg)
+		return ffs.Aggregate{Root: c}, okDeals, failedDeals, pieceSize, err
+	}
+
+	fc.l.Log(ctx, "Waiting for the aggregate with other data to be stored...")
+	res, err := fc.aggr.add(ctx, c, size, cfg)
+	if err != nil {
+		return ffs.Aggregate{}, nil, nil, 0, err
+	}
+	if res.err != nil {
+		return ffs.Aggregate{}, nil, res.failedDeals, 0, res.err
+	}
+	agg := ffs.Aggregate{Root: res.root, Path: res.paths[c]}
+	fc.l.Log(ctx, "Data was aggregated in %s with path %s", agg.Root, agg.Path)
+	return agg, res.okDeals, res.failedDeals, res.pieceSize, nil
+}
+
+// storeBatch aggregates the Cids of a batch in a single DAG, and
+// stores it using the provided configuration.
+func (fc *FilCold) storeBatch(ctx context.Context, cids []cid.Cid, cfg ffs.FilConfig) batchResult {
+	fc.l.Log(ctx, "Aggregating %d cids...", len(cids))
+	entries := make([]aggregator.AggregateDagEntry, len(cids))
+	for i, c := range cids {
+		entries[i] = aggregator.AggregateDagEntry{RootCid: c}
+	}
+	root, err := aggregator.Aggregate(ctx, fc.ipfs.Dag(), entries)
+	if err != nil {
+		return batchResult{err: fmt.Errorf("aggregating cids: %s", err)}
+	}
+	// The aggregate DAG nodes aren't pinned by the Hot Storage of any of
+	// the aggregated data, and miners fetch the whole DAG, so it's pinned
+	// until ReleaseAggregate is called.
+	if err := fc.ipfs.Pin().Add(ctx, path.IpfsPath(root), options.Pin.Recursive(true)); err != nil {
+		return batchResult{err: fmt.Errorf("pinning aggregate: %s", err)}
+	}
+	fail := func(res batchResult) batchResult {
+		if err := fc.ReleaseAggregate(ctx, root); err != nil {
+			log.Errorf("releasing failed aggregate %s: %s", root, err)
+		}
+		return res
+	}
+	paths, err := aggregatePaths(ctx, fc.ipfs.Dag(), root, cids)
+	if err != nil {
+		return fail(batchResult{err: fmt.Errorf("getting paths in aggregate: %s", err)})
+	}
+	okDeals, failedDeals, pieceSize, err := fc.Store(ctx, root, cfg)
+	if err != nil {
+		return fail(batchResult{failedDeals: failedDeals, err: fmt.Errorf("storing aggregate: %s", err)})
+	}
+	return batchResult{
+		root:        root,
+		paths:       paths,
+		okDeals:     okDeals,
+		failedDeals: failedDeals,
+		pieceSize:   pieceSize,
+	}
+}
+
+// ReleaseAggregate unpins the DAG of an aggregate, which stays pinned while
+// data stored in it is tracked, so its deals can be renewed or repaired.
+func (fc *FilCold) ReleaseAggregate(ctx context.Context, root cid.Cid) error {
+	if err := fc.ipfs.Pin().Rm(ctx, path.IpfsPath(root), options.Pin.RmRecursive(true)); err != nil {
+		return fmt.Errorf("unpinning aggregate: %s", err)
+	}
+	return nil
+}
+
+// aggregatePaths returns the paths of Cids in the aggregate DAG with the
+// provided root. The DAG is walked breadth-first without descending into
+// the aggregated Cids.
+func aggregatePaths(ctx context.Context, ng ipld.NodeGetter, root cid.Cid, cids []cid.Cid) (map[cid.Cid]string, error) {
+	targets := make(map[cid.Cid]struct{}, len(cids))
+	for _, c := range cids {
+		targets[c] = struct{}{}
+	}
+	type item struct {
+		c    cid.Cid
+		path string
+	}
+	res := make(map[cid.Cid]string, len(cids))
+	queue := []item{{c: root}}
+	for len(queue) > 0 && len(res) < len(targets) {
+		it := queue[0]
+		queue = queue[1:]
+		nd, err := ng.Get(ctx, it.c)
+		if err != nil {
+			return nil, fmt.Errorf("getting aggregate node %s: %s", it.c, err)
+		}
+		for _, l := range nd.Links() {
+			p := it.path + "/" + l.Name
+			if _, ok := targets[l.Cid]; ok {
+				if _, found := res[l.Cid]; !found {
+					res[l.Cid] = p
+				}
+				continue
+			}
+			queue = append(queue, item{c: l.Cid, path: p})
+		}
+	}
+	if len(res) < len(targets) {
+		return nil, fmt.Errorf("only %d of %d cids were found in the aggregate", len(res), len(targets))
+	}
+	return res, nil
+}
+
+// batchKey returns a key for the deal configuration, so only Cids stored with
+// the same configuration are aggregated together. The key includes the wallet
+// address, since the deals of an aggregate are paid by a single wallet, so
+// Cids of users with different wallets aren't aggregated together.
+func batchKey(cfg ffs.FilConfig) (string, error) {
+	buf, err := json.Marshal(cfg)
+	if err != nil {
+		return "", fmt.Errorf("marshaling config: %s", err)
+	}
+	return string(buf), nil
+}
@@ -0,0 +1,178 @@
+package filcold
+
+import (
+	"context"
+	"math/big"
+	"sync"
+	"testing"
+	"time"
+
+	aggregator "github.com/filecoin-project/go-dagaggregator-unixfs"
+	"github.com/ipfs/go-cid"
+	"github.com/ipfs/go-merkledag"
+	dstest "github.com/ipfs/go-merkledag/test"
+	"github.com/stretchr/testify/require"
+	"github.com/textileio/powergate/v2/ffs"
+)
+
+func TestAggregatePaths(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	dag := dstest.Mock()
+
+	var cids []cid.Cid
+	var entries []aggregator.AggregateDagEntry
+	for _, data := range []string{"foo", "bar", "baz"} {
+		nd := merkledag.NewRawNode([]byte(data))
+		require.NoError(t, dag.Add(ctx, nd))
+		cids = append(cids, nd.Cid())
+		entries = append(entries, aggregator.AggregateDagEntry{RootCid: nd.Cid()})
+	}
+	root, err := aggregator.Aggregate(ctx, dag, entries)
+	require.NoError(t, err)
+
+	paths, err := aggregatePaths(ctx, dag, root, cids)
+	require.NoError(t, err)
+	require.Len(t, paths, len(cids))
+	seen := make(map[string]struct{})
+	for _, c := range cids {
+		p, ok := paths[c]
+		require.True(t, ok)
+		require.NotEmpty(t, p)
+		seen[p] = struct{}{}
+	}
+	require.Len(t, seen, len(cids))
+
+	missing := merkledag.NewRawNode([]byte("missing")).Cid()
+	_, err = aggregatePaths(ctx, dag, root, append(cids, missing))
+	require.Error(t, err)
+}
+
+func TestBatchKey(t *testing.T) {
+	t.Parallel()
+	cfg := ffs.FilConfig{Addr: "t3foo", RepFactor: 1, Aggregate: true}
+	k1, err := batchKey(cfg)
+	require.NoError(t, err)
+
+	cfg.MaxCost = big.NewInt(1000)
+	k2, err := batchKey(cfg)
+	require.NoError(t, err)
+	require.Equal(t, k1, k2)
+
+	cfg.Addr = "t3bar"
+	k3, err := batchKey(cfg)
+	require.NoError(t, err)
+	require.NotEqual(t, k1, k3)
+}
+
+// batchStorer is a fake storeBatchFunc which records stored batches.
+type batchStorer struct {
+	lock    sync.Mutex
+	batches [][]cid.Cid
+	ctxErrs []error
+}
+
+func (bs *batchStorer) store(ctx context.Context, cids []cid.Cid, cfg ffs.FilConfig) batchResult {
+	bs.lock.Lock()
+	defer bs.lock.Unlock()
+	bs.batches = append(bs.batches, cids)
+	bs.ctxErrs = append(bs.ctxErrs, ctx.Err())
+	return batchResult{root: cids[0], okDeals: cids}
+}
+
+func (bs *batchStorer) stored() [][]cid.Cid {
+	bs.lock.Lock()
+	defer bs.lock.Unlock()
+	return bs.batches
+}
+
+func TestBatcherJoin(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	bs := &batchStorer{}
+	b := newBatcher(10, time.Hour, bs.store)
+	defer b.close()
+	cfg := ffs.FilConfig{Addr: "t3foo", RepFactor: 1, Aggregate: true}
+	c1 := merkledag.NewRawNode([]byte("foo")).Cid()
+	c2 := merkledag.NewRawNode([]byte("bar")).Cid()
+	c3 := merkledag.NewRawNode([]byte("baz")).Cid()
+
+	var wg sync.WaitGroup
+	res := make([]batchResult, 2)
+	errs := make([]error, 2)
+	for i, c := range []cid.Cid{c1, c2} {
+		i, c := i, c
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			res[i], errs[i] = b.add(ctx, c, 4, cfg)
+		}()
+	}
+	// Data stored with a different configuration isn't joined.
+	other := cfg
+	other.Addr = "t3bar"
+	go func() { _, _ = b.add(ctx, c3, 4, other) }()
+	require.Never(t, func() bool { return len(bs.stored()) > 0 }, time.Millisecond*100, time.Millisecond*10)
+
+	// The batch is stored once it reaches the aggregate size.
+	_, err := b.add(ctx, c3, 2, cfg)
+	require.NoError(t, err)
+	wg.Wait()
+	require.Len(t, bs.stored(), 1)
+	require.ElementsMatch(t, []cid.Cid{c1, c2, c3}, bs.stored()[0])
+	for i := range res {
+		require.NoError(t, errs[i])
+		require.Equal(t, bs.stored()[0], res[i].okDeals)
+	}
+}
+
+func TestBatcherTimeout(t *testing.T) {
+	t.Parallel()
+	bs := &batchStorer{}
+	b := newBatcher(10, time.Millisecond*100, bs.store)
+	defer b.close()
+	c := merkledag.NewRawNode([]byte("foo")).Cid()
+
+	// The batch is stored when the max wait elapses, even if it
+	// didn't reach the aggregate size.
+	res, err := b.add(context.Background(), c, 1, ffs.FilConfig{})
+	require.NoError(t, err)
+	require.Equal(t, c, res.root)
+	require.Equal(t, [][]cid.Cid{{c}}, bs.stored())
+}
+
+func TestBatcherCancel(t *testing.T) {
+	t.Parallel()
+	bs := &batchStorer{}
+	b := newBatcher(10, time.Millisecond*200, bs.store)
+	c1 := merkledag.NewRawNode([]byte("foo")).Cid()
+	c2 := merkledag.NewRawNode([]byte("bar")).Cid()
+
+	// Canceling the Job which created the batch doesn't affect the
+	// other data in the batch.
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err := b.add(ctx, c1, 1, ffs.FilConfig{})
+	require.Error(t, err)
+	_, err = b.add(context.Background(), c2, 1, ffs.FilConfig{})
+	require.NoError(t, err)
+	require.Len(t, bs.stored(), 1)
+	require.Equal(t, []cid.Cid{c1, c2}, bs.stored()[0])
+	require.NoError(t, bs.ctxErrs[0])
+
+	// Closing the batcher fails open batches without storing them.
+	errc := make(chan error)
+	go func() {
+		res, err := b.add(context.Background(), c1, 1, ffs.FilConfig{})
+		if err == nil {
+			err = res.err
+		}
+		errc <- err
+	}()
+	time.Sleep(time.Millisecond * 50)
+	b.close()
+	require.Error(t, <-errc)
+	require.Len(t, bs.stored(), 1)
+	_, err = b.add(context.Background(), c1, 1, ffs.FilConfig{})
+	require.Error(t, err)
+}
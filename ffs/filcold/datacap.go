@@ -0,0 +1,90 @@
+package filcold
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/dustin/go-humanize"
+	"github.com/filecoin-project/go-state-types/abi"
+	"github.com/textileio/powergate/v2/deals"
+	"github.com/textileio/powergate/v2/ffs"
+	"github.com/textileio/powergate/v2/wallet"
+)
+
+// verifiedDealsCovered returns how many verified deals of a piece size the remaining
+// DataCap of a wallet address covers, up to repFactor. It also returns the remaining
+// DataCap, which is nil if the wallet address isn't a verified client.
+func (fc *FilCold) verifiedDealsCovered(ctx context.Context, addr string, pieceSize abi.PaddedPieceSize, repFactor int) (int, *big.Int, error) {
+	vci, err := fc.wm.GetVerifiedClientInfo(ctx, addr)
+	if err == wallet.ErrNoVerifiedClient {
+		return 0, nil, nil
+	}
+	if err != nil {
+		return 0, nil, fmt.Errorf("get address verified client info: %s", err)
+	}
+	if pieceSize == 0 {
+		return repFactor, vci.RemainingDatacapBytes, nil
+	}
+	covered := new(big.Int).Div(vci.RemainingDatacapBytes, big.NewInt(int64(pieceSize)))
+	if covered.Cmp(big.NewInt(int64(repFactor))) >= 0 {
+		return repFactor, vci.RemainingDatacapBytes, nil
+	}
+	return int(covered.Int64()), vci.RemainingDatacapBytes, nil
+}
+
+// dataCapPolicyVerifiedDeals returns how many of the new deals should be verified deals
+// if the remaining DataCap covers a number of them, considering the DataCap policy.
+// If the policy is to fail, it returns an error describing the missing DataCap.
+func dataCapPolicyVerifiedDeals(cfg ffs.FilConfig, covered int, remaining *big.Int, pieceSize abi.PaddedPieceSize) (int, error) {
+	if covered >= cfg.RepFactor {
+		return cfg.RepFactor, nil
+	}
+	switch cfg.DataCapPolicy {
+	case ffs.DataCapPolicyUnverified:
+		return 0, nil
+	case ffs.DataCapPolicyPartial:
+		return covered, nil
+	default:
+		if remaining == nil {
+			return 0, fmt.Errorf("wallet address isn't a verified client")
+		}
+		return 0, fmt.Errorf("the remaining data-cap %s is less than piece-size %s * rep-factor %d", humanize.IBytes(remaining.Uint64()), humanize.IBytes(uint64(pieceSize)), cfg.RepFactor)
+	}
+}
+
+// makeVerifiedDealConfigs selects miners for cfg.RepFactor new deals, where only the first
+// verified ones are verified deals. Miners of unverified deals are selected with unverified
+// prices, and are different from the ones selected for verified deals.
+// The countries of replicaMiners, which already store the data, are considered
+// for the minimum number of distinct countries.
+func (fc *FilCold) makeVerifiedDealConfigs(cfg ffs.FilConfig, replicaMiners []string, pieceSize abi.PaddedPieceSize, verified int) ([]deals.StorageDealConfig, error) {
+	if !cfg.VerifiedDeal || verified >= cfg.RepFactor {
+		f := makeMinerSelectorFilter(cfg, replicaMiners, pieceSize)
+		return makeDealConfigs(fc.ms, cfg.RepFactor, f, cfg.FastRetrieval, cfg.DealStartOffset, cfg.Offline.Enabled())
+	}
+
+	var res []deals.StorageDealConfig
+	if verified > 0 {
+		f := makeMinerSelectorFilter(cfg, replicaMiners, pieceSize)
+		vcfgs, err := makeDealConfigs(fc.ms, verified, f, cfg.FastRetrieval, cfg.DealStartOffset, cfg.Offline.Enabled())
+		if err != nil {
+			return nil, fmt.Errorf("making verified deal configs: %s", err)
+		}
+		res = vcfgs
+	}
+	ucfg := cfg
+	ucfg.VerifiedDeal = false
+	ucfg.ExcludedMiners = append([]string{}, cfg.ExcludedMiners...)
+	ureplicaMiners := append([]string{}, replicaMiners...)
+	for _, c := range res {
+		ucfg.ExcludedMiners = append(ucfg.ExcludedMiners, c.Miner)
+		ureplicaMiners = append(ureplicaMiners, c.Miner)
+	}
+	f := makeMinerSelectorFilter(ucfg, ureplicaMiners, pieceSize)
+	ucfgs, err := makeDealConfigs(fc.ms, cfg.RepFactor-verified, f, cfg.FastRetrieval, cfg.DealStartOffset, cfg.Offline.Enabled())
+	if err != nil {
+		return nil, fmt.Errorf("making unverified deal configs: %s", err)
+	}
+	return append(res, ucfgs...), nil
+}
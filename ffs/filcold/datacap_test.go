@@ -0,0 +1,43 @@
+package filcold
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/textileio/powergate/v2/ffs"
+)
+
+func TestDataCapPolicyVerifiedDeals(t *testing.T) {
+	t.Parallel()
+	remaining := big.NewInt(2048)
+	tests := []struct {
+		name      string
+		policy    ffs.DataCapPolicy
+		covered   int
+		remaining *big.Int
+		expected  int
+		err       bool
+	}{
+		{name: "covered", policy: ffs.DataCapPolicyFail, covered: 3, remaining: remaining, expected: 3},
+		{name: "fail", policy: ffs.DataCapPolicyFail, covered: 2, remaining: remaining, err: true},
+		{name: "fail no verified client", policy: ffs.DataCapPolicyFail, covered: 0, err: true},
+		{name: "unverified", policy: ffs.DataCapPolicyUnverified, covered: 2, remaining: remaining, expected: 0},
+		{name: "partial", policy: ffs.DataCapPolicyPartial, covered: 2, remaining: remaining, expected: 2},
+		{name: "partial no verified client", policy: ffs.DataCapPolicyPartial, covered: 0, expected: 0},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			cfg := ffs.FilConfig{RepFactor: 3, VerifiedDeal: true, DataCapPolicy: tt.policy}
+			verified, err := dataCapPolicyVerifiedDeals(cfg, tt.covered, tt.remaining, 1024)
+			if tt.err {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, verified)
+		})
+	}
+}
@@ -14,8 +14,10 @@ import (
 	"github.com/filecoin-project/lotus/api"
 	marketevents "github.com/filecoin-project/lotus/markets/loggers"
 	"github.com/ipfs/go-cid"
+	ipld "github.com/ipfs/go-ipld-format"
 	logger "github.com/ipfs/go-log/v2"
 	iface "github.com/ipfs/interface-go-ipfs-core"
+	"github.com/ipfs/interface-go-ipfs-core/options"
 	"github.com/textileio/powergate/v2/deals"
 	"github.com/textileio/powergate/v2/deals/module"
 	dealsModule "github.com/textileio/powergate/v2/deals/module"
@@ -47,6 +49,7 @@ type FilCold struct {
 	minPieceSize         uint64
 	retrNextEventTimeout time.Duration
 	semaphDealPrep       chan struct{}
+	aggr                 *batcher
 
 	// Metrics
 	metricPreprocessingTotal metric.Int64UpDownCounter
@@ -59,8 +62,9 @@ type FilChain interface {
 	GetHeight(context.Context) (uint64, error)
 }
 
-// New returns a new FilCold instance.
-func New(ms ffs.MinerSelector, dm *dealsModule.Module, wm wallet.Module, ipfs iface.CoreAPI, chain FilChain, l ffs.JobLogger, lsm *lotus.SyncMonitor, minPieceSize uint64, maxParallelDealPreparing int, retrievalNextEventTimeout time.Duration) *FilCold {
+// New returns a new FilCold instance. Data smaller than aggregateSize is aggregated when
+// stored with StoreAggregated, waiting at most aggregateMaxWait for other data to reach it.
+func New(ms ffs.MinerSelector, dm *dealsModule.Module, wm wallet.Module, ipfs iface.CoreAPI, chain FilChain, l ffs.JobLogger, lsm *lotus.SyncMonitor, minPieceSize uint64, maxParallelDealPreparing int, retrievalNextEventTimeout time.Duration, aggregateSize uint64, aggregateMaxWait time.Duration) *FilCold {
 	fc := &FilCold{
 		ms:                   ms,
 		dm:                   dm,
@@ -73,15 +77,27 @@ func New(ms ffs.MinerSelector, dm *dealsModule.Module, wm wallet.Module, ipfs if
 		retrNextEventTimeout: retrievalNextEventTimeout,
 		semaphDealPrep:       make(chan struct{}, maxParallelDealPreparing),
 	}
+	fc.aggr = newBatcher(aggregateSize, aggregateMaxWait, fc.storeBatch)
 	fc.initMetrics()
 
 	return fc
 }
 
+// Close closes the FilCold instance. Batches of data waiting to be
+// aggregated fail, and it waits for batches being stored.
+func (fc *FilCold) Close() error {
+	fc.aggr.close()
+	return nil
+}
+
 // Fetch fetches the stored Cid data.The data will be considered available
-// to the underlying blockstore.
+// to the underlying blockstore. If maxPrice is greater than zero, only offers
+// of at most that price are considered, and the retrieval fails if it spends more.
 func (fc *FilCold) Fetch(ctx context.Context, pyCid cid.Cid, piCid *cid.Cid, waddr string, miners []string, maxPrice uint64, selector string) (ffs.FetchInfo, error) {
-	miner, events, err := fc.dm.Fetch(ctx, waddr, pyCid, piCid, miners)
+	if selector != "" {
+		return ffs.FetchInfo{}, fmt.Errorf("selectors aren't supported yet")
+	}
+	miner, events, err := fc.dm.Fetch(ctx, waddr, pyCid, piCid, miners, maxPrice)
 	if err != nil {
 		return ffs.FetchInfo{}, fmt.Errorf("fetching from deal module: %s", err)
 	}
@@ -107,6 +123,9 @@ Loop:
 			strEvent := retrievalmarket.ClientEvents[e.Event]
 			strDealStatus := retrievalmarket.DealStatuses[e.Status]
 			fundsSpent = e.FundsSpent.Uint64()
+			if maxPrice > 0 && fundsSpent > maxPrice {
+				return ffs.FetchInfo{}, fmt.Errorf("retrieval spent %d attoFIL, more than the max price of %d attoFIL", fundsSpent, maxPrice)
+			}
 			newMsg := fmt.Sprintf("Received %s, total spent: %sFIL (%s/%s)", humanize.IBytes(e.BytesReceived), util.AttoFilToFil(fundsSpent), strEvent, strDealStatus)
 			if newMsg != lastMsg {
 				fc.l.Log(ctx, newMsg)
@@ -156,49 +175,89 @@ func (fc *FilCold) calculateDealPiece(ctx context.Context, c cid.Cid) (int64, ab
 	return piece.PayloadSize, piece.PieceSize, piece.PieceCID, nil
 }
 
+// offlinePayloadSize returns the payload size of offline deals data. The data
+// isn't fetched from the network, since it's transferred manually.
+func (fc *FilCold) offlinePayloadSize(ctx context.Context, c cid.Cid, pieceSize abi.PaddedPieceSize) (int64, error) {
+	offline, err := fc.ipfs.WithOptions(options.Api.Offline(true))
+	if err != nil {
+		return 0, fmt.Errorf("creating offline ipfs api: %s", err)
+	}
+	return payloadSize(ctx, offline.Dag(), c, pieceSize), nil
+}
+
+// payloadSize returns the cumulative size of the DAG of c. If the DAG isn't
+// available, it returns the unpadded piece size, which is the maximum size
+// of the data transferred to miners.
+func payloadSize(ctx context.Context, ng ipld.NodeGetter, c cid.Cid, pieceSize abi.PaddedPieceSize) int64 {
+	nd, err := ng.Get(ctx, c)
+	if err == nil {
+		var size uint64
+		if size, err = nd.Size(); err == nil {
+			return int64(size)
+		}
+	}
+	log.Debugf("offline deal payload %s isn't available, using the piece size: %s", c, err)
+	return int64(pieceSize.Unpadded())
+}
+
 // Store stores a Cid in Filecoin considering the configuration provided. The Cid is retrieved using
 // the DAGService registered on instance creation. It returns a slice of ProposalCids that were correctly
 // started, and a slice of with Proposal Cids rejected. Returned proposed deals can be tracked
 // with the WaitForDeal API.
 func (fc *FilCold) Store(ctx context.Context, c cid.Cid, cfg ffs.FilConfig) ([]cid.Cid, []ffs.DealError, abi.PaddedPieceSize, error) {
-	payloadSize, pieceSize, pieceCid, err := fc.calculateDealPiece(ctx, c)
-	if err != nil {
-		return nil, nil, 0, fmt.Errorf("getting cid cummulative size: %s", err)
+	var payloadSize int64
+	var pieceSize abi.PaddedPieceSize
+	var pieceCid cid.Cid
+	var err error
+	if cfg.Offline.Enabled() {
+		// Offline deals data is transferred manually, so the piece
+		// is already known.
+		pieceCid, err = cid.Decode(cfg.Offline.PieceCid)
+		if err != nil {
+			return nil, nil, 0, fmt.Errorf("parsing offline piece cid: %s", err)
+		}
+		pieceSize = abi.PaddedPieceSize(cfg.Offline.PieceSize)
+		payloadSize, err = fc.offlinePayloadSize(ctx, c, pieceSize)
+		if err != nil {
+			return nil, nil, 0, fmt.Errorf("getting offline payload size: %s", err)
+		}
+		fc.l.Log(ctx, "Making offline deals for piece %s with size %s", pieceCid, humanize.IBytes(uint64(pieceSize)))
+	} else {
+		payloadSize, pieceSize, pieceCid, err = fc.calculateDealPiece(ctx, c)
+		if err != nil {
+			return nil, nil, 0, fmt.Errorf("getting cid cummulative size: %s", err)
+		}
+		fc.l.Log(ctx, "The payload size is %s, and the calculated piece size is %s", humanize.IBytes(uint64(payloadSize)), humanize.IBytes(uint64(pieceSize)))
 	}
-	fc.l.Log(ctx, "The payload size is %s, and the calculated piece size is %s", humanize.IBytes(uint64(payloadSize)), humanize.IBytes(uint64(pieceSize)))
 
 	if uint64(pieceSize) < fc.minPieceSize {
 		return nil, nil, 0, fmt.Errorf("Piece size is below allowed minimum %s", humanize.IBytes(fc.minPieceSize))
 	}
 
+	verified := cfg.RepFactor
 	if cfg.VerifiedDeal {
-		vci, err := fc.wm.GetVerifiedClientInfo(ctx, cfg.Addr)
-		if err != nil && err != wallet.ErrNoVerifiedClient {
-			return nil, nil, 0, fmt.Errorf("get address verified client info: %s", err)
+		covered, remaining, err := fc.verifiedDealsCovered(ctx, cfg.Addr, pieceSize, cfg.RepFactor)
+		if err != nil {
+			return nil, nil, 0, err
 		}
-		if err == wallet.ErrNoVerifiedClient {
-			return nil, nil, 0, fmt.Errorf("wallet address isn't a verified client")
+		verified, err = dataCapPolicyVerifiedDeals(cfg, covered, remaining, pieceSize)
+		if err != nil {
+			return nil, nil, 0, err
 		}
-
-		pieceSizeBig := big.NewInt(int64(pieceSize))
-		if vci.RemainingDatacapBytes.Cmp(big.NewInt(0).Mul(big.NewInt(int64(cfg.RepFactor)), pieceSizeBig)) == -1 {
-			return nil, nil, 0, fmt.Errorf("the remaining data-cap %s is less than piece-size %s * rep-factor %d", humanize.IBytes(vci.RemainingDatacapBytes.Uint64()), humanize.IBytes(uint64(pieceSize)), cfg.RepFactor)
+		if verified < cfg.RepFactor {
+			fc.l.Log(ctx, "The remaining data-cap covers %d of %d verified deals, making %d unverified deals", covered, cfg.RepFactor, cfg.RepFactor-verified)
+		}
+		if verified > 0 {
+			fc.l.Log(ctx, "Attempting to use %s of data-cap. The current quota is %s", humanize.IBytes(uint64(pieceSize)*uint64(verified)), humanize.IBytes(remaining.Uint64()))
 		}
-
-		fc.l.Log(ctx, "Attempting to use %s of data-cap. The current quota is %s", humanize.IBytes(uint64(pieceSize)*uint64(cfg.RepFactor)), humanize.IBytes(vci.RemainingDatacapBytes.Uint64()))
-	}
-	f := ffs.MinerSelectorFilter{
-		ExcludedMiners: cfg.ExcludedMiners,
-		CountryCodes:   cfg.CountryCodes,
-		TrustedMiners:  cfg.TrustedMiners,
-		MaxPrice:       cfg.MaxPrice,
-		PieceSize:      uint64(pieceSize),
-		VerifiedDeal:   cfg.VerifiedDeal,
 	}
-	cfgs, err := makeDealConfigs(fc.ms, cfg.RepFactor, f, cfg.FastRetrieval, cfg.DealStartOffset)
+	cfgs, err := fc.makeVerifiedDealConfigs(cfg, ffs.ReplicaMiners(ctx), pieceSize, verified)
 	if err != nil {
 		return nil, nil, 0, fmt.Errorf("making deal configs: %s", err)
 	}
+	if err := checkMaxCost(cfgs, pieceSize, cfg.DealMinDuration, cfg.MaxCost); err != nil {
+		return nil, nil, 0, err
+	}
 
 	okDeals, failedStartingDeals, err := fc.makeDeals(ctx, c, payloadSize, pieceSize, pieceCid, cfgs, cfg)
 	if err != nil {
@@ -207,6 +266,67 @@ func (fc *FilCold) Store(ctx context.Context, c cid.Cid, cfg ffs.FilConfig) ([]c
 	return okDeals, failedStartingDeals, pieceSize, nil
 }
 
+// Plan returns the deals that Store would make for a Cid using the provided
+// configuration, with their estimated cost. No deals are made, but the data
+// should be available to calculate the piece size. Conditions that would make
+// Store fail, e.g: exceeding the MaxCost, are returned as warnings.
+func (fc *FilCold) Plan(ctx context.Context, c cid.Cid, cfg ffs.FilConfig) (ffs.ColdPlan, error) {
+	var piece api.DataCIDSize
+	if cfg.Offline.Enabled() {
+		piece.PieceSize = abi.PaddedPieceSize(cfg.Offline.PieceSize)
+	} else {
+		var err error
+		piece, err = fc.dm.CalculateDealPiece(ctx, c)
+		if err != nil {
+			return ffs.ColdPlan{}, fmt.Errorf("calculating deal piece: %s", err)
+		}
+	}
+	if uint64(piece.PieceSize) < fc.minPieceSize {
+		return ffs.ColdPlan{}, fmt.Errorf("piece size %s is below allowed minimum %s", humanize.IBytes(uint64(piece.PieceSize)), humanize.IBytes(fc.minPieceSize))
+	}
+
+	var warnings []string
+	verified := cfg.RepFactor
+	if cfg.VerifiedDeal {
+		covered, remaining, err := fc.verifiedDealsCovered(ctx, cfg.Addr, piece.PieceSize, cfg.RepFactor)
+		if err != nil {
+			return ffs.ColdPlan{}, err
+		}
+		v, err := dataCapPolicyVerifiedDeals(cfg, covered, remaining, piece.PieceSize)
+		if err != nil {
+			warnings = append(warnings, fmt.Sprintf("New deals would fail: %s", err))
+		} else if v < cfg.RepFactor {
+			verified = v
+			warnings = append(warnings, fmt.Sprintf("The remaining data-cap covers %d of %d verified deals, %d deals would be unverified", covered, cfg.RepFactor, cfg.RepFactor-verified))
+		}
+	}
+	cfgs, err := fc.makeVerifiedDealConfigs(cfg, ffs.ReplicaMiners(ctx), piece.PieceSize, verified)
+	if err != nil {
+		return ffs.ColdPlan{}, fmt.Errorf("making deal configs: %s", err)
+	}
+	if err := checkMaxCost(cfgs, piece.PieceSize, cfg.DealMinDuration, cfg.MaxCost); err != nil {
+		warnings = append(warnings, fmt.Sprintf("New deals would fail: %s", err))
+	}
+	res := ffs.ColdPlan{
+		PayloadSize: piece.PayloadSize,
+		PieceSize:   uint64(piece.PieceSize),
+		Deals:       make([]ffs.PlannedDeal, len(cfgs)),
+		TotalCost:   big.NewInt(0),
+		Warnings:    warnings,
+	}
+	for i, dc := range cfgs {
+		cost := dealCost(dc.EpochPrice, piece.PieceSize, cfg.DealMinDuration)
+		res.Deals[i] = ffs.PlannedDeal{
+			Miner:      dc.Miner,
+			EpochPrice: dc.EpochPrice,
+			Verified:   dc.VerifiedDeal,
+			Cost:       cost,
+		}
+		res.TotalCost.Add(res.TotalCost, cost)
+	}
+	return res, nil
+}
+
 // GetDealInfo returns on-chain information for a deal.
 func (fc *FilCold) GetDealInfo(ctx context.Context, dealID uint64) (api.MarketDeal, error) {
 	di, err := fc.dm.GetDealInfo(ctx, dealID)
@@ -227,7 +347,8 @@ func (fc *FilCold) GetDealInfo(ctx context.Context, dealID uint64) (api.MarketDe
 // Deal status updates are sent on the provided dealUpdates channel.
 // The caller should close the channel once all calls to EnsureRenewals have returned.
 // It returns an updated FilInfo for the Cid. All prevous Proposals in the received FilInfo are kept, only flagging the ones
-// that got renewed with Renewed=true. New deals from renewals are added to the returned FilInfo.
+// that got renewed with Renewed=true, and Replaced=true if a different miner renewed them. New deals
+// from renewals are added to the returned FilInfo.
 // Note: Most probably all this code should change in the future, when Filecoin supports telling the miner which deal is about to
 // expire that we're interested in extending the deal duration. Now we should make a new deal from scratch (send data, etc).
 func (fc *FilCold) EnsureRenewals(ctx context.Context, c cid.Cid, inf ffs.FilInfo, cfg ffs.FilConfig, dealFinalityTimeout time.Duration, dealUpdates chan deals.StorageDealInfo) (ffs.FilInfo, []ffs.DealError, error) {
@@ -236,11 +357,103 @@ func (fc *FilCold) EnsureRenewals(ctx context.Context, c cid.Cid, inf ffs.FilInf
 		return ffs.FilInfo{}, nil, fmt.Errorf("get current filecoin height: %s", err)
 	}
 
+	toRenew := dealsToRenew(inf, cfg, height)
+	if len(toRenew) == 0 {
+		return inf, nil, nil
+	}
+
+	renew := func(ctx context.Context, p ffs.FilStorage, cfg ffs.FilConfig) (ffs.FilStorage, error) {
+		return fc.renewDeal(ctx, c, p, cfg, dealFinalityTimeout, dealUpdates)
+	}
+	newInf, newDealErrors := renewDeals(ctx, inf, toRenew, cfg, renew)
+	return newInf, newDealErrors, nil
+}
+
+// renewFunc makes a deal renewing the provided deal, and returns the new deal.
+type renewFunc func(context.Context, ffs.FilStorage, ffs.FilConfig) (ffs.FilStorage, error)
+
+// renewDeals renews deals of a FilInfo using renew. Renewed deals are flagged
+// only if their new deal succeeded, and new deals are added to the returned FilInfo.
+func renewDeals(ctx context.Context, inf ffs.FilInfo, toRenew []ffs.FilStorage, cfg ffs.FilConfig, renew renewFunc) (ffs.FilInfo, []ffs.DealError) {
+	newInf := ffs.FilInfo{
+		DataCid:       inf.DataCid,
+		Size:          inf.Size,
+		AggregatePath: inf.AggregatePath,
+		Proposals:     make([]ffs.FilStorage, len(inf.Proposals)),
+		RetrievalCost: inf.RetrievalCost,
+	}
+	for i, p := range inf.Proposals {
+		newInf.Proposals[i] = p
+	}
+
+	var newDealErrors []ffs.DealError
+	for _, p := range toRenew {
+		var dealError ffs.DealError
+		rCtx := ffs.WithReplicaMiners(ctx, renewalReplicaMiners(newInf, toRenew))
+		newProposal, err := renew(rCtx, p, cfg)
+		if err != nil {
+			if errors.As(err, &dealError) {
+				newDealErrors = append(newDealErrors, dealError)
+				continue
+			}
+			continue
+		}
+		newInf.Proposals = append(newInf.Proposals, newProposal)
+		for j := range inf.Proposals {
+			if newInf.Proposals[j].DealID == p.DealID {
+				newInf.Proposals[j].Renewed = true
+				newInf.Proposals[j].Replaced = newProposal.Miner != p.Miner
+				break
+			}
+		}
+		if cfg.MaxCost != nil {
+			cost := dealCost(newProposal.EpochPrice, abi.PaddedPieceSize(inf.Size), newProposal.Duration)
+			cfg.MaxCost = new(big.Int).Sub(cfg.MaxCost, cost)
+		}
+	}
+
+	return newInf, newDealErrors
+}
+
+// renewalReplicaMiners returns the miners that will keep storing the data
+// after expiring deals end, so renewal deals preserve the country diversity.
+func renewalReplicaMiners(inf ffs.FilInfo, toRenew []ffs.FilStorage) []string {
+	expiring := make(map[uint64]struct{}, len(toRenew))
+	for _, r := range toRenew {
+		expiring[r.DealID] = struct{}{}
+	}
+	var res []string
+	for _, ip := range inf.Proposals {
+		if ip.Renewed || ip.Faulted {
+			continue
+		}
+		if _, ok := expiring[ip.DealID]; ok {
+			continue
+		}
+		res = append(res, ip.Miner)
+	}
+	return res
+}
+
+// RenewalsDue returns the number of deals of a FilInfo state which EnsureRenewals
+// would renew considering the FilConfig desired configuration.
+func (fc *FilCold) RenewalsDue(ctx context.Context, inf ffs.FilInfo, cfg ffs.FilConfig) (int, error) {
+	height, err := fc.chain.GetHeight(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("get current filecoin height: %s", err)
+	}
+	return len(dealsToRenew(inf, cfg, height)), nil
+}
+
+// dealsToRenew returns the deals which should be renewed at the provided height
+// to ensure the desired RepFactor.
+func dealsToRenew(inf ffs.FilInfo, cfg ffs.FilConfig, height uint64) []ffs.FilStorage {
 	var renewable []ffs.FilStorage
 	for _, p := range inf.Proposals {
 		// If this deal was already renewed, we can ignore it will
-		// soon expire since we already handled it.
-		if p.Renewed {
+		// soon expire since we already handled it. Deals of faulted
+		// miners are replaced by repairs instead.
+		if p.Renewed || p.Faulted {
 			continue
 		}
 		expiry := int64(p.StartEpoch) + p.Duration
@@ -251,14 +464,14 @@ func (fc *FilCold) EnsureRenewals(ctx context.Context, c cid.Cid, inf ffs.FilInf
 	}
 
 	// Calculate how many active deals aren't expiring soon.
-	youngActiveDeals := len(inf.Proposals) - len(renewable)
+	youngActiveDeals := inf.ActiveDeals() - len(renewable)
 	// Calculate how many of the renewable (soon to be expired) deals should be renewed
 	// so we have the desired RepFactor.
 	numToBeRenewed := cfg.RepFactor - youngActiveDeals
 	if numToBeRenewed <= 0 {
 		// Nothing to be renewed to ensure RepFactor,
 		// most prob the RepFactor was decreased.
-		return inf, nil, nil
+		return nil
 	}
 	if numToBeRenewed > len(renewable) {
 		// We need even more deals than renewable to ensure RepFactor,
@@ -266,33 +479,7 @@ func (fc *FilCold) EnsureRenewals(ctx context.Context, c cid.Cid, inf ffs.FilInf
 		// renewed.
 		numToBeRenewed = len(renewable)
 	}
-
-	newInf := ffs.FilInfo{
-		DataCid:   inf.DataCid,
-		Size:      inf.Size,
-		Proposals: make([]ffs.FilStorage, len(inf.Proposals)),
-	}
-	for i, p := range inf.Proposals {
-		newInf.Proposals[i] = p
-	}
-
-	toRenew := renewable[:numToBeRenewed]
-	var newDealErrors []ffs.DealError
-	for i, p := range toRenew {
-		var dealError ffs.DealError
-		newProposal, err := fc.renewDeal(ctx, c, p, cfg, dealFinalityTimeout, dealUpdates)
-		if err != nil {
-			if errors.As(err, &dealError) {
-				newDealErrors = append(newDealErrors, dealError)
-				continue
-			}
-			continue
-		}
-		newInf.Proposals = append(newInf.Proposals, newProposal)
-		newInf.Proposals[i].Renewed = true
-	}
-
-	return newInf, newDealErrors, nil
+	return renewable[:numToBeRenewed]
 }
 
 func (fc *FilCold) renewDeal(ctx context.Context, c cid.Cid, p ffs.FilStorage, fcfg ffs.FilConfig, waitDealTimeout time.Duration, dealUpdates chan deals.StorageDealInfo) (ffs.FilStorage, error) {
@@ -301,18 +488,14 @@ func (fc *FilCold) renewDeal(ctx context.Context, c cid.Cid, p ffs.FilStorage, f
 		return ffs.FilStorage{}, fmt.Errorf("getting cid cummulative size: %s", err)
 	}
 
-	f := ffs.MinerSelectorFilter{
-		ExcludedMiners: fcfg.ExcludedMiners,
-		CountryCodes:   fcfg.CountryCodes,
-		TrustedMiners:  []string{p.Miner},
-		MaxPrice:       fcfg.MaxPrice,
-		PieceSize:      uint64(pieceSize),
-		VerifiedDeal:   fcfg.VerifiedDeal,
-	}
-	dealConfig, err := makeDealConfigs(fc.ms, 1, f, fcfg.FastRetrieval, fcfg.DealStartOffset)
+	dealConfig, err := fc.makeRenewalDealConfig(ctx, p, fcfg, pieceSize)
 	if err != nil {
 		return ffs.FilStorage{}, fmt.Errorf("making new deal config: %s", err)
 	}
+	if err := checkMaxCost(dealConfig, pieceSize, fcfg.DealMinDuration, fcfg.MaxCost); err != nil {
+		fc.l.Log(ctx, "Renewal deal wasn't proposed: %s", err)
+		return ffs.FilStorage{}, err
+	}
 
 	okDeals, failedStartedDeals, err := fc.makeDeals(ctx, c, payloadSize, pieceSize, pieceCid, dealConfig, fcfg)
 	if err != nil {
@@ -334,6 +517,36 @@ func (fc *FilCold) renewDeal(ctx context.Context, c cid.Cid, p ffs.FilStorage, f
 	return okDeal, err
 }
 
+// makeRenewalDealConfig selects the miner for renewing a deal. The miner of the deal
+// is preferred, but if it's excluded or doesn't satisfy the FilConfig filters anymore
+// (e.g: it's faulting or more expensive than MaxPrice), a new miner is selected.
+func (fc *FilCold) makeRenewalDealConfig(ctx context.Context, p ffs.FilStorage, fcfg ffs.FilConfig, pieceSize abi.PaddedPieceSize) ([]deals.StorageDealConfig, error) {
+	excluded := false
+	for _, m := range fcfg.ExcludedMiners {
+		if m == p.Miner {
+			excluded = true
+			break
+		}
+	}
+	reason := "it's excluded"
+	if !excluded {
+		f := makeMinerSelectorFilter(fcfg, ffs.ReplicaMiners(ctx), pieceSize)
+		f.TrustedMiners = []string{p.Miner}
+		dealConfig, err := makeDealConfigs(fc.ms, 1, f, fcfg.FastRetrieval, fcfg.DealStartOffset, false)
+		if err == nil && dealConfig[0].Miner == p.Miner {
+			return dealConfig, nil
+		}
+		reason = "it doesn't satisfy the current filters"
+		if err != nil {
+			reason = err.Error()
+		}
+	}
+	fc.l.Log(ctx, "Miner %s can't renew deal %d since %s, selecting a new miner...", p.Miner, p.DealID, reason)
+	f := makeMinerSelectorFilter(fcfg, ffs.ReplicaMiners(ctx), pieceSize)
+	f.ExcludedMiners = append(append([]string{}, fcfg.ExcludedMiners...), p.Miner)
+	return makeDealConfigs(fc.ms, 1, f, fcfg.FastRetrieval, fcfg.DealStartOffset, false)
+}
+
 // makeDeals starts deals with the specified miners. It returns a slice with all the ProposalCids
 // that were started successfully, and a slice of DealError with deals that failed to be started.
 func (fc *FilCold) makeDeals(ctx context.Context, c cid.Cid, payloadSize int64, pieceSize abi.PaddedPieceSize, pieceCid cid.Cid, cfgs []deals.StorageDealConfig, fcfg ffs.FilConfig) ([]cid.Cid, []ffs.DealError, error) {
@@ -353,10 +566,18 @@ func (fc *FilCold) makeDeals(ctx context.Context, c cid.Cid, payloadSize int64,
 		fc.l.Log(ctx, "Proposing deal to miner %s with %s FIL per epoch...", cfg.Miner, util.AttoFilToFil(cfg.EpochPrice))
 	}
 
+	// The cost is reserved from the budget, so deals proposed concurrently
+	// for the same user can't exceed it.
+	r, err := ffs.ReserveBudget(ctx, dealsCost(cfgs, pieceSize, fcfg.DealMinDuration))
+	if err != nil {
+		return nil, nil, fmt.Errorf("reserving budget: %s", err)
+	}
 	sres, err := fc.dm.Store(ctx, fcfg.Addr, c, payloadSize, pieceSize, pieceCid, cfgs, uint64(fcfg.DealMinDuration))
 	if err != nil {
+		r.Release()
 		return nil, nil, fmt.Errorf("storing deals in deal module: %s", err)
 	}
+	r.Commit()
 	var okDeals []cid.Cid
 	var failedDeals []ffs.DealError
 	for _, r := range sres {
@@ -438,7 +659,57 @@ Loop:
 	return ffs.FilStorage{}, fmt.Errorf("aborted due to cancellation")
 }
 
-func makeDealConfigs(ms ffs.MinerSelector, cntMiners int, f ffs.MinerSelectorFilter, fastRetrieval bool, dealStartOffset int64) ([]deals.StorageDealConfig, error) {
+func makeMinerSelectorFilter(cfg ffs.FilConfig, replicaMiners []string, pieceSize abi.PaddedPieceSize) ffs.MinerSelectorFilter {
+	return ffs.MinerSelectorFilter{
+		ExcludedMiners:   cfg.ExcludedMiners,
+		CountryCodes:     cfg.CountryCodes,
+		TrustedMiners:    cfg.TrustedMiners,
+		MaxPrice:         cfg.MaxPrice,
+		PieceSize:        uint64(pieceSize),
+		VerifiedDeal:     cfg.VerifiedDeal,
+		MinCountries:     cfg.MinCountries,
+		ReplicaMiners:    replicaMiners,
+		MinRelativePower: cfg.MinRelativePower,
+		MaxFaults:        cfg.MaxFaults,
+		MaxFaultyRatio:   cfg.MaxFaultyRatio,
+		SectorSize:       cfg.SectorSize,
+	}
+}
+
+// dealCost returns the cost in attoFIL of a deal for a piece size and duration,
+// given the miner ask price per GiB per epoch. The cost is multiplied by the
+// duration before dividing by GiB, so pieces smaller than a GiB don't round
+// down to a zero price per epoch.
+func dealCost(epochPrice uint64, pieceSize abi.PaddedPieceSize, duration int64) *big.Int {
+	res := new(big.Int).SetUint64(epochPrice)
+	res.Mul(res, new(big.Int).SetUint64(uint64(pieceSize)))
+	res.Mul(res, big.NewInt(duration))
+	return res.Div(res, big.NewInt(1<<30))
+}
+
+// checkMaxCost returns an error if the deals would cost more than maxCost
+// for their whole duration. A nil maxCost means no limit.
+func checkMaxCost(cfgs []deals.StorageDealConfig, pieceSize abi.PaddedPieceSize, duration int64, maxCost *big.Int) error {
+	if maxCost == nil {
+		return nil
+	}
+	total := dealsCost(cfgs, pieceSize, duration)
+	if total.Cmp(maxCost) > 0 {
+		return fmt.Errorf("deals would cost %s attoFIL, exceeding the remaining budget of %s attoFIL", total, maxCost)
+	}
+	return nil
+}
+
+// dealsCost returns the total cost of deals for their whole duration.
+func dealsCost(cfgs []deals.StorageDealConfig, pieceSize abi.PaddedPieceSize, duration int64) *big.Int {
+	total := big.NewInt(0)
+	for _, c := range cfgs {
+		total.Add(total, dealCost(c.EpochPrice, pieceSize, duration))
+	}
+	return total
+}
+
+func makeDealConfigs(ms ffs.MinerSelector, cntMiners int, f ffs.MinerSelectorFilter, fastRetrieval bool, dealStartOffset int64, offline bool) ([]deals.StorageDealConfig, error) {
 	mps, err := ms.GetMiners(cntMiners, f)
 	if err != nil {
 		return nil, fmt.Errorf("getting miners from minerselector: %s", err)
@@ -451,6 +722,7 @@ func makeDealConfigs(ms ffs.MinerSelector, cntMiners int, f ffs.MinerSelectorFil
 			FastRetrieval:   fastRetrieval,
 			DealStartOffset: dealStartOffset,
 			VerifiedDeal:    f.VerifiedDeal,
+			Offline:         offline,
 		}
 	}
 	return res, nil
@@ -0,0 +1,271 @@
+package filcold
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/filecoin-project/go-state-types/abi"
+	"github.com/ipfs/go-merkledag"
+	dstest "github.com/ipfs/go-merkledag/test"
+	"github.com/stretchr/testify/require"
+	"github.com/textileio/powergate/v2/ffs"
+	"github.com/textileio/powergate/v2/ffs/joblogger"
+	"github.com/textileio/powergate/v2/tests"
+	txndstr "github.com/textileio/powergate/v2/txndstransform"
+	"github.com/textileio/powergate/v2/util"
+)
+
+type minerSelector struct {
+	miners []ffs.MinerProposal
+	filter ffs.MinerSelectorFilter
+}
+
+var _ ffs.MinerSelector = (*minerSelector)(nil)
+
+// GetMiners returns the miners satisfying the excluded miners and max price
+// filters, prioritizing trusted miners.
+func (ms *minerSelector) GetMiners(n int, f ffs.MinerSelectorFilter) ([]ffs.MinerProposal, error) {
+	ms.filter = f
+	excluded := make(map[string]struct{}, len(f.ExcludedMiners))
+	for _, m := range f.ExcludedMiners {
+		excluded[m] = struct{}{}
+	}
+	var trusted, others []ffs.MinerProposal
+	for _, m := range ms.miners {
+		if _, ok := excluded[m.Addr]; ok {
+			continue
+		}
+		if f.MaxPrice > 0 && m.EpochPrice > f.MaxPrice {
+			continue
+		}
+		isTrusted := false
+		for _, t := range f.TrustedMiners {
+			if t == m.Addr {
+				isTrusted = true
+				break
+			}
+		}
+		if isTrusted {
+			trusted = append(trusted, m)
+		} else {
+			others = append(others, m)
+		}
+	}
+	res := append(trusted, others...)
+	if n > len(res) {
+		return nil, fmt.Errorf("not enough miners satisfy the filters")
+	}
+	return res[:n], nil
+}
+
+func TestDealCost(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name       string
+		epochPrice uint64
+		pieceSize  uint64
+		duration   int64
+		expected   string
+	}{
+		{name: "GiB", epochPrice: 10, pieceSize: 1 << 30, duration: 100, expected: "1000"},
+		{name: "32GiB", epochPrice: 500000000, pieceSize: 32 << 30, duration: 1000000, expected: "16000000000000000"},
+		// The price per epoch of a small piece is less than 1 attoFIL,
+		// but it adds up over the deal duration.
+		{name: "small piece", epochPrice: 1, pieceSize: 1 << 20, duration: 1 << 20, expected: "1024"},
+		{name: "zero price", epochPrice: 0, pieceSize: 1 << 30, duration: 100, expected: "0"},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			cost := dealCost(tt.epochPrice, abi.PaddedPieceSize(tt.pieceSize), tt.duration)
+			require.Equal(t, tt.expected, cost.String())
+		})
+	}
+}
+
+func TestPlan(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	ms := &minerSelector{
+		miners: []ffs.MinerProposal{
+			{Addr: "f01000", EpochPrice: 100},
+			{Addr: "f01001", EpochPrice: 200},
+		},
+	}
+	fc := New(ms, nil, nil, nil, nil, nil, nil, 0, 1, time.Minute, 0, 0)
+
+	c := merkledag.NewRawNode([]byte("data")).Cid()
+	pieceCid := merkledag.NewRawNode([]byte("piece")).Cid()
+	pieceSize := uint64(1 << 20)
+	cfg := ffs.FilConfig{
+		RepFactor:       2,
+		DealMinDuration: util.MinDealDuration,
+		MaxPrice:        300,
+		ExcludedMiners:  []string{"f09999"},
+		// Offline deals use the configured piece, so the data
+		// isn't needed to calculate it.
+		Offline: ffs.FilOffline{PieceCid: pieceCid.String(), PieceSize: pieceSize},
+	}
+	plan, err := fc.Plan(ctx, c, cfg)
+	require.NoError(t, err)
+	require.Equal(t, pieceSize, plan.PieceSize)
+	require.Empty(t, plan.Warnings)
+	require.Len(t, plan.Deals, 2)
+	total := big.NewInt(0)
+	for i, d := range plan.Deals {
+		require.Equal(t, ms.miners[i].Addr, d.Miner)
+		require.Equal(t, ms.miners[i].EpochPrice, d.EpochPrice)
+		require.False(t, d.Verified)
+		require.Equal(t, dealCost(d.EpochPrice, abi.PaddedPieceSize(pieceSize), cfg.DealMinDuration).String(), d.Cost.String())
+		total.Add(total, d.Cost)
+	}
+	require.Equal(t, total.String(), plan.TotalCost.String())
+	require.Equal(t, cfg.MaxPrice, ms.filter.MaxPrice)
+	require.Equal(t, pieceSize, ms.filter.PieceSize)
+	require.Equal(t, cfg.ExcludedMiners, ms.filter.ExcludedMiners)
+	require.Empty(t, ms.filter.ReplicaMiners)
+
+	// Miners already storing the data are indicated in the context.
+	_, err = fc.Plan(ffs.WithReplicaMiners(ctx, []string{"f01002"}), c, cfg)
+	require.NoError(t, err)
+	require.Equal(t, []string{"f01002"}, ms.filter.ReplicaMiners)
+
+	// Not enough miners satisfy the configuration.
+	cfg.RepFactor = 3
+	_, err = fc.Plan(ctx, c, cfg)
+	require.Error(t, err)
+
+	// Pieces smaller than the minimum piece size aren't stored.
+	cfg.RepFactor = 2
+	fc = New(ms, nil, nil, nil, nil, nil, nil, pieceSize*2, 1, time.Minute, 0, 0)
+	_, err = fc.Plan(ctx, c, cfg)
+	require.Error(t, err)
+}
+
+func TestPayloadSize(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	dag := dstest.Mock()
+	nd := merkledag.NewRawNode([]byte("data"))
+	require.NoError(t, dag.Add(ctx, nd))
+	pieceSize := abi.PaddedPieceSize(1 << 20)
+
+	require.Equal(t, int64(4), payloadSize(ctx, dag, nd.Cid(), pieceSize))
+
+	// Offline deals data might not be available, since it's transferred
+	// manually to miners.
+	missing := merkledag.NewRawNode([]byte("missing")).Cid()
+	require.Equal(t, int64(pieceSize.Unpadded()), payloadSize(ctx, dag, missing, pieceSize))
+}
+
+func TestMakeRenewalDealConfig(t *testing.T) {
+	t.Parallel()
+	deal := ffs.FilStorage{DealID: 1, Miner: "f01000"}
+	tests := []struct {
+		name     string
+		miners   []ffs.MinerProposal
+		excluded []string
+		expected string
+	}{
+		{
+			name:     "original miner",
+			miners:   []ffs.MinerProposal{{Addr: "f01001", EpochPrice: 100}, {Addr: "f01000", EpochPrice: 100}},
+			expected: "f01000",
+		},
+		{
+			name:     "original miner excluded",
+			miners:   []ffs.MinerProposal{{Addr: "f01000", EpochPrice: 100}, {Addr: "f01001", EpochPrice: 100}},
+			excluded: []string{"f01000"},
+			expected: "f01001",
+		},
+		{
+			name:     "original miner fails filters",
+			miners:   []ffs.MinerProposal{{Addr: "f01000", EpochPrice: 300}, {Addr: "f01001", EpochPrice: 100}},
+			expected: "f01001",
+		},
+		{
+			name:   "no other miner",
+			miners: []ffs.MinerProposal{{Addr: "f01000", EpochPrice: 300}},
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ms := &minerSelector{miners: tt.miners}
+			fc := New(ms, nil, nil, nil, nil, newJobLogger(), nil, 0, 1, time.Minute, 0, 0)
+			cfg := ffs.FilConfig{RepFactor: 1, MaxPrice: 200, ExcludedMiners: tt.excluded}
+
+			res, err := fc.makeRenewalDealConfig(context.Background(), deal, cfg, abi.PaddedPieceSize(1<<20))
+			if tt.expected == "" {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Len(t, res, 1)
+			require.Equal(t, tt.expected, res[0].Miner)
+			if tt.expected != deal.Miner {
+				// The original miner isn't selected as the new miner.
+				require.Contains(t, ms.filter.ExcludedMiners, deal.Miner)
+			}
+		})
+	}
+}
+
+func TestRenewDeals(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	inf := ffs.FilInfo{
+		Size: 1 << 20,
+		Proposals: []ffs.FilStorage{
+			{DealID: 1, Miner: "f01000", Duration: 100},
+			{DealID: 2, Miner: "f01001", Duration: 100},
+			{DealID: 3, Miner: "f01002", Duration: 100},
+		},
+	}
+	// Deal 1 is renewed by the same miner, deal 2 by a new miner,
+	// and deal 3 can't be renewed.
+	renewals := map[uint64]ffs.FilStorage{
+		1: {DealID: 4, Miner: "f01000", Duration: 100},
+		2: {DealID: 5, Miner: "f01003", Duration: 100},
+	}
+	renew := func(ctx context.Context, p ffs.FilStorage, cfg ffs.FilConfig) (ffs.FilStorage, error) {
+		if p.DealID == 3 {
+			return ffs.FilStorage{}, ffs.DealError{Miner: p.Miner, Message: "no other miner available"}
+		}
+		return renewals[p.DealID], nil
+	}
+
+	res, dealErrors := renewDeals(ctx, inf, inf.Proposals, ffs.FilConfig{}, renew)
+	require.Len(t, dealErrors, 1)
+	require.Equal(t, "f01002", dealErrors[0].Miner)
+	require.Len(t, res.Proposals, 5)
+	require.True(t, res.Proposals[0].Renewed)
+	require.False(t, res.Proposals[0].Replaced)
+	require.True(t, res.Proposals[1].Renewed)
+	require.True(t, res.Proposals[1].Replaced)
+	require.False(t, res.Proposals[2].Renewed)
+	require.False(t, res.Proposals[2].Replaced)
+	require.Equal(t, renewals[1], res.Proposals[3])
+	require.Equal(t, renewals[2], res.Proposals[4])
+	// The received FilInfo isn't modified.
+	for _, p := range inf.Proposals {
+		require.False(t, p.Renewed)
+	}
+
+	// Failing renewals don't flag any deal.
+	fail := func(ctx context.Context, p ffs.FilStorage, cfg ffs.FilConfig) (ffs.FilStorage, error) {
+		return ffs.FilStorage{}, fmt.Errorf("making new deal config: no miners available")
+	}
+	res, dealErrors = renewDeals(ctx, inf, inf.Proposals, ffs.FilConfig{}, fail)
+	require.Empty(t, dealErrors)
+	require.Equal(t, inf.Proposals, res.Proposals)
+}
+
+func newJobLogger() ffs.JobLogger {
+	return joblogger.New(txndstr.Wrap(tests.NewTxMapDatastore(), "joblogger"))
+}
@@ -99,7 +99,7 @@ func RequireEventualJobState(t require.TestingT, fapi *api.API, jid ffs.JobID, s
 		case job, ok := <-ch:
 			require.True(t, ok)
 			require.Equal(t, jid, job.ID)
-			if job.Status == ffs.Queued || job.Status == ffs.Executing {
+			if job.Status == ffs.Queued || job.Status == ffs.Executing || job.Status == ffs.Scheduled {
 				if job.Status == status {
 					stop = true
 					res = job
@@ -86,10 +86,10 @@ func NewCustomFFSManager(t require.TestingT, ds datastore.TxnDatastore, cb lotus
 	l := joblogger.New(txndstr.Wrap(ds, "ffs/joblogger"))
 	lsm, err := lotus.NewSyncMonitor(cb)
 	require.NoError(t, err)
-	cl := filcold.New(ms, dm, nil, ipfsClient, fchain, l, lsm, minimumPieceSize, 1, time.Hour)
+	cl := filcold.New(ms, dm, nil, ipfsClient, fchain, l, lsm, minimumPieceSize, 1, time.Hour, minimumPieceSize, time.Minute)
 	hl, err := coreipfs.New(ds, ipfsClient, l)
 	require.NoError(t, err)
-	sched, err := scheduler.New(txndstr.Wrap(ds, "ffs/scheduler"), l, hl, cl, 10, time.Minute*10, nil, scheduler.GCConfig{AutoGCInterval: 0})
+	sched, err := scheduler.New(txndstr.Wrap(ds, "ffs/scheduler"), l, hl, cl, 10, time.Minute*10, nil, scheduler.GCConfig{AutoGCInterval: 0}, scheduler.EvalConfig{}, scheduler.RetentionConfig{}, nil, nil, nil)
 	require.NoError(t, err)
 
 	wm, err := lotusWallet.New(cb, masterAddr, *big.NewInt(iWalletBal), false, "")
@@ -125,6 +125,10 @@ func NewCustomFFSManager(t require.TestingT, ds datastore.TxnDatastore, cb lotus
 			t.Errorf("closing scheduler: %s", err)
 			t.FailNow()
 		}
+		if err := cl.Close(); err != nil {
+			t.Errorf("closing filcold: %s", err)
+			t.FailNow()
+		}
 		if err := l.Close(); err != nil {
 			t.Errorf("closing joblogger: %s", err)
 			t.FailNow()
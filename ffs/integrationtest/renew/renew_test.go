@@ -71,3 +71,43 @@ Loop:
 		break Loop
 	}
 }
+
+func TestRenewUnfreeze(t *testing.T) {
+	// Same minimum deal duration limitation as TestRenew.
+	t.SkipNow()
+
+	t.Parallel()
+	ipfsAPI, _, fapi, cls := itmanager.NewAPI(t, 1, 300)
+	defer cls()
+
+	ra := rand.New(rand.NewSource(22))
+	cid, _ := it.AddRandomFile(t, ra, ipfsAPI)
+
+	renewThreshold := 12600
+	config := fapi.DefaultStorageConfig().WithHotEnabled(false).WithColdFilDealDuration(util.MinDealDuration+int64(100)).WithColdFilRenew(true, renewThreshold)
+	_, err := fapi.PushStorageConfig(cid, api.WithStorageConfig(config))
+	require.Error(t, err)
+
+	config = config.WithColdFilRenewUnfreeze(true)
+	jid, err := fapi.PushStorageConfig(cid, api.WithStorageConfig(config))
+	require.NoError(t, err)
+	it.RequireEventualJobState(t, fapi, jid, ffs.Success)
+	it.RequireStorageConfig(t, fapi, cid, &config)
+
+	ticker := time.NewTicker(time.Second)
+	defer ticker.Stop()
+	epochDeadline := 200
+	for range ticker.C {
+		i, err := fapi.StorageInfo(cid)
+		require.NoError(t, err)
+		if len(i.Cold.Filecoin.Proposals) < 2 {
+			require.Greater(t, epochDeadline, 0)
+			continue
+		}
+
+		require.Equal(t, 2, len(i.Cold.Filecoin.Proposals))
+		require.True(t, i.Cold.Filecoin.Proposals[0].Renewed)
+		require.False(t, i.Hot.Enabled)
+		break
+	}
+}
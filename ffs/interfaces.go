@@ -45,11 +45,16 @@ type HotStorage interface {
 	// StageCid pulls Cid data and stage-pin it.
 	StageCid(context.Context, APIID, cid.Cid) error
 
+	// StageCar imports a CAR file and stage-pins its roots.
+	StageCar(context.Context, APIID, io.Reader) ([]cid.Cid, error)
+
 	// Unpin unpins a Cid.
 	Unpin(context.Context, APIID, cid.Cid) error
 
-	// Get retrieves a stored Cid data.
-	Get(context.Context, cid.Cid) (io.Reader, error)
+	// Get retrieves a stored Cid data. If a path is provided, it's
+	// resolved from the Cid. Data is read from an offset, and up to
+	// a length if it's greater than zero.
+	Get(context.Context, cid.Cid, string, int64, int64) (io.Reader, error)
 
 	// Pin pins a Cid. If the data wasn't previously Added,
 	// depending on the implementation it may use internal mechanisms
@@ -64,6 +69,15 @@ type HotStorage interface {
 	// otherwise.
 	IsPinned(context.Context, APIID, cid.Cid) (bool, error)
 
+	// Cache pins a Cid with a time-limited pin, which is unpinned
+	// by GCStaged after the provided TTL. If the Cid was already
+	// pinned, the pin becomes time-limited.
+	Cache(context.Context, APIID, cid.Cid, time.Duration) (int, error)
+
+	// CacheExpiration returns when the time-limited pin of a Cid
+	// expires, or zero time if the Cid isn't cached.
+	CacheExpiration(context.Context, APIID, cid.Cid) (time.Time, error)
+
 	// GCStaged unpins Cids that are stage-pinned that aren't
 	// contained in a exclude list, and were pinned before a time.
 	GCStaged(context.Context, []cid.Cid, time.Time) ([]cid.Cid, error)
@@ -72,6 +86,61 @@ type HotStorage interface {
 	PinnedCids(context.Context) ([]PinnedCid, error)
 }
 
+// EventNotifier receives Events about StorageJobs and their deals.
+type EventNotifier interface {
+	// Notify notifies an Event. It shouldn't block.
+	Notify(Event)
+}
+
+// BudgetTracker tracks spending limits of APIIDs.
+type BudgetTracker interface {
+	// Remaining returns the amount of attoFIL that an APIID can still
+	// spend in deals for a Cid. A nil result means no limit.
+	Remaining(APIID, cid.Cid) (*big.Int, error)
+	// Reserve atomically reserves an amount of attoFIL of the remaining
+	// budget of an APIID for a Cid, so concurrent Jobs can't exceed it.
+	// It fails if the remaining budget isn't enough.
+	Reserve(APIID, cid.Cid, *big.Int) (BudgetReservation, error)
+}
+
+// BudgetReservation is an amount of attoFIL reserved from a budget while
+// proposing deals. It should be committed or released once the deals
+// were proposed.
+type BudgetReservation interface {
+	// Commit indicates that deals were proposed, so the reservation is
+	// replaced by their deal records.
+	Commit()
+	// Release cancels the reservation, since no deal was proposed.
+	Release()
+}
+
+// BudgetReserver reserves an amount of attoFIL of a budget.
+type BudgetReserver func(*big.Int) (BudgetReservation, error)
+
+type budgetReserverCtxKey struct{}
+
+// WithBudgetReserver returns a copy of ctx with a BudgetReserver, so
+// ColdStorage reserves the cost of new deals before proposing them.
+func WithBudgetReserver(ctx context.Context, r BudgetReserver) context.Context {
+	return context.WithValue(ctx, budgetReserverCtxKey{}, r)
+}
+
+// ReserveBudget reserves an amount of attoFIL with the BudgetReserver
+// indicated in ctx with WithBudgetReserver. If there's none, the budget
+// is unlimited and the returned reservation does nothing.
+func ReserveBudget(ctx context.Context, amount *big.Int) (BudgetReservation, error) {
+	r, _ := ctx.Value(budgetReserverCtxKey{}).(BudgetReserver)
+	if r == nil {
+		return noopReservation{}, nil
+	}
+	return r(amount)
+}
+
+type noopReservation struct{}
+
+func (noopReservation) Commit()  {}
+func (noopReservation) Release() {}
+
 // DealError contains information about a failed deal.
 type DealError struct {
 	ProposalCid cid.Cid
@@ -91,6 +160,16 @@ type FetchInfo struct {
 	FundsSpent     uint64
 }
 
+// Aggregate describes where data is stored in an aggregate DAG
+// shared with other data.
+type Aggregate struct {
+	// Root is the root Cid of the aggregate DAG.
+	Root cid.Cid
+	// Path is the path of the data in the aggregate DAG. It's empty
+	// if the data wasn't aggregated, so Root is the data Cid.
+	Path string
+}
+
 var (
 	// ErrOnChainDealNotFound is returned when a deal doesn't exist,
 	// or might have been slashed.
@@ -105,6 +184,16 @@ type ColdStorage interface {
 	// a slice of rejected proposal deals, and the size of the data.
 	Store(context.Context, cid.Cid, FilConfig) ([]cid.Cid, []DealError, abi.PaddedPieceSize, error)
 
+	// StoreAggregated is similar to Store, but if the Cid data is small
+	// it's aggregated with other small data and deals are made for the
+	// aggregate. It returns the Aggregate where the Cid was included, and
+	// the results of storing it.
+	StoreAggregated(context.Context, cid.Cid, FilConfig) (Aggregate, []cid.Cid, []DealError, abi.PaddedPieceSize, error)
+
+	// ReleaseAggregate releases the resources kept for an aggregate
+	// created by StoreAggregated, once no stored data references it.
+	ReleaseAggregate(context.Context, cid.Cid) error
+
 	// WaitForDeal blocks the provided Deal Proposal reach a
 	// final state. If the deal finishes successfully it returns a FilStorage
 	// result. If the deal finished with error, it returns a ffs.DealError
@@ -118,10 +207,18 @@ type ColdStorage interface {
 	// configuration. It returns a slice of deal errors happened during execution.
 	EnsureRenewals(context.Context, cid.Cid, FilInfo, FilConfig, time.Duration, chan deals.StorageDealInfo) (FilInfo, []DealError, error)
 
+	// RenewalsDue returns the number of deals which EnsureRenewals would
+	// renew for a Cid under a particular configuration.
+	RenewalsDue(context.Context, FilInfo, FilConfig) (int, error)
+
 	// GetDealInfo returns information about an on-chain deal.
 	// If a deal ID doesn't exist, the deal isn't active anymore, or was
 	// slashed, then ErrOnChainDealNotFound is returned.
 	GetDealInfo(context.Context, uint64) (api.MarketDeal, error)
+
+	// Plan returns the deals that Store would make for a Cid
+	// using the provided configuration, without making them.
+	Plan(context.Context, cid.Cid, FilConfig) (ColdPlan, error)
 }
 
 // MinerSelector returns miner addresses and ask storage information using a
@@ -153,6 +250,41 @@ type MinerSelectorFilter struct {
 	PieceSize uint64
 	// VerifiedDeal indicates it should take verified storage prices.
 	VerifiedDeal bool
+	// MinCountries is the minimum number of distinct countries where returned
+	// miners, together with ReplicaMiners, should be located. Zero disables
+	// this constraint.
+	MinCountries int
+	// ReplicaMiners contains miner addresses already storing the data, whose
+	// countries count towards MinCountries. ColdStorage sets it from the
+	// miners indicated with WithReplicaMiners.
+	ReplicaMiners []string
+	// MinRelativePower is the minimum relative power of miners in the
+	// network. Zero disables this filter.
+	MinRelativePower float64
+	// MaxFaults is the maximum number of recent faults of miners.
+	// Zero allows no faults, and nil disables this filter.
+	MaxFaults *int
+	// MaxFaultyRatio is the maximum ratio of faulty sectors over live
+	// sectors of miners. Zero disables this filter.
+	MaxFaultyRatio float64
+	// SectorSize is the allowed sector size of miners. Zero allows
+	// any sector size.
+	SectorSize uint64
+}
+
+type replicaMinersCtxKey struct{}
+
+// WithReplicaMiners returns a copy of ctx indicating the miners already
+// storing the data, so ColdStorage considers their countries when selecting
+// miners for new deals.
+func WithReplicaMiners(ctx context.Context, miners []string) context.Context {
+	return context.WithValue(ctx, replicaMinersCtxKey{}, miners)
+}
+
+// ReplicaMiners returns the miners indicated in ctx with WithReplicaMiners.
+func ReplicaMiners(ctx context.Context) []string {
+	miners, _ := ctx.Value(replicaMinersCtxKey{}).([]string)
+	return miners
 }
 
 // MinerProposal contains a miners address and storage ask information
@@ -162,6 +294,58 @@ type MinerProposal struct {
 	EpochPrice uint64
 }
 
+// StorageConfigPlan describes the actions that executing a StorageConfig
+// for a Cid would take.
+type StorageConfigPlan struct {
+	Cid  cid.Cid
+	Hot  HotPlan
+	Cold ColdPlan
+}
+
+// HotPlan describes the Hot Storage actions of a StorageConfigPlan.
+type HotPlan struct {
+	// Pin indicates that the data would be pinned in Hot Storage.
+	Pin bool
+	// Unfreeze indicates that the data would be retrieved from
+	// existing Filecoin deals if it can't be fetched from the IPFS network.
+	Unfreeze bool
+	// Unpin indicates that the data would be unpinned from Hot Storage.
+	Unpin bool
+}
+
+// ColdPlan describes the Cold Storage actions of a StorageConfigPlan.
+type ColdPlan struct {
+	// ActiveDeals is the number of active deals of the Cid.
+	ActiveDeals int
+	// PayloadSize is the size in bytes of the data. It's only
+	// calculated if new deals would be made.
+	PayloadSize int64
+	// PieceSize is the padded piece size of the data. It's only
+	// calculated if new deals would be made.
+	PieceSize uint64
+	// Deals are the new deals that would be made.
+	Deals []PlannedDeal
+	// Warnings describe conditions that would make new deals
+	// differ from the configuration, or fail.
+	Warnings []string
+	// TotalCost is the estimated cost in attoFIL of all new
+	// deals for their whole duration.
+	TotalCost *big.Int
+}
+
+// PlannedDeal describes a deal that would be made with a miner.
+type PlannedDeal struct {
+	// Miner is the miner address.
+	Miner string
+	// EpochPrice is the miner ask price in attoFIL per GiB per epoch.
+	EpochPrice uint64
+	// Verified indicates that the deal would be a verified deal.
+	Verified bool
+	// Cost is the estimated cost in attoFIL of the deal for its
+	// whole duration.
+	Cost *big.Int
+}
+
 // PinnedCid provides information about a pinned Cid.
 type PinnedCid struct {
 	Cid    cid.Cid
@@ -173,4 +357,5 @@ type APIIDPinnedCid struct {
 	ID        APIID
 	Staged    bool
 	CreatedAt int64
+	ExpiresAt int64
 }
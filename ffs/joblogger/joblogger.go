@@ -130,6 +130,44 @@ func (cl *Logger) GetByCid(ctx context.Context, iid ffs.APIID, c cid.Cid) ([]ffs
 	return lgs, nil
 }
 
+// RemoveByJobs removes the history logs of a Cid from the provided Jobs,
+// and returns the number of removed log entries.
+func (cl *Logger) RemoveByJobs(ctx context.Context, iid ffs.APIID, c cid.Cid, jids []ffs.JobID) (int, error) {
+	remove := make(map[ffs.JobID]struct{}, len(jids))
+	for _, jid := range jids {
+		remove[jid] = struct{}{}
+	}
+	q := query.Query{Prefix: makeStorageCidKey(iid, c).String()}
+	res, err := cl.ds.Query(q)
+	if err != nil {
+		return 0, fmt.Errorf("running query: %s", err)
+	}
+	var keys []datastore.Key
+	for r := range res.Next() {
+		if r.Error != nil {
+			_ = res.Close()
+			return 0, fmt.Errorf("iter next: %s", r.Error)
+		}
+		var le logEntry
+		if err := json.Unmarshal(r.Value, &le); err != nil {
+			_ = res.Close()
+			return 0, fmt.Errorf("unmarshaling log entry: %s", err)
+		}
+		if _, ok := remove[le.Jid]; ok {
+			keys = append(keys, datastore.NewKey(r.Key))
+		}
+	}
+	if err := res.Close(); err != nil {
+		return 0, fmt.Errorf("closing query result: %s", err)
+	}
+	for _, k := range keys {
+		if err := cl.ds.Delete(k); err != nil {
+			return 0, fmt.Errorf("deleting log entry: %s", err)
+		}
+	}
+	return len(keys), nil
+}
+
 // Watch is a blocking function that writes to the channel all new created log entries.
 // The client should cancel the ctx to signal stopping writing to the channel and free resources.
 func (cl *Logger) Watch(ctx context.Context, c chan<- ffs.LogEntry) error {
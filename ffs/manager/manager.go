@@ -204,19 +204,24 @@ func (m *Manager) GetByAuthToken(token string) (*api.API, error) {
 		return nil, ErrAuthTokenNotFound
 	}
 
-	i, ok := m.instances[iid]
-	if !ok {
-		log.Debugf("loading uncached instance %s", iid)
-		i, err = api.Load(namespace.Wrap(m.ds, datastore.NewKey("api/"+iid.String())), iid, m.sched, m.wm, m.drm)
-		if err != nil {
-			return nil, fmt.Errorf("loading instance %s: %s", iid, err)
-		}
-		m.instances[iid] = i
-	} else {
-		log.Debugf("using cached instance %s", iid)
-	}
+	return m.getInstance(iid)
+}
 
-	return i, nil
+// WalletAddrs returns the wallet addresses of an existing instance.
+func (m *Manager) WalletAddrs(iid ffs.APIID) ([]string, error) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	i, err := m.getInstance(iid)
+	if err != nil {
+		return nil, err
+	}
+	addrs := i.Addrs()
+	res := make([]string, len(addrs))
+	for j, a := range addrs {
+		res[j] = a.Addr
+	}
+	return res, nil
 }
 
 // GetDefaultStorageConfig returns the current default StorageConfig used
@@ -245,6 +250,23 @@ func (m *Manager) Close() error {
 	return nil
 }
 
+// getInstance returns a cached instance, or loads it from the datastore.
+// This method must be guarded.
+func (m *Manager) getInstance(iid ffs.APIID) (*api.API, error) {
+	i, ok := m.instances[iid]
+	if ok {
+		log.Debugf("using cached instance %s", iid)
+		return i, nil
+	}
+	log.Debugf("loading uncached instance %s", iid)
+	i, err := api.Load(namespace.Wrap(m.ds, datastore.NewKey("api/"+iid.String())), iid, m.sched, m.wm, m.drm)
+	if err != nil {
+		return nil, fmt.Errorf("loading instance %s: %s", iid, err)
+	}
+	m.instances[iid] = i
+	return i, nil
+}
+
 // saveDefaultConfig persists a new default configuration and updates
 // the cached value. This method must be guarded.
 func (m *Manager) saveDefaultConfig(dc ffs.StorageConfig) error {
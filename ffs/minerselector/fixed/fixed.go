@@ -4,6 +4,9 @@ import (
 	"fmt"
 
 	"github.com/textileio/powergate/v2/ffs"
+	"github.com/textileio/powergate/v2/ffs/minerselector/internal/diversity"
+	"github.com/textileio/powergate/v2/ffs/minerselector/internal/quality"
+	"github.com/textileio/powergate/v2/index/miner"
 )
 
 // MinerSelector is a MinerSelector implementation which
@@ -17,6 +20,12 @@ type Miner struct {
 	Addr       string
 	Country    string
 	EpochPrice uint64
+
+	RelativePower float64
+	SectorSize    uint64
+	SectorsLive   uint64
+	SectorsFaulty uint64
+	RecentFaults  int
 }
 
 var _ ffs.MinerSelector = (*MinerSelector)(nil)
@@ -35,12 +44,24 @@ func New(miners []Miner) *MinerSelector {
 func (fms *MinerSelector) GetMiners(n int, f ffs.MinerSelectorFilter) ([]ffs.MinerProposal, error) {
 	res := make([]ffs.MinerProposal, 0, n)
 	mres := make(map[string]struct{})
+	replicaCountries := make([]string, len(f.ReplicaMiners))
+	for i, rm := range f.ReplicaMiners {
+		replicaCountries[i] = fms.country(rm)
+	}
+	dt := diversity.New(f.MinCountries, n, replicaCountries)
 	for _, pm := range f.TrustedMiners {
 		for _, m := range fms.miners {
 			if m.Addr == pm {
 				if f.MaxPrice > 0 && m.EpochPrice > f.MaxPrice {
 					continue
 				}
+				if checkQuality(f, m) != nil {
+					continue
+				}
+				if !dt.Accepts(m.Country) {
+					continue
+				}
+				dt.Add(m.Country)
 				mres[m.Addr] = struct{}{}
 				res = append(res, ffs.MinerProposal{
 					Addr:       m.Addr,
@@ -61,6 +82,9 @@ func (fms *MinerSelector) GetMiners(n int, f ffs.MinerSelectorFilter) ([]ffs.Min
 		if f.MaxPrice > 0 && m.EpochPrice > f.MaxPrice {
 			continue
 		}
+		if checkQuality(f, m) != nil {
+			continue
+		}
 		skip := false
 		for _, bAddr := range f.ExcludedMiners {
 			if bAddr == m.Addr {
@@ -83,6 +107,10 @@ func (fms *MinerSelector) GetMiners(n int, f ffs.MinerSelectorFilter) ([]ffs.Min
 				continue
 			}
 		}
+		if !dt.Accepts(m.Country) {
+			continue
+		}
+		dt.Add(m.Country)
 		res = append(res, ffs.MinerProposal{
 			Addr:       m.Addr,
 			EpochPrice: m.EpochPrice,
@@ -96,3 +124,22 @@ func (fms *MinerSelector) GetMiners(n int, f ffs.MinerSelectorFilter) ([]ffs.Min
 	}
 	return res, nil
 }
+
+func (fms *MinerSelector) country(addr string) string {
+	for _, m := range fms.miners {
+		if m.Addr == addr {
+			return m.Country
+		}
+	}
+	return ""
+}
+
+func checkQuality(f ffs.MinerSelectorFilter, m Miner) error {
+	d := miner.OnChainMinerData{
+		RelativePower: m.RelativePower,
+		SectorSize:    m.SectorSize,
+		SectorsLive:   m.SectorsLive,
+		SectorsFaulty: m.SectorsFaulty,
+	}
+	return quality.Check(f, d, true, m.RecentFaults)
+}
@@ -0,0 +1,58 @@
+package diversity
+
+// Tracker tracks the countries of selected miners to enforce a minimum
+// number of distinct countries between n selected miners.
+type Tracker struct {
+	minCountries int
+	n            int
+	selected     int
+	countries    map[string]struct{}
+}
+
+// New returns a Tracker for selecting n miners which, together with
+// miners already storing the data in replicaCountries, should be located
+// in at least minCountries distinct countries.
+func New(minCountries, n int, replicaCountries []string) *Tracker {
+	t := &Tracker{
+		minCountries: minCountries,
+		n:            n,
+		countries:    make(map[string]struct{}),
+	}
+	for _, c := range replicaCountries {
+		if c != "" {
+			t.countries[c] = struct{}{}
+		}
+	}
+	return t
+}
+
+// Accepts returns true if selecting a miner located in country keeps the
+// constraint satisfiable with the remaining miners to be selected. An empty
+// country means the location of the miner is unknown.
+func (t *Tracker) Accepts(country string) bool {
+	if t.selected >= t.n {
+		return false
+	}
+	missing := t.minCountries - len(t.countries)
+	if missing <= 0 {
+		return true
+	}
+	if _, ok := t.countries[country]; country != "" && !ok {
+		return true
+	}
+	return t.n-t.selected-1 >= missing
+}
+
+// Add registers a selected miner located in country.
+func (t *Tracker) Add(country string) {
+	t.selected++
+	if country != "" {
+		t.countries[country] = struct{}{}
+	}
+}
+
+// Satisfied returns true if selected miners are located in at least
+// the minimum number of distinct countries.
+func (t *Tracker) Satisfied() bool {
+	return len(t.countries) >= t.minCountries
+}
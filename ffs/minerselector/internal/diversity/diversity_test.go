@@ -0,0 +1,46 @@
+package diversity
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestTracker(t *testing.T) {
+	t.Parallel()
+	t.Run("Disabled", func(t *testing.T) {
+		t.Parallel()
+		tr := New(0, 2, nil)
+		require.True(t, tr.Accepts(""))
+		tr.Add("")
+		require.True(t, tr.Accepts(""))
+		tr.Add("")
+		require.False(t, tr.Accepts("US"))
+		require.True(t, tr.Satisfied())
+	})
+	t.Run("DistinctCountries", func(t *testing.T) {
+		t.Parallel()
+		tr := New(2, 3, nil)
+		require.True(t, tr.Accepts("US"))
+		tr.Add("US")
+		// A second miner in the same country still leaves room
+		// for one in a new country.
+		require.True(t, tr.Accepts("US"))
+		tr.Add("US")
+		require.False(t, tr.Accepts("US"))
+		require.False(t, tr.Accepts(""))
+		require.True(t, tr.Accepts("CN"))
+		tr.Add("CN")
+		require.True(t, tr.Satisfied())
+	})
+	t.Run("ReplicaCountries", func(t *testing.T) {
+		t.Parallel()
+		tr := New(3, 1, []string{"US", "CN", ""})
+		require.False(t, tr.Accepts("US"))
+		require.False(t, tr.Accepts(""))
+		require.False(t, tr.Satisfied())
+		require.True(t, tr.Accepts("DE"))
+		tr.Add("DE")
+		require.True(t, tr.Satisfied())
+	})
+}
@@ -0,0 +1,63 @@
+package quality
+
+import (
+	"fmt"
+
+	"github.com/textileio/powergate/v2/ffs"
+	"github.com/textileio/powergate/v2/index/faults"
+	"github.com/textileio/powergate/v2/index/miner"
+)
+
+// RecentFaultsWindow is the number of epochs before the current height
+// where miner faults are considered recent.
+const RecentFaultsWindow = faults.RecentFaultsWindow
+
+// Enabled returns true if any miner quality filter is set in f.
+func Enabled(f ffs.MinerSelectorFilter) bool {
+	return f.MinRelativePower > 0 || f.MaxFaults != nil || f.MaxFaultyRatio > 0 || f.SectorSize > 0
+}
+
+// RecentFaults returns the number of fault epochs which are inside
+// the recent faults window considering the current height.
+func RecentFaults(epochs []int64, height int64) int {
+	var res int
+	for _, e := range epochs {
+		if e > height-RecentFaultsWindow {
+			res++
+		}
+	}
+	return res
+}
+
+// Check returns a non-nil error if a miner doesn't satisfy the quality
+// filters of f. The known parameter indicates if m was found in the
+// miner index; unknown miners are rejected if an on-chain data filter
+// is set.
+func Check(f ffs.MinerSelectorFilter, m miner.OnChainMinerData, known bool, recentFaults int) error {
+	if !known && (f.MinRelativePower > 0 || f.MaxFaultyRatio > 0 || f.SectorSize > 0) {
+		return fmt.Errorf("miner on-chain data is unknown")
+	}
+	if f.MinRelativePower > 0 && m.RelativePower < f.MinRelativePower {
+		return fmt.Errorf("relative power is below minimum: %f<%f", m.RelativePower, f.MinRelativePower)
+	}
+	if f.MaxFaults != nil && recentFaults > *f.MaxFaults {
+		return fmt.Errorf("recent faults are above maximum: %d>%d", recentFaults, *f.MaxFaults)
+	}
+	if f.MaxFaultyRatio > 0 && faultyRatio(m) > f.MaxFaultyRatio {
+		return fmt.Errorf("faulty sectors ratio is above maximum: %f>%f", faultyRatio(m), f.MaxFaultyRatio)
+	}
+	if f.SectorSize > 0 && m.SectorSize != f.SectorSize {
+		return fmt.Errorf("sector size isn't allowed: %d!=%d", m.SectorSize, f.SectorSize)
+	}
+	return nil
+}
+
+func faultyRatio(m miner.OnChainMinerData) float64 {
+	if m.SectorsLive == 0 {
+		if m.SectorsFaulty > 0 {
+			return 1
+		}
+		return 0
+	}
+	return float64(m.SectorsFaulty) / float64(m.SectorsLive)
+}
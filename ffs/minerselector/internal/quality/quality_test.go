@@ -0,0 +1,64 @@
+package quality
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/textileio/powergate/v2/ffs"
+	"github.com/textileio/powergate/v2/index/miner"
+)
+
+func TestRecentFaults(t *testing.T) {
+	t.Parallel()
+	height := int64(RecentFaultsWindow * 2)
+	epochs := []int64{1, height - RecentFaultsWindow, height - RecentFaultsWindow + 1, height}
+	require.Equal(t, 2, RecentFaults(epochs, height))
+	require.Equal(t, 0, RecentFaults(nil, height))
+}
+
+func TestCheck(t *testing.T) {
+	t.Parallel()
+	m := miner.OnChainMinerData{
+		RelativePower: 0.1,
+		SectorSize:    1 << 35,
+		SectorsLive:   100,
+		SectorsFaulty: 10,
+	}
+	tests := []struct {
+		name         string
+		f            ffs.MinerSelectorFilter
+		known        bool
+		recentFaults int
+		ok           bool
+	}{
+		{name: "NoFilters", known: false, recentFaults: 5, ok: true},
+		{name: "UnknownMiner", f: ffs.MinerSelectorFilter{SectorSize: 1 << 35}, known: false, ok: false},
+		{name: "UnknownMinerFaults", f: ffs.MinerSelectorFilter{MaxFaults: maxFaults(1)}, known: false, ok: true},
+		{name: "MinRelativePower", f: ffs.MinerSelectorFilter{MinRelativePower: 0.1}, known: true, ok: true},
+		{name: "BelowMinRelativePower", f: ffs.MinerSelectorFilter{MinRelativePower: 0.2}, known: true, ok: false},
+		{name: "MaxFaults", f: ffs.MinerSelectorFilter{MaxFaults: maxFaults(2)}, known: true, recentFaults: 2, ok: true},
+		{name: "AboveMaxFaults", f: ffs.MinerSelectorFilter{MaxFaults: maxFaults(2)}, known: true, recentFaults: 3, ok: false},
+		{name: "NoFaults", f: ffs.MinerSelectorFilter{MaxFaults: maxFaults(0)}, known: true, recentFaults: 0, ok: true},
+		{name: "AboveNoFaults", f: ffs.MinerSelectorFilter{MaxFaults: maxFaults(0)}, known: true, recentFaults: 1, ok: false},
+		{name: "MaxFaultyRatio", f: ffs.MinerSelectorFilter{MaxFaultyRatio: 0.1}, known: true, ok: true},
+		{name: "AboveMaxFaultyRatio", f: ffs.MinerSelectorFilter{MaxFaultyRatio: 0.05}, known: true, ok: false},
+		{name: "SectorSize", f: ffs.MinerSelectorFilter{SectorSize: 1 << 35}, known: true, ok: true},
+		{name: "OtherSectorSize", f: ffs.MinerSelectorFilter{SectorSize: 1 << 36}, known: true, ok: false},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			err := Check(tt.f, m, tt.known, tt.recentFaults)
+			if tt.ok {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
+func maxFaults(n int) *int {
+	return &n
+}
@@ -10,6 +10,8 @@ import (
 	"github.com/filecoin-project/lotus/chain/types"
 	logger "github.com/ipfs/go-log/v2"
 	"github.com/textileio/powergate/v2/ffs"
+	"github.com/textileio/powergate/v2/ffs/minerselector/internal/diversity"
+	"github.com/textileio/powergate/v2/ffs/minerselector/internal/quality"
 	askRunner "github.com/textileio/powergate/v2/index/ask/runner"
 	"github.com/textileio/powergate/v2/lotus"
 	"github.com/textileio/powergate/v2/reputation"
@@ -50,10 +52,27 @@ func (rt *RepTop) GetMiners(n int, f ffs.MinerSelectorFilter) ([]ffs.MinerPropos
 	// This is done to circumvent index building restrictions such as excluding miners
 	// with zero power. Trusted miners are trusted by definition, so if they have zero
 	// power, that's a risk that the client is accepting.
-	trustedMiners := rt.genTrustedMiners(f, n)
+	// Both lists share the tracking of selected miners countries, to
+	// satisfy the minimum number of distinct countries if required.
+	replicaCountries := make([]string, len(f.ReplicaMiners))
+	for i, m := range f.ReplicaMiners {
+		replicaCountries[i] = rt.rm.MinerCountry(m)
+	}
+	dt := diversity.New(f.MinCountries, n, replicaCountries)
+
+	// Miner quality filters need the current height to count recent faults.
+	var height int64
+	if quality.Enabled(f) {
+		h, err := rt.getHeight()
+		if err != nil {
+			return nil, fmt.Errorf("getting current height: %s", err)
+		}
+		height = h
+	}
+	trustedMiners := rt.genTrustedMiners(f, n, dt, height)
 
 	// The remaining needed miners are gathered from the reputation index.
-	reputationMiners, err := rt.genFromReputation(f, n-len(trustedMiners))
+	reputationMiners, err := rt.genFromReputation(f, n-len(trustedMiners), dt, height)
 	if err != nil {
 		return nil, fmt.Errorf("getting miners from reputation module: %s", err)
 	}
@@ -62,14 +81,23 @@ func (rt *RepTop) GetMiners(n int, f ffs.MinerSelectorFilter) ([]ffs.MinerPropos
 	return append(trustedMiners, reputationMiners...), nil
 }
 
-func (rt *RepTop) genTrustedMiners(f ffs.MinerSelectorFilter, n int) []ffs.MinerProposal {
+func (rt *RepTop) genTrustedMiners(f ffs.MinerSelectorFilter, n int, dt *diversity.Tracker, height int64) []ffs.MinerProposal {
 	ret := make([]ffs.MinerProposal, 0, len(f.TrustedMiners))
 	for _, m := range f.TrustedMiners {
+		country := rt.rm.MinerCountry(m)
+		if !dt.Accepts(country) {
+			continue
+		}
+		if err := rt.checkQuality(f, m, height); err != nil {
+			log.Warnf("trusted miner %s quality: %s", m, err)
+			continue
+		}
 		mp, err := rt.getMinerProposal(f, m)
 		if err != nil {
 			log.Warnf("trusted miner %s query asking: %s", m, err)
 			continue
 		}
+		dt.Add(country)
 		ret = append(ret, mp)
 		if len(ret) == n {
 			break
@@ -79,7 +107,7 @@ func (rt *RepTop) genTrustedMiners(f ffs.MinerSelectorFilter, n int) []ffs.Miner
 	return ret
 }
 
-func (rt *RepTop) genFromReputation(f ffs.MinerSelectorFilter, n int) ([]ffs.MinerProposal, error) {
+func (rt *RepTop) genFromReputation(f ffs.MinerSelectorFilter, n int, dt *diversity.Tracker, height int64) ([]ffs.MinerProposal, error) {
 	if n == 0 {
 		return nil, nil
 	}
@@ -95,6 +123,13 @@ func (rt *RepTop) genFromReputation(f ffs.MinerSelectorFilter, n int) ([]ffs.Min
 	maxMinerErrors := 5
 	res := make([]ffs.MinerProposal, 0, n)
 	for _, m := range ms {
+		country := rt.rm.MinerCountry(m.Addr)
+		if !dt.Accepts(country) {
+			continue
+		}
+		if err := rt.checkQuality(f, m.Addr, height); err != nil {
+			continue // Miner doesn't satisfy quality filters, skip.
+		}
 		mp, err := rt.getMinerProposal(f, m.Addr)
 		if err != nil {
 			if len(minerErrors) < maxMinerErrors {
@@ -104,17 +139,44 @@ func (rt *RepTop) genFromReputation(f ffs.MinerSelectorFilter, n int) ([]ffs.Min
 			}
 			continue // Cached miner isn't replying to query-ask now, skip.
 		}
+		dt.Add(country)
 		res = append(res, mp)
 		if len(res) == n {
 			break
 		}
 	}
 	if len(res) < n {
+		if f.MinCountries > 0 {
+			return nil, fmt.Errorf("not enough miners in %d distinct countries satisfy the miner selector constraints: %s", f.MinCountries, minerErrors)
+		}
 		return nil, fmt.Errorf("not enough miners satisfy the miner selector constraints: %s", minerErrors)
 	}
 	return res, nil
 }
 
+func (rt *RepTop) checkQuality(f ffs.MinerSelectorFilter, addr string, height int64) error {
+	if !quality.Enabled(f) {
+		return nil
+	}
+	d, faults, ok := rt.rm.MinerOnChainData(addr)
+	return quality.Check(f, d, ok, quality.RecentFaults(faults, height))
+}
+
+func (rt *RepTop) getHeight() (int64, error) {
+	c, cls, err := rt.cb(context.Background())
+	if err != nil {
+		return 0, fmt.Errorf("creating lotus client: %s", err)
+	}
+	defer cls()
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*10)
+	defer cancel()
+	ts, err := c.ChainHead(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("getting chain head: %s", err)
+	}
+	return int64(ts.Height()), nil
+}
+
 func (rt *RepTop) getMinerProposal(f ffs.MinerSelectorFilter, addrStr string) (ffs.MinerProposal, error) {
 	c, cls, err := rt.cb(context.Background())
 	if err != nil {
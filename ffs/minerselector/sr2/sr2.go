@@ -15,6 +15,10 @@ import (
 	"github.com/filecoin-project/lotus/chain/types"
 	logger "github.com/ipfs/go-log/v2"
 	"github.com/textileio/powergate/v2/ffs"
+	"github.com/textileio/powergate/v2/ffs/minerselector/internal/diversity"
+	"github.com/textileio/powergate/v2/ffs/minerselector/internal/quality"
+	"github.com/textileio/powergate/v2/index/faults"
+	"github.com/textileio/powergate/v2/index/miner"
 	"github.com/textileio/powergate/v2/lotus"
 )
 
@@ -30,6 +34,8 @@ var (
 type MinerSelector struct {
 	url string
 	cb  lotus.ClientBuilder
+	mi  miner.Module
+	fi  faults.Module
 }
 
 var _ ffs.MinerSelector = (*MinerSelector)(nil)
@@ -43,9 +49,10 @@ type bucket struct {
 	MinerAddresses []string
 }
 
-// New returns a new SR2 miner selector.
-func New(url string, cb lotus.ClientBuilder) (*MinerSelector, error) {
-	ms := &MinerSelector{url: url, cb: cb}
+// New returns a new SR2 miner selector. The miner and faults indexes are
+// used to apply miner quality filters.
+func New(url string, cb lotus.ClientBuilder, mi miner.Module, fi faults.Module) (*MinerSelector, error) {
+	ms := &MinerSelector{url: url, cb: cb, mi: mi, fi: fi}
 
 	_, err := ms.getMiners()
 	if err != nil {
@@ -68,6 +75,42 @@ func (ms *MinerSelector) GetMiners(n int, f ffs.MinerSelectorFilter) ([]ffs.Mine
 	}
 	defer cls()
 
+	if (quality.Enabled(f) || f.MinCountries > 0) && ms.mi == nil {
+		return nil, fmt.Errorf("miner quality and country filters need the miner index")
+	}
+
+	// Miner quality filters need the current height to count recent faults.
+	var height int64
+	var mIndex miner.IndexSnapshot
+	var fIndex faults.IndexSnapshot
+	if quality.Enabled(f) {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second*10)
+		defer cancel()
+		ts, err := c.ChainHead(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("getting chain head: %s", err)
+		}
+		height = int64(ts.Height())
+		fIndex = ms.fi.Get()
+	}
+	if ms.mi != nil {
+		mIndex = ms.mi.Get()
+	}
+
+	// Selected miners, together with replica miners, should be located in
+	// at least f.MinCountries distinct countries.
+	var total int
+	for _, bucket := range mb.Buckets {
+		if bucket.Amount > 0 {
+			total += bucket.Amount
+		}
+	}
+	replicaCountries := make([]string, len(f.ReplicaMiners))
+	for i, m := range f.ReplicaMiners {
+		replicaCountries[i] = mIndex.Meta.Info[m].Location.Country
+	}
+	dt := diversity.New(f.MinCountries, total, replicaCountries)
+
 	rand.Seed(time.Now().UnixNano())
 	var selected []ffs.MinerProposal
 	for _, bucket := range mb.Buckets {
@@ -83,6 +126,18 @@ func (ms *MinerSelector) GetMiners(n int, f ffs.MinerSelectorFilter) ([]ffs.Mine
 		}
 		var regionSelected int
 		for i := 0; regionSelected < bucket.Amount && i < len(miners); i++ {
+			country := mIndex.Meta.Info[miners[i]].Location.Country
+			if !dt.Accepts(country) {
+				continue
+			}
+			if quality.Enabled(f) {
+				d, ok := mIndex.OnChain.Miners[miners[i]]
+				recentFaults := quality.RecentFaults(fIndex.Miners[miners[i]].Epochs, height)
+				if err := quality.Check(f, d, ok, recentFaults); err != nil {
+					log.Warnf("skipping miner %s since doesn't satisfy quality filters: %s", miners[i], err)
+					continue
+				}
+			}
 			sask, err := getMinerQueryAsk(c, miners[i])
 			if err != nil {
 				log.Warnf("sr2 miner %s query-ask errored: %s", miners[i], err)
@@ -103,6 +158,7 @@ func (ms *MinerSelector) GetMiners(n int, f ffs.MinerSelectorFilter) ([]ffs.Mine
 			if f.PieceSize < uint64(sask.MinPieceSize) || f.PieceSize > uint64(sask.MaxPieceSize) {
 				log.Warnf("skipping miner %s since needed piece size %d doesn't fit bounds (%d, %d)", miners[i], f.PieceSize, sask.MinPieceSize, sask.MaxPieceSize)
 			}
+			dt.Add(country)
 			selected = append(selected, ffs.MinerProposal{
 				Addr:       miners[i],
 				EpochPrice: sask.Price.Uint64(),
@@ -114,6 +170,9 @@ func (ms *MinerSelector) GetMiners(n int, f ffs.MinerSelectorFilter) ([]ffs.Mine
 	if len(selected) == 0 {
 		return nil, fmt.Errorf("no SR2 miners are available")
 	}
+	if !dt.Satisfied() {
+		return nil, fmt.Errorf("not enough SR2 miners in %d distinct countries are available", f.MinCountries)
+	}
 
 	return selected, nil
 }
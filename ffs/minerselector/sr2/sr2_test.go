@@ -24,7 +24,7 @@ func TestMS(t *testing.T) {
 	require.NoError(t, err)
 
 	url := "https://raw.githubusercontent.com/filecoin-project/slingshot/master/miners.json"
-	sr2, err := New(url, cb)
+	sr2, err := New(url, cb, nil, nil)
 	require.NoError(t, err)
 
 	for {
@@ -0,0 +1,96 @@
+package aggstore
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/ipfs/go-cid"
+	"github.com/ipfs/go-datastore"
+	"github.com/ipfs/go-datastore/query"
+	"github.com/textileio/powergate/v2/ffs"
+	"github.com/textileio/powergate/v2/util"
+)
+
+var (
+	dsBaseRef = datastore.NewKey("ref")
+)
+
+// Store persists the deals of aggregates, which are shared by
+// all the data stored in them, and the data referencing them.
+type Store struct {
+	ds datastore.Datastore
+}
+
+// New returns a new Store backed by the Datastore.
+func New(ds datastore.Datastore) *Store {
+	return &Store{
+		ds: ds,
+	}
+}
+
+// Get returns the deals of an aggregate. If no deals were saved
+// for the aggregate, it returns an empty result.
+func (s *Store) Get(root cid.Cid) ([]ffs.FilStorage, error) {
+	buf, err := s.ds.Get(makeKey(root))
+	if err == datastore.ErrNotFound {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("getting aggregate deals from datastore: %s", err)
+	}
+	var res []ffs.FilStorage
+	if err := json.Unmarshal(buf, &res); err != nil {
+		return nil, fmt.Errorf("unmarshaling aggregate deals from datastore: %s", err)
+	}
+	return res, nil
+}
+
+// Put saves the deals of an aggregate.
+func (s *Store) Put(root cid.Cid, deals []ffs.FilStorage) error {
+	buf, err := json.Marshal(deals)
+	if err != nil {
+		return fmt.Errorf("marshaling aggregate deals for datastore: %s", err)
+	}
+	if err := s.ds.Put(makeKey(root), buf); err != nil {
+		return fmt.Errorf("put aggregate deals in datastore: %s", err)
+	}
+	return nil
+}
+
+// AddRef saves that data of an APIID is stored in an aggregate.
+func (s *Store) AddRef(root cid.Cid, iid ffs.APIID, c cid.Cid) error {
+	if err := s.ds.Put(makeRefKey(root, iid, c), []byte{}); err != nil {
+		return fmt.Errorf("put aggregate reference in datastore: %s", err)
+	}
+	return nil
+}
+
+// RemoveRef removes the reference of data of an APIID to an aggregate.
+// It returns the number of remaining references to the aggregate.
+func (s *Store) RemoveRef(root cid.Cid, iid ffs.APIID, c cid.Cid) (int, error) {
+	if err := s.ds.Delete(makeRefKey(root, iid, c)); err != nil {
+		return 0, fmt.Errorf("deleting aggregate reference from datastore: %s", err)
+	}
+	q := query.Query{Prefix: dsBaseRef.ChildString(util.CidToString(root)).String(), KeysOnly: true}
+	res, err := s.ds.Query(q)
+	if err != nil {
+		return 0, fmt.Errorf("querying aggregate references: %s", err)
+	}
+	defer func() { _ = res.Close() }()
+	var count int
+	for r := range res.Next() {
+		if r.Error != nil {
+			return 0, fmt.Errorf("iter next: %s", r.Error)
+		}
+		count++
+	}
+	return count, nil
+}
+
+func makeRefKey(root cid.Cid, iid ffs.APIID, c cid.Cid) datastore.Key {
+	return dsBaseRef.ChildString(util.CidToString(root)).ChildString(iid.String()).ChildString(util.CidToString(c))
+}
+
+func makeKey(root cid.Cid) datastore.Key {
+	return datastore.NewKey(util.CidToString(root))
+}
@@ -38,6 +38,9 @@ type RetrievalAction struct {
 	Miners        []string
 	WalletAddress string
 	MaxPrice      uint64
+	// CacheTTL indicates for how many seconds the retrieved data
+	// is cached in hot storage. If zero, the data is pinned.
+	CacheTTL int
 }
 
 // Store persists Actions.
@@ -80,6 +83,14 @@ func (s *Store) PutStorageAction(jid ffs.JobID, a StorageAction) error {
 	return nil
 }
 
+// DeleteStorageAction deletes the Action of a Job, if exists.
+func (s *Store) DeleteStorageAction(jid ffs.JobID) error {
+	if err := s.ds.Delete(makeStorageActionKey(jid)); err != nil {
+		return fmt.Errorf("deleting from datastore: %s", err)
+	}
+	return nil
+}
+
 // GetRetrievalAction returns a the RetrievalAction corresponding to the
 // RetrievalJob id.
 func (s *Store) GetRetrievalAction(jid ffs.JobID) (RetrievalAction, error) {
@@ -57,6 +57,15 @@ func (s *Store) Put(ri ffs.RetrievalInfo) error {
 	return nil
 }
 
+// Delete deletes information about a retrieval. Deleting
+// unexisting information is a noop.
+func (s *Store) Delete(rid ffs.RetrievalID) error {
+	if err := s.ds.Delete(makeKey(rid)); err != nil {
+		return fmt.Errorf("deleting retrieval info from datastore: %s", err)
+	}
+	return nil
+}
+
 func makeKey(rid ffs.RetrievalID) datastore.Key {
 	return datastore.NewKey(rid.String())
 }
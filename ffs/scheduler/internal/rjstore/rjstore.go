@@ -112,6 +112,27 @@ func (s *Store) Enqueue(j ffs.RetrievalJob) error {
 	return nil
 }
 
+// CancelQueued cancels a retrieval job if it's in Queued status.
+// If the job isn't Queued, the call is a noop. If the job doesn't
+// exist it returns ErrNotFound.
+func (s *Store) CancelQueued(jid ffs.JobID) (bool, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	j, err := s.get(jid)
+	if err != nil {
+		return false, err
+	}
+	if j.Status != ffs.Queued {
+		return false, nil
+	}
+	j.Status = ffs.Canceled
+	if err := s.put(j); err != nil {
+		return false, fmt.Errorf("canceling queued job: %s", err)
+	}
+	return true, nil
+}
+
 // Get returns the current state of a retrieval job.
 //If doesn't exist, returns ErrNotFound.
 func (s *Store) Get(jid ffs.JobID) (ffs.RetrievalJob, error) {
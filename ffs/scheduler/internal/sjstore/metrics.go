@@ -12,6 +12,7 @@ var (
 	attrStatusSuccess   = attribute.Key("jobstatus").String("success")
 	attrStatusCanceled  = attribute.Key("jobstatus").String("canceled")
 	attrStatusFailed    = attribute.Key("jobstatus").String("failed")
+	attrStatusScheduled = attribute.Key("jobstatus").String("scheduled")
 )
 
 func (s *Store) initMetrics() {
@@ -9,6 +9,7 @@ import (
 	"strconv"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/ipfs/go-cid"
 	"github.com/ipfs/go-datastore"
@@ -29,6 +30,7 @@ Might be useful in a future migration or datastore maintenance.
 /apiid/<api-id>/<cid>/<timestamp>: Index on api-id primarily, cid secondarily, with timestamp, values of job-id
 /cid/<cid>/<api-id>/<timestamp>: Index on cid primarily, api-id secondarily, with timestamp, values of job-id
 /starteddeals_v2/<instance-id>/<cid>: Stores StartedDeals data by instance-id and cid
+/fairshare/<api-id>: Stores FairShare configuration by api-id
 */
 
 var (
@@ -37,10 +39,15 @@ var (
 	// ErrNotFound indicates the instance doesn't exist.
 	ErrNotFound = errors.New("job not found")
 
+	// pruneBatchSize is the maximum number of Jobs deleted while
+	// holding the store lock when pruning.
+	pruneBatchSize = 100
+
 	dsBaseJob          = datastore.NewKey("job")
 	dsBaseAPIID        = datastore.NewKey("apiid")
 	dsBaseCid          = datastore.NewKey("cid")
 	dsBaseStartedDeals = datastore.NewKey("starteddeals_v2")
+	dsBaseFairShare    = datastore.NewKey("fairshare")
 )
 
 // Store is a Datastore implementation of JobStore, which saves
@@ -50,7 +57,10 @@ type Store struct {
 	ds       datastore.TxnDatastore
 	watchers []watcher
 
-	queued []ffs.StorageJob
+	// queued contains Queued jobs by APIID, sorted by priority and
+	// then by creation order.
+	queued      map[ffs.APIID][]ffs.StorageJob
+	totalQueued int
 
 	jobStatusCache map[ffs.APIID]map[cid.Cid]map[cid.Cid]deals.StorageDealInfo
 
@@ -62,6 +72,14 @@ type Store struct {
 	queuedIDs    map[ffs.JobID]struct{}
 	executingIDs map[ffs.JobID]struct{}
 
+	scheduledJobs map[ffs.JobID]*ffs.StorageJob
+
+	fairShares   map[ffs.APIID]ffs.FairShare
+	dequeues     uint64
+	lastDequeued map[ffs.APIID]uint64
+
+	statusChanged func(ffs.StorageJob)
+
 	// Metrics
 	metricJobCounter metric.Int64UpDownCounter
 }
@@ -70,6 +88,18 @@ type Store struct {
 type Stats struct {
 	TotalQueued    int
 	TotalExecuting int
+	TotalScheduled int
+}
+
+// RetentionPolicy configures which final Jobs are deleted when pruning.
+// The last successful Job of each APIID and Cid, and non-final Jobs,
+// are never deleted.
+type RetentionPolicy struct {
+	// MaxAge is the maximum age of final Jobs. Zero means no limit.
+	MaxAge time.Duration
+	// MaxPerCid is the maximum number of final Jobs kept for
+	// each APIID and Cid. Zero means no limit.
+	MaxPerCid int
 }
 
 type watcher struct {
@@ -81,6 +111,7 @@ type watcher struct {
 func New(ds datastore.TxnDatastore) (*Store, error) {
 	s := &Store{
 		ds:                 ds,
+		queued:             make(map[ffs.APIID][]ffs.StorageJob),
 		jobStatusCache:     make(map[ffs.APIID]map[cid.Cid]map[cid.Cid]deals.StorageDealInfo),
 		queuedJobs:         make(map[ffs.APIID]map[cid.Cid][]*ffs.StorageJob),
 		executingJobs:      make(map[ffs.APIID]map[cid.Cid]*ffs.StorageJob),
@@ -88,6 +119,9 @@ func New(ds datastore.TxnDatastore) (*Store, error) {
 		lastSuccessfulJobs: make(map[ffs.APIID]map[cid.Cid]*ffs.StorageJob),
 		queuedIDs:          make(map[ffs.JobID]struct{}),
 		executingIDs:       make(map[ffs.JobID]struct{}),
+		scheduledJobs:      make(map[ffs.JobID]*ffs.StorageJob),
+		fairShares:         make(map[ffs.APIID]ffs.FairShare),
+		lastDequeued:       make(map[ffs.APIID]uint64),
 	}
 	s.initMetrics()
 	if err := s.loadCaches(); err != nil {
@@ -97,6 +131,15 @@ func New(ds datastore.TxnDatastore) (*Store, error) {
 	return s, nil
 }
 
+// OnStatusChange sets a function which is called every time a Job is
+// created or changes its status. It's called while holding the store
+// lock, so it shouldn't block nor call the store.
+func (s *Store) OnStatusChange(f func(ffs.StorageJob)) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.statusChanged = f
+}
+
 // MonitorJob returns a channel that can be passed into the deal monitoring process.
 func (s *Store) MonitorJob(j ffs.StorageJob) chan deals.StorageDealInfo {
 	dealUpdates := make(chan deals.StorageDealInfo, 1000)
@@ -173,43 +216,148 @@ func (s *Store) Finalize(jid ffs.JobID, st ffs.JobStatus, jobError error, dealEr
 }
 
 // Dequeue dequeues a Job which doesn't have have another Executing Job
-// for the same Cid. Saying it differently, it's safe to execute. The returned
-// job Status is automatically changed to Executing. If an instance id is provided,
-// only a job for that instance id will be dequeued. If no jobs are available to dequeue
-// it returns a nil *ffs.Job and no-error.
+// for the same Cid. Saying it differently, it's safe to execute. Jobs with
+// higher priority are dequeued first. Between Jobs with the same priority,
+// the Job is picked from the APIID with the fewest Executing Jobs relative to
+// its FairShare weight, breaking ties in round-robin order between APIIDs and
+// then by creation order. APIIDs that reached their FairShare MaxExecuting
+// limit are skipped. The returned job Status is automatically changed
+// to Executing. If an instance id is provided, only a job for that instance id
+// will be dequeued. If no jobs are available to dequeue it returns a nil *ffs.Job
+// and no-error.
 func (s *Store) Dequeue(iid ffs.APIID) (*ffs.StorageJob, error) {
 	s.lock.Lock()
 	defer s.lock.Unlock()
 
-	for _, job := range s.queued {
-		execJob, ok := s.executingJobs[job.APIID][job.Cid]
-		isAPIIDMatch := true
-		if iid != ffs.EmptyInstanceID {
-			isAPIIDMatch = iid == job.APIID
+	var selected *ffs.StorageJob
+	for apiID, jobs := range s.queued {
+		if iid != ffs.EmptyInstanceID && iid != apiID {
+			continue
 		}
-		if job.Status == ffs.Queued && !ok && isAPIIDMatch {
-			job.Status = ffs.Executing
-			if err := s.put(job, false); err != nil {
-				return nil, err
+		if !s.hasFreeSlot(apiID) {
+			continue
+		}
+		// Only the first executable job of each APIID competes
+		// for the slot.
+		for i := range jobs {
+			job := &jobs[i]
+			// jobs are sorted by priority, so if we already have a
+			// candidate, any following lower priority job can't win.
+			if selected != nil && job.Priority < selected.Priority {
+				break
+			}
+			if execJob, ok := s.executingJobs[job.APIID][job.Cid]; ok {
+				// ToDo: Maybe remove this since there might be lots of reasons we skip over a job.
+				log.Infof("queued %s is delayed since job %s is running", job.ID, execJob.ID)
+				continue
+			}
+			if selected == nil || s.preferred(*job, *selected) {
+				selected = job
 			}
+			break
+		}
+	}
+	if selected == nil {
+		return nil, nil
+	}
+
+	job := *selected
+	job.Status = ffs.Executing
+	if err := s.put(job, false); err != nil {
+		return nil, err
+	}
+	s.dequeues++
+	s.lastDequeued[job.APIID] = s.dequeues
 
-			ctx := context.Background()
-			s.metricJobCounter.Add(ctx, -1, attrStatusQueued)
-			s.metricJobCounter.Add(ctx, 1, attrStatusExecuting)
+	ctx := context.Background()
+	s.metricJobCounter.Add(ctx, -1, attrStatusQueued)
+	s.metricJobCounter.Add(ctx, 1, attrStatusExecuting)
 
-			return &job, nil
-		}
-		if ok {
-			// ToDo: Maybe remove this since there might be lots of reasons we skip over a job.
-			// For example, if the specified iid doesn't match, but that is not worth logging.
-			log.Infof("queued %s is delayed since job %s is running", job.ID, execJob.ID)
-		}
+	return &job, nil
+}
+
+// SetFairShare sets the FairShare configuration of an APIID.
+func (s *Store) SetFairShare(iid ffs.APIID, fs ffs.FairShare) error {
+	if err := fs.Validate(); err != nil {
+		return fmt.Errorf("invalid fair share: %s", err)
+	}
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	buf, err := json.Marshal(fs)
+	if err != nil {
+		return fmt.Errorf("marshaling fair share: %s", err)
+	}
+	if err := s.ds.Put(makeFairShareKey(iid), buf); err != nil {
+		return fmt.Errorf("saving fair share to datastore: %s", err)
+	}
+	s.fairShares[iid] = fs
+	return nil
+}
+
+// RemoveFairShare removes the FairShare configuration of an APIID, so it
+// gets the default share of execution slots. If the APIID doesn't have a
+// FairShare configured, the call is a noop.
+func (s *Store) RemoveFairShare(iid ffs.APIID) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if err := s.ds.Delete(makeFairShareKey(iid)); err != nil {
+		return fmt.Errorf("deleting fair share from datastore: %s", err)
+	}
+	delete(s.fairShares, iid)
+	return nil
+}
+
+// GetFairShares returns all configured FairShares by APIID.
+func (s *Store) GetFairShares() map[ffs.APIID]ffs.FairShare {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	res := make(map[ffs.APIID]ffs.FairShare, len(s.fairShares))
+	for iid, fs := range s.fairShares {
+		res[iid] = fs
+	}
+	return res
+}
+
+func (s *Store) hasFreeSlot(iid ffs.APIID) bool {
+	fs, ok := s.fairShares[iid]
+	if !ok || fs.MaxExecuting == 0 {
+		return true
+	}
+	return len(s.executingJobs[iid]) < fs.MaxExecuting
+}
+
+// preferred returns true if the queued job a should be dequeued before b,
+// considering their priorities and how served their APIIDs are.
+func (s *Store) preferred(a, b ffs.StorageJob) bool {
+	if a.Priority != b.Priority {
+		return a.Priority > b.Priority
+	}
+	if a.APIID == b.APIID {
+		return a.CreatedAt < b.CreatedAt
+	}
+	loadA := len(s.executingJobs[a.APIID]) * s.weight(b.APIID)
+	loadB := len(s.executingJobs[b.APIID]) * s.weight(a.APIID)
+	if loadA != loadB {
+		return loadA < loadB
+	}
+	if s.lastDequeued[a.APIID] != s.lastDequeued[b.APIID] {
+		return s.lastDequeued[a.APIID] < s.lastDequeued[b.APIID]
+	}
+	return a.CreatedAt < b.CreatedAt
+}
+
+func (s *Store) weight(iid ffs.APIID) int {
+	if fs, ok := s.fairShares[iid]; ok {
+		return fs.Weight
 	}
-	return nil, nil
+	return 1
 }
 
-// Enqueue queues a new Job. If other Job for the same Cid is in Queued status,
-// it will be automatically marked as Canceled.
+// Enqueue queues a new Job. If other Job for the same Cid is in Queued or
+// Scheduled status, it will be automatically marked as Canceled.
 func (s *Store) Enqueue(j ffs.StorageJob) error {
 	s.lock.Lock()
 	defer s.lock.Unlock()
@@ -225,6 +373,54 @@ func (s *Store) Enqueue(j ffs.StorageJob) error {
 	return nil
 }
 
+// Schedule saves a new Job in Scheduled status, which will be Queued
+// by QueueDue once its NotBefore time is reached. If other Job for the
+// same Cid is in Queued or Scheduled status, it will be automatically
+// marked as Canceled.
+func (s *Store) Schedule(j ffs.StorageJob) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if err := s.cancelQueued(j.Cid); err != nil {
+		return fmt.Errorf("canceling queued jobs: %s", err)
+	}
+	j.Status = ffs.Scheduled
+	if err := s.put(j, true); err != nil {
+		return fmt.Errorf("saving to datastore: %s", err)
+	}
+	s.metricJobCounter.Add(context.Background(), 1, attrStatusScheduled)
+
+	return nil
+}
+
+// QueueDue moves to Queued status all Scheduled Jobs with a NotBefore
+// time before or equal to now. It returns the number of Jobs that
+// were Queued.
+func (s *Store) QueueDue(now time.Time) (int, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	var due []ffs.StorageJob
+	for _, j := range s.scheduledJobs {
+		if j.NotBefore <= now.Unix() {
+			due = append(due, *j)
+		}
+	}
+	sort.Slice(due, func(a, b int) bool {
+		return due[a].CreatedAt < due[b].CreatedAt
+	})
+
+	ctx := context.Background()
+	for _, j := range due {
+		j.Status = ffs.Queued
+		if err := s.put(j, false); err != nil {
+			return 0, fmt.Errorf("queueing scheduled job: %s", err)
+		}
+		s.metricJobCounter.Add(ctx, -1, attrStatusScheduled)
+		s.metricJobCounter.Add(ctx, 1, attrStatusQueued)
+	}
+	return len(due), nil
+}
+
 // GetExecutingJob returns a JobID that is currently executing for
 // data with cid c in iid. If there's not such job, it returns nil.
 func (s *Store) GetExecutingJob(iid ffs.APIID, c cid.Cid) *ffs.JobID {
@@ -251,8 +447,9 @@ func (s *Store) getStats() Stats {
 		count += len(iidJobs)
 	}
 	return Stats{
-		TotalQueued:    len(s.queued),
+		TotalQueued:    s.totalQueued,
 		TotalExecuting: count,
+		TotalScheduled: len(s.scheduledJobs),
 	}
 }
 
@@ -269,8 +466,59 @@ func (s *Store) GetExecutingJobIDs() []ffs.JobID {
 	return res
 }
 
-// CancelQueued cancels a job if it's in Queued status.
-// If the Job isn't Queued, the call is a noop. If the Job
+// Requeue moves an Executing Job back to Queued status, so it's executed
+// again, e.g: if its execution was interrupted. If another Job for the same
+// Cid was Queued or Scheduled meanwhile, it supersedes the interrupted Job,
+// which is marked as Canceled in the same way as Enqueue does.
+func (s *Store) Requeue(jid ffs.JobID) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	j, err := s.get(jid)
+	if err != nil {
+		return err
+	}
+	if j.Status != ffs.Executing {
+		return fmt.Errorf("can't requeue job with status %s", ffs.JobStatusStr[j.Status])
+	}
+	ctx := context.Background()
+	if s.superseded(j) {
+		j.Status = ffs.Canceled
+		if err := s.put(j, false); err != nil {
+			return fmt.Errorf("saving to datastore: %s", err)
+		}
+		s.metricJobCounter.Add(ctx, -1, attrStatusExecuting)
+		s.metricJobCounter.Add(ctx, 1, attrStatusCanceled)
+		return nil
+	}
+	j.Status = ffs.Queued
+	if err := s.put(j, false); err != nil {
+		return fmt.Errorf("saving to datastore: %s", err)
+	}
+	s.metricJobCounter.Add(ctx, -1, attrStatusExecuting)
+	s.metricJobCounter.Add(ctx, 1, attrStatusQueued)
+	return nil
+}
+
+// superseded returns true if other Job for the same Cid is Queued or Scheduled.
+func (s *Store) superseded(j ffs.StorageJob) bool {
+	for _, jobs := range s.queuedJobs {
+		for _, qj := range jobs[j.Cid] {
+			if qj.ID != j.ID {
+				return true
+			}
+		}
+	}
+	for _, sj := range s.scheduledJobs {
+		if sj.Cid == j.Cid && sj.ID != j.ID {
+			return true
+		}
+	}
+	return false
+}
+
+// CancelQueued cancels a job if it's in Queued or Scheduled status.
+// If the Job isn't Queued or Scheduled, the call is a noop. If the Job
 // doesn't exist it returns ErrNotFound.
 func (s *Store) CancelQueued(jid ffs.JobID) (bool, error) {
 	s.lock.Lock()
@@ -280,17 +528,42 @@ func (s *Store) CancelQueued(jid ffs.JobID) (bool, error) {
 	if err != nil {
 		return false, err
 	}
-	if j.Status != ffs.Queued {
+	if j.Status != ffs.Queued && j.Status != ffs.Scheduled {
 		return false, nil
 	}
+	prev := j.Status
 	j.Status = ffs.Canceled
 	if err := s.put(j, false); err != nil {
 		return false, fmt.Errorf("canceling queued job: %s", err)
 	}
+	s.metricCanceled(prev)
 
 	return true, nil
 }
 
+// CancelScheduled cancels all Scheduled jobs for Cid c in iid, e.g: pending
+// retries of a Cid which is untracked. Queued and Executing jobs for the Cid
+// aren't affected.
+func (s *Store) CancelScheduled(iid ffs.APIID, c cid.Cid) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	var scheduled []ffs.StorageJob
+	for _, j := range s.scheduledJobs {
+		if j.APIID == iid && j.Cid == c {
+			scheduled = append(scheduled, *j)
+		}
+	}
+	for _, j := range scheduled {
+		j.Status = ffs.Canceled
+		if err := s.put(j, false); err != nil {
+			return fmt.Errorf("canceling scheduled job: %s", err)
+		}
+		s.metricCanceled(ffs.Scheduled)
+	}
+	return nil
+}
+
 func (s *Store) cancelQueued(c cid.Cid) error {
 	q := query.Query{Prefix: dsBaseJob.String()}
 	res, err := s.ds.Query(q)
@@ -310,16 +583,138 @@ func (s *Store) cancelQueued(c cid.Cid) error {
 		if err := json.Unmarshal(r.Value, &j); err != nil {
 			return fmt.Errorf("unmarshalling job: %s", err)
 		}
-		if j.Status == ffs.Queued && j.Cid == c {
+		if (j.Status == ffs.Queued || j.Status == ffs.Scheduled) && j.Cid == c {
+			prev := j.Status
 			j.Status = ffs.Canceled
 			if err := s.put(j, false); err != nil {
 				return fmt.Errorf("canceling queued job: %s", err)
 			}
+			s.metricCanceled(prev)
 		}
 	}
 	return nil
 }
 
+// metricCanceled moves a Job canceled while in prev status
+// between the status counters, in the same way as QueueDue.
+func (s *Store) metricCanceled(prev ffs.JobStatus) {
+	ctx := context.Background()
+	switch prev {
+	case ffs.Queued:
+		s.metricJobCounter.Add(ctx, -1, attrStatusQueued)
+	case ffs.Scheduled:
+		s.metricJobCounter.Add(ctx, -1, attrStatusScheduled)
+	}
+	s.metricJobCounter.Add(ctx, 1, attrStatusCanceled)
+}
+
+// Prune deletes final Jobs which aren't retained by the RetentionPolicy
+// at the provided time, and returns them. Candidates are collected from
+// a datastore snapshot without holding the store lock, and deleted in
+// batches.
+func (s *Store) Prune(rp RetentionPolicy, now time.Time) ([]ffs.StorageJob, error) {
+	if rp.MaxAge < 0 || rp.MaxPerCid < 0 {
+		return nil, fmt.Errorf("retention policy values can't be negative")
+	}
+	if rp.MaxAge == 0 && rp.MaxPerCid == 0 {
+		return nil, nil
+	}
+
+	candidates, err := s.pruneCandidates(rp, now)
+	if err != nil {
+		return nil, fmt.Errorf("getting prune candidates: %s", err)
+	}
+
+	var pruned []ffs.StorageJob
+	for len(candidates) > 0 {
+		n := pruneBatchSize
+		if n > len(candidates) {
+			n = len(candidates)
+		}
+		deleted, err := s.pruneBatch(candidates[:n])
+		if err != nil {
+			return pruned, fmt.Errorf("pruning batch: %s", err)
+		}
+		pruned = append(pruned, deleted...)
+		candidates = candidates[n:]
+	}
+
+	return pruned, nil
+}
+
+// pruneCandidates returns the final Jobs which aren't retained by the
+// RetentionPolicy, excluding the newest successful Job of each APIID and
+// Cid. Final Jobs don't change, so it doesn't need the store lock.
+func (s *Store) pruneCandidates(rp RetentionPolicy, now time.Time) ([]ffs.StorageJob, error) {
+	type key struct {
+		iid ffs.APIID
+		c   cid.Cid
+	}
+	finalJobs := map[key][]ffs.StorageJob{}
+	q := query.Query{Prefix: dsBaseJob.String()}
+	res, err := s.ds.Query(q)
+	if err != nil {
+		return nil, fmt.Errorf("querying datastore: %s", err)
+	}
+	for r := range res.Next() {
+		if r.Error != nil {
+			_ = res.Close()
+			return nil, fmt.Errorf("iter next: %s", r.Error)
+		}
+		var j ffs.StorageJob
+		if err := json.Unmarshal(r.Value, &j); err != nil {
+			_ = res.Close()
+			return nil, fmt.Errorf("unmarshalling job: %s", err)
+		}
+		if isFinal(j) {
+			k := key{iid: j.APIID, c: j.Cid}
+			finalJobs[k] = append(finalJobs[k], j)
+		}
+	}
+	if err := res.Close(); err != nil {
+		return nil, fmt.Errorf("closing prune query result: %s", err)
+	}
+
+	var candidates []ffs.StorageJob
+	for _, jobs := range finalJobs {
+		sort.Slice(jobs, func(a, b int) bool {
+			return jobs[a].CreatedAt > jobs[b].CreatedAt
+		})
+		keptSuccessful := false
+		for i, j := range jobs {
+			if isSuccessful(j) && !keptSuccessful {
+				keptSuccessful = true
+				continue
+			}
+			tooMany := rp.MaxPerCid > 0 && i >= rp.MaxPerCid
+			tooOld := rp.MaxAge > 0 && now.Sub(time.Unix(j.CreatedAt, 0)) > rp.MaxAge
+			if tooMany || tooOld {
+				candidates = append(candidates, j)
+			}
+		}
+	}
+	return candidates, nil
+}
+
+// pruneBatch deletes the provided final Jobs, skipping the ones which are
+// the last successful Job of their APIID and Cid, and returns the deleted ones.
+func (s *Store) pruneBatch(jobs []ffs.StorageJob) ([]ffs.StorageJob, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	deleted := make([]ffs.StorageJob, 0, len(jobs))
+	for _, j := range jobs {
+		if last, ok := s.lastSuccessfulJobs[j.APIID][j.Cid]; ok && last.ID == j.ID {
+			continue
+		}
+		deleted = append(deleted, j)
+	}
+	if err := s.delete(deleted); err != nil {
+		return nil, fmt.Errorf("deleting jobs: %s", err)
+	}
+	return deleted, nil
+}
+
 // Get returns the current state of Job. If doesn't exist, returns
 // ErrNotFound.
 func (s *Store) Get(jid ffs.JobID) (ffs.StorageJob, error) {
@@ -426,6 +821,8 @@ const (
 	Executing
 	// Final lists final StorageJobs.
 	Final
+	// Scheduled lists scheduled StorageJobs.
+	Scheduled
 )
 
 // ListConfig controls the behavior for listing StorageJobs.
@@ -552,7 +949,12 @@ func (s *Store) List(config ListConfig) ([]ffs.StorageJob, bool, string, error)
 		case Final:
 			_, queued := s.queuedIDs[jobID]
 			_, executing := s.executingIDs[jobID]
-			if queued || executing {
+			_, scheduled := s.scheduledJobs[jobID]
+			if queued || executing || scheduled {
+				continue
+			}
+		case Scheduled:
+			if _, scheduled := s.scheduledJobs[jobID]; !scheduled {
 				continue
 			}
 		}
@@ -587,6 +989,13 @@ func (s *Store) Close() error {
 }
 
 func (s *Store) put(j ffs.StorageJob, updateIndex bool) error {
+	// Final Jobs aren't cached by ID, but they can only be
+	// reached from a cached status.
+	statusChanged := updateIndex
+	if prev, ok := s.cachedStatus(j.ID); ok {
+		statusChanged = prev != j.Status
+	}
+
 	buf, err := json.Marshal(j)
 	if err != nil {
 		return fmt.Errorf("marshaling for datastore: %s", err)
@@ -631,21 +1040,37 @@ func (s *Store) put(j ffs.StorageJob, updateIndex bool) error {
 	// Update queued cids cache.
 	ensureJobsSliceMap(s.queuedJobs, j.APIID)
 	if j.Status == ffs.Queued {
-		// Every new Queued job goes at the tail since has
-		// the biggest CreatedAt value.
-		s.queued = append(s.queued, j)
+		// Every new Queued job goes after all the jobs with
+		// the same or higher priority, since has the biggest
+		// CreatedAt value.
+		queued := s.queued[j.APIID]
+		idx := sort.Search(len(queued), func(i int) bool {
+			return queued[i].Priority < j.Priority
+		})
+		queued = append(queued, ffs.StorageJob{})
+		copy(queued[idx+1:], queued[idx:])
+		queued[idx] = j
+		s.queued[j.APIID] = queued
+		s.totalQueued++
 		s.queuedJobs[j.APIID][j.Cid] = append(s.queuedJobs[j.APIID][j.Cid], &j)
 		s.queuedIDs[j.ID] = struct{}{}
 	} else { // In any other case, ensure taking it out from queued caches.
 		delIndex := -1
-		for i, job := range s.queued {
+		queued := s.queued[j.APIID]
+		for i, job := range queued {
 			if j.ID == job.ID {
 				delIndex = i
 				break
 			}
 		}
 		if delIndex != -1 {
-			s.queued = append(s.queued[:delIndex], s.queued[delIndex+1:]...)
+			queued = append(queued[:delIndex], queued[delIndex+1:]...)
+			if len(queued) == 0 {
+				delete(s.queued, j.APIID)
+			} else {
+				s.queued[j.APIID] = queued
+			}
+			s.totalQueued--
 		}
 
 		delIndex = -1
@@ -661,6 +1086,13 @@ func (s *Store) put(j ffs.StorageJob, updateIndex bool) error {
 		delete(s.queuedIDs, j.ID)
 	}
 
+	// Update scheduled jobs cache.
+	if j.Status == ffs.Scheduled {
+		s.scheduledJobs[j.ID] = &j
+	} else {
+		delete(s.scheduledJobs, j.ID)
+	}
+
 	// Update the cache of latest final jobs
 	if isFinal(j) {
 		ensureJobsMap(s.lastFinalJobs, j.APIID)
@@ -674,9 +1106,98 @@ func (s *Store) put(j ffs.StorageJob, updateIndex bool) error {
 	}
 
 	s.notifyWatchers(j)
+	if statusChanged && s.statusChanged != nil {
+		s.statusChanged(j)
+	}
 	return nil
 }
 
+// cachedStatus returns the status of a Queued, Executing or Scheduled Job.
+func (s *Store) cachedStatus(jid ffs.JobID) (ffs.JobStatus, bool) {
+	if _, ok := s.queuedIDs[jid]; ok {
+		return ffs.Queued, true
+	}
+	if _, ok := s.executingIDs[jid]; ok {
+		return ffs.Executing, true
+	}
+	if _, ok := s.scheduledJobs[jid]; ok {
+		return ffs.Scheduled, true
+	}
+	return ffs.Unspecified, false
+}
+
+// delete deletes final Jobs and their index entries. If a deleted Job
+// was the last final Job of its APIID and Cid, the newest remaining final
+// Job takes its place.
+func (s *Store) delete(jobs []ffs.StorageJob) error {
+	if len(jobs) == 0 {
+		return nil
+	}
+	txn, err := s.ds.NewTransaction(false)
+	if err != nil {
+		return fmt.Errorf("starting transaction: %s", err)
+	}
+	defer txn.Discard()
+
+	for _, j := range jobs {
+		if err := txn.Delete(makeKey(j.ID)); err != nil {
+			return fmt.Errorf("deleting from datastore: %s", err)
+		}
+		if err := txn.Delete(makeAPIIDKey(j)); err != nil {
+			return fmt.Errorf("deleting from api id index: %s", err)
+		}
+		if err := txn.Delete(makeCidKey(j)); err != nil {
+			return fmt.Errorf("deleting from cid index: %s", err)
+		}
+	}
+	if err := txn.Commit(); err != nil {
+		return fmt.Errorf("committing txn: %v", err)
+	}
+
+	for _, j := range jobs {
+		last, ok := s.lastFinalJobs[j.APIID][j.Cid]
+		if !ok || last.ID != j.ID {
+			continue
+		}
+		delete(s.lastFinalJobs[j.APIID], j.Cid)
+		newest, err := s.newestFinal(j.APIID, j.Cid)
+		if err != nil {
+			return fmt.Errorf("getting newest final job: %s", err)
+		}
+		if newest != nil {
+			s.lastFinalJobs[j.APIID][j.Cid] = newest
+		}
+	}
+	return nil
+}
+
+// newestFinal returns the newest final Job of an APIID and Cid, or
+// nil if there isn't one.
+func (s *Store) newestFinal(iid ffs.APIID, c cid.Cid) (*ffs.StorageJob, error) {
+	q := query.Query{Prefix: prefixAPIIDAndCid(iid, c)}
+	res, err := s.ds.Query(q)
+	if err != nil {
+		return nil, fmt.Errorf("querying datastore: %s", err)
+	}
+	defer func() { _ = res.Close() }()
+
+	var newest *ffs.StorageJob
+	for r := range res.Next() {
+		if r.Error != nil {
+			return nil, fmt.Errorf("iter next: %s", r.Error)
+		}
+		j, err := s.get(ffs.JobID(r.Value))
+		if err != nil {
+			return nil, fmt.Errorf("getting job %s: %s", r.Value, err)
+		}
+		if isFinal(j) && (newest == nil || j.CreatedAt > newest.CreatedAt) {
+			j := j
+			newest = &j
+		}
+	}
+	return newest, nil
+}
+
 func (s *Store) get(jid ffs.JobID) (ffs.StorageJob, error) {
 	buf, err := s.ds.Get(makeKey(jid))
 	if err == datastore.ErrNotFound {
@@ -730,7 +1251,8 @@ func (s *Store) loadCaches() error {
 		}
 
 		if j.Status == ffs.Queued {
-			s.queued = append(s.queued, j)
+			s.queued[j.APIID] = append(s.queued[j.APIID], j)
+			s.totalQueued++
 			ensureJobsSliceMap(s.queuedJobs, j.APIID)
 			s.queuedJobs[j.APIID][j.Cid] = append(s.queuedJobs[j.APIID][j.Cid], &j)
 			s.queuedIDs[j.ID] = struct{}{}
@@ -738,6 +1260,8 @@ func (s *Store) loadCaches() error {
 			ensureJobsMap(s.executingJobs, j.APIID)
 			s.executingJobs[j.APIID][j.Cid] = &j
 			s.executingIDs[j.ID] = struct{}{}
+		} else if j.Status == ffs.Scheduled {
+			s.scheduledJobs[j.ID] = &j
 		}
 
 		ensureJobsMap(s.lastFinalJobs, j.APIID)
@@ -752,9 +1276,18 @@ func (s *Store) loadCaches() error {
 			s.lastSuccessfulJobs[j.APIID][j.Cid] = &j
 		}
 	}
-	sort.Slice(s.queued, func(a, b int) bool {
-		return s.queued[a].CreatedAt < s.queued[b].CreatedAt
-	})
+	if err := s.loadFairShares(); err != nil {
+		return fmt.Errorf("loading fair shares: %s", err)
+	}
+
+	for _, queued := range s.queued {
+		sort.Slice(queued, func(a, b int) bool {
+			if queued[a].Priority != queued[b].Priority {
+				return queued[a].Priority > queued[b].Priority
+			}
+			return queued[a].CreatedAt < queued[b].CreatedAt
+		})
+	}
 	for _, cidMap := range s.queuedJobs {
 		for _, queuedJobs := range cidMap {
 			sort.Slice(queuedJobs, func(a, b int) bool {
@@ -767,10 +1300,36 @@ func (s *Store) loadCaches() error {
 	ctx := context.Background()
 	s.metricJobCounter.Add(ctx, int64(stats.TotalQueued), attrStatusQueued)
 	s.metricJobCounter.Add(ctx, int64(stats.TotalExecuting), attrStatusExecuting)
+	s.metricJobCounter.Add(ctx, int64(stats.TotalScheduled), attrStatusScheduled)
 
 	return nil
 }
 
+func (s *Store) loadFairShares() error {
+	q := query.Query{Prefix: dsBaseFairShare.String()}
+	res, err := s.ds.Query(q)
+	if err != nil {
+		return fmt.Errorf("querying datastore: %s", err)
+	}
+	defer func() {
+		if err := res.Close(); err != nil {
+			log.Errorf("closing fair shares result: %s", err)
+		}
+	}()
+	for r := range res.Next() {
+		if r.Error != nil {
+			return fmt.Errorf("iter next: %s", r.Error)
+		}
+		var fs ffs.FairShare
+		if err := json.Unmarshal(r.Value, &fs); err != nil {
+			return fmt.Errorf("unmarshalling fair share: %s", err)
+		}
+		iid := ffs.APIID(datastore.RawKey(r.Key).BaseNamespace())
+		s.fairShares[iid] = fs
+	}
+	return nil
+}
+
 func ensureJobsMap(m map[ffs.APIID]map[cid.Cid]*ffs.StorageJob, apiID ffs.APIID) {
 	_, ok := m[apiID]
 	if !ok {
@@ -797,6 +1356,10 @@ func makeStartedDealsKey(iid ffs.APIID, c cid.Cid) datastore.Key {
 	return dsBaseStartedDeals.ChildString(iid.String()).ChildString(util.CidToString(c))
 }
 
+func makeFairShareKey(iid ffs.APIID) datastore.Key {
+	return dsBaseFairShare.ChildString(iid.String())
+}
+
 func makeKey(jid ffs.JobID) datastore.Key {
 	return dsBaseJob.ChildString(jid.String())
 }
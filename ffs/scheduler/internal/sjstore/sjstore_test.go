@@ -9,6 +9,7 @@ import (
 	"github.com/multiformats/go-multihash"
 	mh "github.com/multiformats/go-multihash"
 	"github.com/stretchr/testify/require"
+	"github.com/textileio/powergate/v2/deals"
 	"github.com/textileio/powergate/v2/ffs"
 	"github.com/textileio/powergate/v2/tests"
 )
@@ -424,6 +425,145 @@ func TestDequeue(t *testing.T) {
 	})
 }
 
+func TestDequeuePriority(t *testing.T) {
+	t.Parallel()
+	s := create(t)
+
+	low := createJob(t, "apiid", cid.Undef)
+	low.Priority = ffs.PriorityLow
+	normal1 := createJob(t, "apiid", cid.Undef)
+	urgent := createJob(t, "apiid", cid.Undef)
+	urgent.Priority = ffs.PriorityUrgent
+	normal2 := createJob(t, "apiid", cid.Undef)
+	high := createJob(t, "apiid", cid.Undef)
+	high.Priority = ffs.PriorityHigh
+	for _, j := range []ffs.StorageJob{low, normal1, urgent, normal2, high} {
+		err := s.Enqueue(j)
+		require.NoError(t, err)
+	}
+
+	requireDequeueOrder := func(s *Store, expected ...ffs.StorageJob) {
+		for _, e := range expected {
+			j, err := s.Dequeue(ffs.EmptyInstanceID)
+			require.NoError(t, err)
+			require.NotNil(t, j)
+			require.Equal(t, e.ID, j.ID)
+		}
+	}
+
+	// Dequeue some jobs from the in-memory queue, and the rest
+	// after reloading the store to check the order is kept.
+	requireDequeueOrder(s, urgent, high)
+	s2, err := New(s.ds)
+	require.NoError(t, err)
+	requireDequeueOrder(s2, normal1, normal2, low)
+	j, err := s2.Dequeue(ffs.EmptyInstanceID)
+	require.NoError(t, err)
+	require.Nil(t, j)
+}
+
+func TestDequeueFairShare(t *testing.T) {
+	t.Parallel()
+
+	enqueue := func(t *testing.T, s *Store, apiid string, n int) []ffs.StorageJob {
+		res := make([]ffs.StorageJob, n)
+		for i := 0; i < n; i++ {
+			res[i] = createJob(t, apiid, cid.Undef)
+			err := s.Enqueue(res[i])
+			require.NoError(t, err)
+		}
+		return res
+	}
+	requireDequeueOrder := func(t *testing.T, s *Store, expected ...ffs.StorageJob) {
+		for _, e := range expected {
+			j, err := s.Dequeue(ffs.EmptyInstanceID)
+			require.NoError(t, err)
+			require.NotNil(t, j)
+			require.Equal(t, e.ID, j.ID)
+		}
+	}
+
+	t.Run("RoundRobin", func(t *testing.T) {
+		t.Parallel()
+		s := create(t)
+		a := enqueue(t, s, "api1", 3)
+		b := enqueue(t, s, "api2", 2)
+
+		requireDequeueOrder(t, s, a[0], b[0], a[1], b[1], a[2])
+	})
+	t.Run("Weight", func(t *testing.T) {
+		t.Parallel()
+		s := create(t)
+		err := s.SetFairShare("api1", ffs.FairShare{Weight: 2})
+		require.NoError(t, err)
+		a := enqueue(t, s, "api1", 4)
+		b := enqueue(t, s, "api2", 3)
+
+		// Once both have executing jobs, api1 gets two slots for each one of api2.
+		requireDequeueOrder(t, s, a[0], b[0], a[1], b[1], a[2], a[3], b[2])
+	})
+	t.Run("MaxExecuting", func(t *testing.T) {
+		t.Parallel()
+		s := create(t)
+		err := s.SetFairShare("api1", ffs.FairShare{Weight: 1, MaxExecuting: 1})
+		require.NoError(t, err)
+		a := enqueue(t, s, "api1", 2)
+		b := enqueue(t, s, "api2", 2)
+
+		requireDequeueOrder(t, s, a[0], b[0], b[1])
+		j, err := s.Dequeue(ffs.EmptyInstanceID)
+		require.NoError(t, err)
+		require.Nil(t, j)
+
+		// Finalizing the executing job frees a slot for api1.
+		err = s.Finalize(a[0].ID, ffs.Success, nil, nil)
+		require.NoError(t, err)
+		requireDequeueOrder(t, s, a[1])
+	})
+	t.Run("Invalid", func(t *testing.T) {
+		t.Parallel()
+		s := create(t)
+		err := s.SetFairShare("api1", ffs.FairShare{Weight: 0})
+		require.Error(t, err)
+		err = s.SetFairShare("api1", ffs.FairShare{Weight: 1, MaxExecuting: -1})
+		require.Error(t, err)
+		require.Empty(t, s.GetFairShares())
+	})
+	t.Run("Persisted", func(t *testing.T) {
+		t.Parallel()
+		s := create(t)
+		fs := ffs.FairShare{Weight: 3, MaxExecuting: 5}
+		err := s.SetFairShare("api1", fs)
+		require.NoError(t, err)
+
+		s2, err := New(s.ds)
+		require.NoError(t, err)
+		require.Equal(t, map[ffs.APIID]ffs.FairShare{"api1": fs}, s2.GetFairShares())
+	})
+	t.Run("Remove", func(t *testing.T) {
+		t.Parallel()
+		s := create(t)
+		err := s.SetFairShare("api1", ffs.FairShare{Weight: 1, MaxExecuting: 1})
+		require.NoError(t, err)
+		a := enqueue(t, s, "api1", 2)
+
+		requireDequeueOrder(t, s, a[0])
+		j, err := s.Dequeue(ffs.EmptyInstanceID)
+		require.NoError(t, err)
+		require.Nil(t, j)
+
+		// Without the MaxExecuting limit, the queued job can be executed.
+		err = s.RemoveFairShare("api1")
+		require.NoError(t, err)
+		require.Empty(t, s.GetFairShares())
+		requireDequeueOrder(t, s, a[1])
+
+		s2, err := New(s.ds)
+		require.NoError(t, err)
+		require.Empty(t, s2.GetFairShares())
+	})
+}
+
 func TestCancelation(t *testing.T) {
 	t.Parallel()
 	s := create(t)
@@ -445,6 +585,290 @@ func TestCancelation(t *testing.T) {
 	require.Equal(t, ffs.Queued, j2Queued.Status)
 }
 
+func TestSchedule(t *testing.T) {
+	t.Parallel()
+	t.Run("QueueDue", func(t *testing.T) {
+		t.Parallel()
+		s := create(t)
+		now := time.Now()
+		j1 := createJob(t, "apiid", cid.Undef)
+		j1.NotBefore = now.Add(-time.Minute).Unix()
+		err := s.Schedule(j1)
+		require.NoError(t, err)
+		j2 := createJob(t, "apiid", cid.Undef)
+		j2.NotBefore = now.Add(time.Hour).Unix()
+		err = s.Schedule(j2)
+		require.NoError(t, err)
+		require.Equal(t, 2, s.GetStats().TotalScheduled)
+
+		// Scheduled jobs aren't dequeued.
+		j, err := s.Dequeue(ffs.EmptyInstanceID)
+		require.NoError(t, err)
+		require.Nil(t, j)
+
+		n, err := s.QueueDue(now)
+		require.NoError(t, err)
+		require.Equal(t, 1, n)
+		j1Queued, err := s.Get(j1.ID)
+		require.NoError(t, err)
+		require.Equal(t, ffs.Queued, j1Queued.Status)
+		j2Scheduled, err := s.Get(j2.ID)
+		require.NoError(t, err)
+		require.Equal(t, ffs.Scheduled, j2Scheduled.Status)
+
+		j, err = s.Dequeue(ffs.EmptyInstanceID)
+		require.NoError(t, err)
+		require.NotNil(t, j)
+		require.Equal(t, j1.ID, j.ID)
+
+		n, err = s.QueueDue(now.Add(2 * time.Hour))
+		require.NoError(t, err)
+		require.Equal(t, 1, n)
+		require.Equal(t, 0, s.GetStats().TotalScheduled)
+	})
+	t.Run("Cancelation", func(t *testing.T) {
+		t.Parallel()
+		s := create(t)
+		j1 := createJob(t, "apiid", cid.Undef)
+		j1.NotBefore = time.Now().Add(time.Hour).Unix()
+		err := s.Schedule(j1)
+		require.NoError(t, err)
+
+		// A newer Job for the same Cid cancels the Scheduled one.
+		j2 := createJob(t, "apiid", j1.Cid)
+		err = s.Enqueue(j2)
+		require.NoError(t, err)
+		j1Canceled, err := s.Get(j1.ID)
+		require.NoError(t, err)
+		require.Equal(t, ffs.Canceled, j1Canceled.Status)
+
+		j3 := createJob(t, "apiid", cid.Undef)
+		j3.NotBefore = time.Now().Add(time.Hour).Unix()
+		err = s.Schedule(j3)
+		require.NoError(t, err)
+		err = s.CancelScheduled("apiid", j3.Cid)
+		require.NoError(t, err)
+		j3Canceled, err := s.Get(j3.ID)
+		require.NoError(t, err)
+		require.Equal(t, ffs.Canceled, j3Canceled.Status)
+		require.Equal(t, 0, s.GetStats().TotalScheduled)
+	})
+	t.Run("List", func(t *testing.T) {
+		t.Parallel()
+		s := create(t)
+		j1 := createJob(t, "apiid", cid.Undef)
+		j1.NotBefore = time.Now().Add(time.Hour).Unix()
+		err := s.Schedule(j1)
+		require.NoError(t, err)
+		j2 := createJob(t, "apiid", cid.Undef)
+		err = s.Enqueue(j2)
+		require.NoError(t, err)
+
+		res, _, _, err := s.List(ListConfig{Select: Scheduled})
+		require.NoError(t, err)
+		require.Len(t, res, 1)
+		require.Equal(t, j1.ID, res[0].ID)
+
+		res, _, _, err = s.List(ListConfig{Select: Final})
+		require.NoError(t, err)
+		require.Empty(t, res)
+	})
+	t.Run("Persisted", func(t *testing.T) {
+		t.Parallel()
+		s := create(t)
+		j := createJob(t, "apiid", cid.Undef)
+		j.NotBefore = time.Now().Add(time.Hour).Unix()
+		err := s.Schedule(j)
+		require.NoError(t, err)
+
+		s2, err := New(s.ds)
+		require.NoError(t, err)
+		require.Equal(t, 1, s2.GetStats().TotalScheduled)
+		n, err := s2.QueueDue(time.Now().Add(2 * time.Hour))
+		require.NoError(t, err)
+		require.Equal(t, 1, n)
+	})
+}
+
+func TestOnStatusChange(t *testing.T) {
+	t.Parallel()
+	s := create(t)
+	var statuses []ffs.JobStatus
+	s.OnStatusChange(func(j ffs.StorageJob) {
+		statuses = append(statuses, j.Status)
+	})
+
+	j := createJob(t, "apiid", cid.Undef)
+	j.NotBefore = time.Now().Add(-time.Minute).Unix()
+	err := s.Schedule(j)
+	require.NoError(t, err)
+	_, err = s.QueueDue(time.Now())
+	require.NoError(t, err)
+	dj, err := s.Dequeue(ffs.EmptyInstanceID)
+	require.NoError(t, err)
+	require.NotNil(t, dj)
+
+	// Deal info updates don't change the status.
+	dealUpdates := s.MonitorJob(*dj)
+	dealUpdates <- deals.StorageDealInfo{ProposalCid: dj.Cid}
+	close(dealUpdates)
+
+	err = s.Finalize(j.ID, ffs.Success, nil, nil)
+	require.NoError(t, err)
+
+	require.Eventually(t, func() bool {
+		fj, err := s.Get(j.ID)
+		require.NoError(t, err)
+		return len(fj.DealInfo) == 1
+	}, time.Second, 10*time.Millisecond)
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	require.Equal(t, []ffs.JobStatus{ffs.Scheduled, ffs.Queued, ffs.Executing, ffs.Success}, statuses)
+}
+
+func init() {
+	// Prune in small batches, so pruning more jobs
+	// than the batch size is tested.
+	pruneBatchSize = 2
+}
+
+func TestRequeue(t *testing.T) {
+	t.Parallel()
+	s := create(t)
+	j := createJob(t, "apiid", cid.Undef)
+	err := s.Enqueue(j)
+	require.NoError(t, err)
+
+	// Only executing jobs can be re-queued.
+	err = s.Requeue(j.ID)
+	require.Error(t, err)
+
+	dj, err := s.Dequeue(j.APIID)
+	require.NoError(t, err)
+	require.Equal(t, j.ID, dj.ID)
+	err = s.Requeue(j.ID)
+	require.NoError(t, err)
+	rj, err := s.Get(j.ID)
+	require.NoError(t, err)
+	require.Equal(t, ffs.Queued, rj.Status)
+	require.Equal(t, 0, s.GetStats().TotalExecuting)
+
+	// It can be dequeued again.
+	dj, err = s.Dequeue(j.APIID)
+	require.NoError(t, err)
+	require.Equal(t, j.ID, dj.ID)
+
+	// If a new job for the cid was queued meanwhile,
+	// the interrupted one is canceled.
+	j2 := createJob(t, "apiid", j.Cid)
+	err = s.Enqueue(j2)
+	require.NoError(t, err)
+	err = s.Requeue(j.ID)
+	require.NoError(t, err)
+	rj, err = s.Get(j.ID)
+	require.NoError(t, err)
+	require.Equal(t, ffs.Canceled, rj.Status)
+	dj, err = s.Dequeue(j.APIID)
+	require.NoError(t, err)
+	require.Equal(t, j2.ID, dj.ID)
+}
+
+func TestPrune(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+	// setup creates final Jobs for the same Cid, one per day
+	// with the oldest first. The third one is the last successful Job.
+	setup := func(t *testing.T) (*Store, []ffs.StorageJob) {
+		s := create(t)
+		c := createJob(t, "apiid", cid.Undef).Cid
+		statuses := []ffs.JobStatus{ffs.Success, ffs.Failed, ffs.Success, ffs.Failed, ffs.Failed}
+		var jobs []ffs.StorageJob
+		for i, st := range statuses {
+			j := createJob(t, "apiid", c)
+			j.CreatedAt = now.Add(-time.Duration(len(statuses)-i) * 24 * time.Hour).Unix()
+			err := s.Enqueue(j)
+			require.NoError(t, err)
+			dj, err := s.Dequeue(j.APIID)
+			require.NoError(t, err)
+			require.Equal(t, j.ID, dj.ID)
+			err = s.Finalize(j.ID, st, nil, nil)
+			require.NoError(t, err)
+			jobs = append(jobs, j)
+		}
+		return s, jobs
+	}
+	requirePruned := func(t *testing.T, s *Store, pruned []ffs.StorageJob, jobs []ffs.StorageJob, expPruned ...int) {
+		var prunedIDs []ffs.JobID
+		for _, j := range pruned {
+			prunedIDs = append(prunedIDs, j.ID)
+		}
+		var expPrunedIDs []ffs.JobID
+		for _, i := range expPruned {
+			expPrunedIDs = append(expPrunedIDs, jobs[i].ID)
+		}
+		require.ElementsMatch(t, expPrunedIDs, prunedIDs)
+		for _, j := range pruned {
+			_, err := s.Get(j.ID)
+			require.Equal(t, ErrNotFound, err)
+		}
+		res, _, _, err := s.List(ListConfig{})
+		require.NoError(t, err)
+		require.Len(t, res, len(jobs)-len(expPruned))
+	}
+
+	t.Run("NoPolicy", func(t *testing.T) {
+		t.Parallel()
+		s, jobs := setup(t)
+		pruned, err := s.Prune(RetentionPolicy{}, now)
+		require.NoError(t, err)
+		requirePruned(t, s, pruned, jobs)
+	})
+	t.Run("MaxPerCid", func(t *testing.T) {
+		t.Parallel()
+		s, jobs := setup(t)
+		pruned, err := s.Prune(RetentionPolicy{MaxPerCid: 2}, now)
+		require.NoError(t, err)
+		requirePruned(t, s, pruned, jobs, 0, 1)
+	})
+	t.Run("MaxAge", func(t *testing.T) {
+		t.Parallel()
+		s, jobs := setup(t)
+		pruned, err := s.Prune(RetentionPolicy{MaxAge: 36 * time.Hour}, now)
+		require.NoError(t, err)
+		requirePruned(t, s, pruned, jobs, 0, 1, 3)
+	})
+	t.Run("LastFinalJob", func(t *testing.T) {
+		t.Parallel()
+		s, jobs := setup(t)
+		pruned, err := s.Prune(RetentionPolicy{MaxAge: 12 * time.Hour}, now)
+		require.NoError(t, err)
+		requirePruned(t, s, pruned, jobs, 0, 1, 3, 4)
+		// The last successful job is now the last final job.
+		s.lock.Lock()
+		defer s.lock.Unlock()
+		require.Equal(t, jobs[2].ID, s.lastFinalJobs[jobs[2].APIID][jobs[2].Cid].ID)
+	})
+	t.Run("NonFinal", func(t *testing.T) {
+		t.Parallel()
+		s := create(t)
+		j := createJob(t, "apiid", cid.Undef)
+		j.CreatedAt = now.Add(-48 * time.Hour).Unix()
+		err := s.Enqueue(j)
+		require.NoError(t, err)
+		pruned, err := s.Prune(RetentionPolicy{MaxAge: time.Hour, MaxPerCid: 1}, now)
+		require.NoError(t, err)
+		require.Empty(t, pruned)
+	})
+	t.Run("Invalid", func(t *testing.T) {
+		t.Parallel()
+		s := create(t)
+		_, err := s.Prune(RetentionPolicy{MaxPerCid: -1}, now)
+		require.Error(t, err)
+	})
+}
+
 func TestStartedDeals(t *testing.T) {
 	t.Parallel()
 	s := create(t)
@@ -3,8 +3,11 @@ package trackstore
 import (
 	"encoding/json"
 	"fmt"
+	"hash/fnv"
+	"sort"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/ipfs/go-cid"
 	"github.com/ipfs/go-datastore"
@@ -17,9 +20,21 @@ import (
 // latest Storage Config value of a Cid to execute those actions.
 // This store is used by the Scheduler background jobs that
 // repair or renew storage configurations.
+//
+// Tracked storage configs are also kept in memory, indexed by
+// their evaluation frequency and offset, so finding the ones
+// due for evaluation doesn't query the datastore.
 type Store struct {
-	lock sync.Mutex
-	ds   datastore.Datastore
+	lock         sync.Mutex
+	ds           datastore.Datastore
+	minFrequency time.Duration
+
+	tracked map[cid.Cid][]TrackedStorageConfig
+	// own indexes tracked storage configs with an EvalFrequency
+	// by their frequency. defaults indexes the ones without it
+	// by the default frequencies used to query them.
+	own      map[time.Duration]*dueIndex
+	defaults map[time.Duration]*dueIndex
 }
 
 // TrackedStorageConfig has information about a StorageConfig from
@@ -35,9 +50,20 @@ type TrackedCid struct {
 	Tracked []TrackedStorageConfig
 }
 
-// New retruns a new Store.
-func New(ds datastore.Datastore) (*Store, error) {
-	return &Store{ds: ds}, nil
+// New retruns a new Store. Storage configs with an EvalFrequency
+// lower than minFrequency are evaluated every minFrequency.
+func New(ds datastore.Datastore, minFrequency time.Duration) (*Store, error) {
+	s := &Store{
+		ds:           ds,
+		minFrequency: minFrequency,
+		tracked:      map[cid.Cid][]TrackedStorageConfig{},
+		own:          map[time.Duration]*dueIndex{},
+		defaults:     map[time.Duration]*dueIndex{},
+	}
+	if err := s.load(); err != nil {
+		return nil, fmt.Errorf("loading tracked storage configs: %s", err)
+	}
+	return s, nil
 }
 
 // Put updates the StorageConfig tracking state for a Cid.
@@ -50,10 +76,7 @@ func (s *Store) Put(iid ffs.APIID, c cid.Cid, sc ffs.StorageConfig) error {
 	s.lock.Lock()
 	defer s.lock.Unlock()
 
-	scs, err := s.get(c)
-	if err != nil {
-		return fmt.Errorf("getting current storage configs for cid: %s", err)
-	}
+	scs := append([]TrackedStorageConfig(nil), s.tracked[c]...)
 	idx := -1
 	for i := range scs {
 		if scs[i].IID == iid {
@@ -90,10 +113,10 @@ func (s *Store) Put(iid ffs.APIID, c cid.Cid, sc ffs.StorageConfig) error {
 
 // Remove removes tracking the storage config from iid.
 func (s *Store) Remove(iid ffs.APIID, c cid.Cid) error {
-	scs, err := s.get(c)
-	if err != nil {
-		return fmt.Errorf("getting current storage configs for cid: %s", err)
-	}
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	scs := append([]TrackedStorageConfig(nil), s.tracked[c]...)
 	idx := -1
 	for i := range scs {
 		if scs[i].IID == iid {
@@ -101,10 +124,11 @@ func (s *Store) Remove(iid ffs.APIID, c cid.Cid) error {
 			break
 		}
 	}
-	if idx != -1 {
-		scs[idx] = scs[len(scs)-1]
-		scs = scs[:len(scs)-1]
+	if idx == -1 {
+		return nil
 	}
+	scs[idx] = scs[len(scs)-1]
+	scs = scs[:len(scs)-1]
 	if err := s.persist(c, scs); err != nil {
 		return fmt.Errorf("persisting updated storage configs for cid: %s", err)
 	}
@@ -116,12 +140,16 @@ func (s *Store) GetRepairables() ([]TrackedCid, error) {
 	s.lock.Lock()
 	defer s.lock.Unlock()
 
-	tcs, err := s.query(false, true)
-	if err != nil {
-		return nil, fmt.Errorf("getting repairable cids from datastore: %s", err)
-	}
+	return s.query(false, true, nil), nil
+}
+
+// GetRepairablesDue returns the tracked repairable storage configs which are due
+// for evaluation in the (from, to] time window. See dueIndex for details.
+func (s *Store) GetRepairablesDue(from, to time.Time, defaultFrequency time.Duration) ([]TrackedCid, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
 
-	return tcs, nil
+	return s.query(false, true, s.due(from, to, defaultFrequency)), nil
 }
 
 // GetRenewables returns all the tracked renewable storage configs.
@@ -129,50 +157,97 @@ func (s *Store) GetRenewables() ([]TrackedCid, error) {
 	s.lock.Lock()
 	defer s.lock.Unlock()
 
-	tcs, err := s.query(true, false)
-	if err != nil {
-		return nil, fmt.Errorf("getting renewable cids from datastore: %s", err)
+	return s.query(true, false, nil), nil
+}
+
+// GetRenewablesDue returns the tracked renewable storage configs which are due
+// for evaluation in the (from, to] time window. See dueIndex for details.
+func (s *Store) GetRenewablesDue(from, to time.Time, defaultFrequency time.Duration) ([]TrackedCid, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	return s.query(true, false, s.due(from, to, defaultFrequency)), nil
+}
+
+// trackedKey identifies a tracked storage config.
+type trackedKey struct {
+	iid ffs.APIID
+	c   cid.Cid
+}
+
+// due returns the tracked storage configs which are due for evaluation
+// in the (from, to] time window.
+func (s *Store) due(from, to time.Time, defaultFrequency time.Duration) map[trackedKey]struct{} {
+	res := map[trackedKey]struct{}{}
+	for _, di := range s.own {
+		di.due(from, to, res)
+	}
+	if defaultFrequency <= 0 {
+		return res
 	}
+	di, ok := s.defaults[defaultFrequency]
+	if !ok {
+		di = &dueIndex{freq: defaultFrequency}
+		for c, tscs := range s.tracked {
+			for _, tsc := range tscs {
+				if tsc.StorageConfig.EvalFrequency <= 0 {
+					di.insert(trackedKey{iid: tsc.IID, c: c})
+				}
+			}
+		}
+		s.defaults[defaultFrequency] = di
+	}
+	di.due(from, to, res)
+	return res
+}
 
-	return tcs, nil
+func (s *Store) query(renewable bool, repairable bool, due map[trackedKey]struct{}) []TrackedCid {
+	var res []TrackedCid
+	for c, tscs := range s.tracked {
+		tc := TrackedCid{Cid: c}
+		for _, tsc := range tscs {
+			isRepairable := tsc.StorageConfig.Repairable
+			isRenewable := tsc.StorageConfig.Cold.Enabled && tsc.StorageConfig.Cold.Filecoin.Renew.Enabled
+			if !(repairable && isRepairable) && !(renewable && isRenewable) {
+				continue
+			}
+			if due != nil {
+				if _, ok := due[trackedKey{iid: tsc.IID, c: c}]; !ok {
+					continue
+				}
+			}
+			tc.Tracked = append(tc.Tracked, tsc)
+		}
+		if len(tc.Tracked) > 0 {
+			res = append(res, tc)
+		}
+	}
+	return res
 }
 
-func (s *Store) query(renewable bool, repairable bool) ([]TrackedCid, error) {
-	q := query.Query{}
-	r, err := s.ds.Query(q)
+func (s *Store) load() error {
+	r, err := s.ds.Query(query.Query{})
 	if err != nil {
-		return nil, fmt.Errorf("querying persisted tracked storage configs: %s", err)
+		return fmt.Errorf("querying persisted tracked storage configs: %s", err)
 	}
 	defer func() { _ = r.Close() }()
 
-	var res []TrackedCid
 	for v := range r.Next() {
 		if v.Error != nil {
-			return nil, fmt.Errorf("iterating query result: %s", v.Error)
+			return fmt.Errorf("iterating query result: %s", v.Error)
 		}
 		var tscs []TrackedStorageConfig
 		if err := json.Unmarshal(v.Value, &tscs); err != nil {
-			return nil, fmt.Errorf("unmarshaling storage configs: %s", err)
+			return fmt.Errorf("unmarshaling storage configs: %s", err)
 		}
 		cidStr := strings.TrimPrefix(v.Key, "/")
 		c, err := cid.Decode(cidStr)
 		if err != nil {
-			return nil, fmt.Errorf("decoding cid: %s", err)
-		}
-
-		tc := TrackedCid{Cid: c}
-		for _, tsc := range tscs {
-			isRepairable := tsc.StorageConfig.Repairable
-			isRenewable := tsc.StorageConfig.Cold.Enabled && tsc.StorageConfig.Cold.Filecoin.Renew.Enabled
-			if (repairable && isRepairable) || (renewable && isRenewable) {
-				tc.Tracked = append(tc.Tracked, tsc)
-			}
-		}
-		if len(tc.Tracked) > 0 {
-			res = append(res, tc)
+			return fmt.Errorf("decoding cid: %s", err)
 		}
+		s.track(c, tscs)
 	}
-	return res, nil
+	return nil
 }
 
 func (s *Store) persist(c cid.Cid, scs []TrackedStorageConfig) error {
@@ -182,6 +257,7 @@ func (s *Store) persist(c cid.Cid, scs []TrackedStorageConfig) error {
 		if err := s.ds.Delete(key); err != nil {
 			return fmt.Errorf("deleting empty storage configs for cid: %s", err)
 		}
+		s.track(c, nil)
 		return nil
 	}
 
@@ -193,20 +269,128 @@ func (s *Store) persist(c cid.Cid, scs []TrackedStorageConfig) error {
 	if err := s.ds.Put(key, buf); err != nil {
 		return fmt.Errorf("saving storage config list in datastore: %s", err)
 	}
+	s.track(c, scs)
 	return nil
 }
 
-func (s *Store) get(c cid.Cid) ([]TrackedStorageConfig, error) {
-	v, err := s.ds.Get(datastore.NewKey(c.String()))
-	if err == datastore.ErrNotFound {
-		return nil, nil
+// track replaces the in-memory tracked storage configs of a cid,
+// and updates the due indexes accordingly.
+func (s *Store) track(c cid.Cid, scs []TrackedStorageConfig) {
+	for _, tsc := range s.tracked[c] {
+		k := trackedKey{iid: tsc.IID, c: c}
+		if freq := s.frequency(tsc); freq > 0 {
+			s.own[freq].remove(k)
+			continue
+		}
+		for _, di := range s.defaults {
+			di.remove(k)
+		}
 	}
-	if err != nil {
-		return nil, fmt.Errorf("getting tracked storage configs: %s", err)
+	if len(scs) == 0 {
+		delete(s.tracked, c)
+		return
+	}
+	s.tracked[c] = scs
+	for _, tsc := range scs {
+		k := trackedKey{iid: tsc.IID, c: c}
+		if freq := s.frequency(tsc); freq > 0 {
+			di, ok := s.own[freq]
+			if !ok {
+				di = &dueIndex{freq: freq}
+				s.own[freq] = di
+			}
+			di.insert(k)
+			continue
+		}
+		for _, di := range s.defaults {
+			di.insert(k)
+		}
+	}
+}
+
+// frequency returns the evaluation frequency of a tracked storage
+// config, or zero if it uses the default frequency.
+func (s *Store) frequency(tsc TrackedStorageConfig) time.Duration {
+	if tsc.StorageConfig.EvalFrequency <= 0 {
+		return 0
+	}
+	freq := time.Duration(tsc.StorageConfig.EvalFrequency) * time.Second
+	if freq < s.minFrequency {
+		freq = s.minFrequency
+	}
+	return freq
+}
+
+// dueIndex indexes tracked storage configs evaluated with the same
+// frequency. Every storage config is evaluated once per frequency
+// period, at a fixed offset within the period derived from its APIID
+// and Cid. This spreads evaluations of all tracked storage configs over
+// the frequency period, instead of evaluating all of them at once.
+// Entries are sorted by offset, so the ones due in a time window are
+// found without iterating all of them.
+type dueIndex struct {
+	freq    time.Duration
+	entries []dueEntry
+}
+
+type dueEntry struct {
+	offset int64
+	key    trackedKey
+}
+
+func (di *dueIndex) offset(k trackedKey) int64 {
+	h := fnv.New64a()
+	_, _ = h.Write([]byte(k.iid.String()))
+	_, _ = h.Write(k.c.Bytes())
+	return int64(h.Sum64() % uint64(di.freq))
+}
+
+func (di *dueIndex) insert(k trackedKey) {
+	o := di.offset(k)
+	i := sort.Search(len(di.entries), func(i int) bool { return di.entries[i].offset >= o })
+	di.entries = append(di.entries, dueEntry{})
+	copy(di.entries[i+1:], di.entries[i:])
+	di.entries[i] = dueEntry{offset: o, key: k}
+}
+
+func (di *dueIndex) remove(k trackedKey) {
+	o := di.offset(k)
+	i := sort.Search(len(di.entries), func(i int) bool { return di.entries[i].offset >= o })
+	for ; i < len(di.entries) && di.entries[i].offset == o; i++ {
+		if di.entries[i].key == k {
+			di.entries = append(di.entries[:i], di.entries[i+1:]...)
+			return
+		}
+	}
+}
+
+// due adds to res the entries with an evaluation time, offset+k*freq,
+// in the (from, to] time window.
+func (di *dueIndex) due(from, to time.Time, res map[trackedKey]struct{}) {
+	f, t := from.UnixNano(), to.UnixNano()
+	if t <= f {
+		return
+	}
+	freq := int64(di.freq)
+	if t-f >= freq {
+		for _, e := range di.entries {
+			res[e.key] = struct{}{}
+		}
+		return
+	}
+	// Offsets in (f mod freq, t mod freq], wrapping around the
+	// end of the period if needed.
+	lo, hi := f%freq, t%freq
+	add := func(lo, hi int64) {
+		i := sort.Search(len(di.entries), func(i int) bool { return di.entries[i].offset > lo })
+		for ; i < len(di.entries) && di.entries[i].offset <= hi; i++ {
+			res[di.entries[i].key] = struct{}{}
+		}
 	}
-	var tsc []TrackedStorageConfig
-	if err := json.Unmarshal(v, &tsc); err != nil {
-		return nil, fmt.Errorf("unmarshaling tracked storage configs: %s", err)
+	if lo < hi {
+		add(lo, hi)
+		return
 	}
-	return tsc, nil
+	add(lo, freq-1)
+	add(-1, hi)
 }
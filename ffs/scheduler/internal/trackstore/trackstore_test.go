@@ -1,10 +1,13 @@
 package trackstore
 
 import (
+	"fmt"
 	"sort"
 	"testing"
+	"time"
 
 	"github.com/ipfs/go-cid"
+	"github.com/multiformats/go-multihash"
 	"github.com/stretchr/testify/require"
 	"github.com/textileio/powergate/v2/ffs"
 	"github.com/textileio/powergate/v2/tests"
@@ -257,6 +260,127 @@ func TestPutMultipleIIDsWithRemoves(t *testing.T) {
 	requireRepairables(t, ts)
 }
 
+func TestDue(t *testing.T) {
+	t.Parallel()
+
+	ts := create(t)
+	iid := ffs.NewAPIID()
+	var cids []cid.Cid
+	for i := 0; i < 50; i++ {
+		h, _ := multihash.Sum([]byte(fmt.Sprintf("data%d", i)), multihash.SHA2_256, -1)
+		c := cid.NewCidV1(cid.Raw, h)
+		err := ts.Put(iid, c, scRepairableRenewable)
+		require.NoError(t, err)
+		cids = append(cids, c)
+	}
+	// This one has its own eval frequency of one minute.
+	cFrequent, _ := util.CidFromString("QmWATWQ7fVPP2EFGu71UkfnqhYXDYH566qy47CnJDgvs99")
+	err := ts.Put(iid, cFrequent, scRepairableRenewable.WithEvalFrequency(60))
+	require.NoError(t, err)
+
+	// Walk a full frequency period in one minute windows, and check
+	// that each storage config is due once per period, and not all
+	// of them in the same window.
+	freq := time.Hour
+	from := time.Unix(1600000000, 0)
+	renewals := map[cid.Cid]int{}
+	repairs := map[cid.Cid]int{}
+	maxPerWindow := 0
+	for to := from.Add(time.Minute); !to.After(from.Add(freq)); to = to.Add(time.Minute) {
+		tcs, err := ts.GetRenewablesDue(to.Add(-time.Minute), to, freq)
+		require.NoError(t, err)
+		for _, tc := range tcs {
+			renewals[tc.Cid]++
+		}
+		if len(tcs) > maxPerWindow {
+			maxPerWindow = len(tcs)
+		}
+		tcs, err = ts.GetRepairablesDue(to.Add(-time.Minute), to, freq)
+		require.NoError(t, err)
+		for _, tc := range tcs {
+			repairs[tc.Cid]++
+		}
+	}
+	for _, c := range cids {
+		require.Equal(t, 1, renewals[c])
+		require.Equal(t, 1, repairs[c])
+	}
+	require.Equal(t, 60, renewals[cFrequent])
+	require.Equal(t, 60, repairs[cFrequent])
+	require.Less(t, maxPerWindow, len(cids))
+}
+
+func TestDueMinFrequency(t *testing.T) {
+	t.Parallel()
+
+	ts, err := New(tests.NewTxMapDatastore(), time.Minute*10)
+	require.NoError(t, err)
+	iid := ffs.NewAPIID()
+	c, _ := util.CidFromString("QmWATWQ7fVPP2EFGu71UkfnqhYXDYH566qy47CnJDgvs99")
+	err = ts.Put(iid, c, scRepairableRenewable.WithEvalFrequency(60))
+	require.NoError(t, err)
+
+	// The eval frequency is lower than the minimum, so it's due
+	// every ten minutes instead of every minute.
+	from := time.Unix(1600000000, 0)
+	due := 0
+	for to := from.Add(time.Minute); !to.After(from.Add(time.Hour)); to = to.Add(time.Minute) {
+		tcs, err := ts.GetRenewablesDue(to.Add(-time.Minute), to, time.Hour)
+		require.NoError(t, err)
+		due += len(tcs)
+	}
+	require.Equal(t, 6, due)
+}
+
+func TestDueUpdates(t *testing.T) {
+	t.Parallel()
+
+	ds := tests.NewTxMapDatastore()
+	ts, err := New(ds, 0)
+	require.NoError(t, err)
+	iid := ffs.NewAPIID()
+	c, _ := util.CidFromString("QmWATWQ7fVPP2EFGu71UkfnqhYXDYH566qy47CnJDgvs99")
+	from := time.Unix(1600000000, 0)
+	to := from.Add(time.Hour)
+
+	// Query the default frequency before tracking anything, so the
+	// default frequency index is updated by the following puts.
+	requireDue(t, ts, from, to, 0)
+
+	err = ts.Put(iid, c, scRepairableRenewable)
+	require.NoError(t, err)
+	requireDue(t, ts, from, to, 1)
+
+	// Changing the eval frequency moves it to another index.
+	err = ts.Put(iid, c, scRepairableRenewable.WithEvalFrequency(60))
+	require.NoError(t, err)
+	requireDue(t, ts, from, to, 1)
+
+	// Tracked storage configs are loaded from the datastore.
+	ts2, err := New(ds, 0)
+	require.NoError(t, err)
+	requireDue(t, ts2, from, to, 1)
+
+	err = ts.Remove(iid, c)
+	require.NoError(t, err)
+	requireDue(t, ts, from, to, 0)
+	err = ts.Put(iid, c, scRepairableRenewable)
+	require.NoError(t, err)
+	err = ts.Put(iid, c, ffs.StorageConfig{})
+	require.NoError(t, err)
+	requireDue(t, ts, from, to, 0)
+}
+
+func requireDue(t *testing.T, ts *Store, from, to time.Time, n int) {
+	t.Helper()
+	tcs, err := ts.GetRenewablesDue(from, to, time.Hour)
+	require.NoError(t, err)
+	require.Len(t, tcs, n)
+	tcs, err = ts.GetRepairablesDue(from, to, time.Hour)
+	require.NoError(t, err)
+	require.Len(t, tcs, n)
+}
+
 type expected struct {
 	Cid  cid.Cid
 	IIDs []ffs.APIID
@@ -309,7 +433,7 @@ func sortTC(tcs []TrackedCid) {
 
 func create(t *testing.T) *Store {
 	ds := tests.NewTxMapDatastore()
-	store, err := New(ds)
+	store, err := New(ds, 0)
 	require.NoError(t, err)
 	return store
 }
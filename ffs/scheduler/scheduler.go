@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"math/big"
 	"sync"
 	"time"
 
@@ -12,13 +13,16 @@ import (
 	"github.com/ipfs/go-datastore"
 	logging "github.com/ipfs/go-log/v2"
 	"github.com/textileio/powergate/v2/ffs"
+	"github.com/textileio/powergate/v2/ffs/scheduler/internal/aggstore"
 	"github.com/textileio/powergate/v2/ffs/scheduler/internal/astore"
 	"github.com/textileio/powergate/v2/ffs/scheduler/internal/cistore"
 	"github.com/textileio/powergate/v2/ffs/scheduler/internal/ristore"
 	"github.com/textileio/powergate/v2/ffs/scheduler/internal/rjstore"
 	"github.com/textileio/powergate/v2/ffs/scheduler/internal/sjstore"
 	"github.com/textileio/powergate/v2/ffs/scheduler/internal/trackstore"
+	"github.com/textileio/powergate/v2/index/faults"
 	txndstr "github.com/textileio/powergate/v2/txndstransform"
+	"github.com/textileio/powergate/v2/util"
 )
 
 var (
@@ -27,13 +31,25 @@ var (
 	// ErrNotFound is returned when an item isn't found on a Store.
 	ErrNotFound = errors.New("item not found")
 
-	// RenewalEvalFrequency is the frequency in which renewable StorageConfigs
-	// will be evaluated.
+	// RenewalEvalFrequency is the default frequency in which renewable StorageConfigs
+	// will be evaluated, if not configured in EvalConfig.
 	RenewalEvalFrequency = time.Hour * 24
 
-	// RepairEvalFrequency is the frequency in which repairable StorageConfigs
-	// will be evaluated.
+	// RepairEvalFrequency is the default frequency in which repairable StorageConfigs
+	// will be evaluated, if not configured in EvalConfig.
 	RepairEvalFrequency = time.Hour * 24
+
+	// TrackedEvalTick is the maximum frequency in which the renewal and repair
+	// crons look for tracked StorageConfigs due for evaluation.
+	TrackedEvalTick = time.Minute
+
+	// MinEvalFrequency is the default minimum EvalFrequency of StorageConfigs,
+	// if not configured in EvalConfig.
+	MinEvalFrequency = time.Hour
+
+	// ScheduledEvalFrequency is the frequency in which Scheduled storage
+	// jobs will be evaluated to be queued.
+	ScheduledEvalFrequency = time.Minute
 )
 
 // Scheduler receives actions to store a Cid in hot and cold storage. These actions are
@@ -49,7 +65,11 @@ type Scheduler struct {
 	ts  *trackstore.Store
 	cis *cistore.Store
 	ris *ristore.Store
+	ags *aggstore.Store
 	l   ffs.JobLogger
+	en  ffs.EventNotifier
+	fi  faults.Module
+	bt  ffs.BudgetTracker
 
 	sr2RepFactor        func() (int, error)
 	dealFinalityTimeout time.Duration
@@ -57,11 +77,26 @@ type Scheduler struct {
 	gcLock sync.Mutex
 	gc     GCConfig
 
+	eval      EvalConfig
+	retention RetentionConfig
+
 	sd         storageDaemon
 	rd         retrievalDaemon
 	cancelLock sync.Mutex
 	jobsCancel map[ffs.JobID]chan struct{}
 
+	// aggrLocks serializes the evaluation of deals of aggregates, so
+	// new deals are made once for all the data stored in them.
+	aggrLocks *util.KeyedMutex
+
+	drainLock     sync.Mutex
+	draining      bool
+	drainDeadline time.Time
+	drainTimer    *time.Timer
+	// drainInterrupt is closed when the drain deadline is reached,
+	// to interrupt executing jobs.
+	drainInterrupt chan struct{}
+
 	ctx      context.Context
 	cancel   context.CancelFunc
 	finished chan struct{}
@@ -79,6 +114,9 @@ type storageDaemon struct {
 type retrievalDaemon struct {
 	rateLim       chan struct{}
 	evaluateQueue chan struct{}
+	// executing is the number of executing retrieval
+	// jobs, guarded by cancelLock.
+	executing int
 }
 
 // GCConfig provides configuration for FFS GC.
@@ -87,9 +125,72 @@ type GCConfig struct {
 	AutoGCInterval   time.Duration
 }
 
+// EvalConfig provides configuration for the renewal and repair
+// evaluations of tracked StorageConfigs. StorageConfigs with their
+// own EvalFrequency ignore these values.
+type EvalConfig struct {
+	// RenewalFrequency is the frequency in which renewable StorageConfigs
+	// are evaluated. Zero uses RenewalEvalFrequency.
+	RenewalFrequency time.Duration
+	// RepairFrequency is the frequency in which repairable StorageConfigs
+	// are evaluated. Zero uses RepairEvalFrequency.
+	RepairFrequency time.Duration
+	// MinFrequency is the minimum EvalFrequency allowed in pushed
+	// StorageConfigs. Already tracked StorageConfigs with a lower
+	// EvalFrequency are evaluated with this frequency. Zero uses
+	// MinEvalFrequency.
+	MinFrequency time.Duration
+}
+
+// RetentionConfig provides configuration for pruning final storage jobs.
+// The last successful storage job of each APIID and Cid, and storage jobs
+// that aren't final, are never pruned.
+type RetentionConfig struct {
+	// MaxAge is the maximum age of final storage jobs. Zero means no limit.
+	MaxAge time.Duration
+	// MaxPerCid is the maximum number of final storage jobs kept for
+	// each APIID and Cid. Zero means no limit.
+	MaxPerCid int
+	// PruneInterval is the interval of automatic pruning. Zero means never.
+	PruneInterval time.Duration
+}
+
+// DrainStatus describes the draining state of the Scheduler.
+type DrainStatus struct {
+	// Draining indicates that queued storage and retrieval jobs
+	// aren't being dequeued.
+	Draining bool
+	// Deadline is the time in which executing jobs will be interrupted
+	// and re-queued.
+	// It's zero if there's no deadline.
+	Deadline time.Time
+	// ExecutingStorageJobs is the number of executing storage jobs.
+	ExecutingStorageJobs int
+	// ExecutingRetrievalJobs is the number of executing retrieval jobs.
+	ExecutingRetrievalJobs int
+}
+
+// PruneReport describes the result of pruning final storage jobs.
+type PruneReport struct {
+	// PrunedJobs are the pruned storage jobs.
+	PrunedJobs []ffs.StorageJob
+	// RemovedLogEntries is the number of removed log entries
+	// of pruned storage jobs.
+	RemovedLogEntries int
+}
+
 // New returns a new instance of Scheduler which uses JobStore as its backing repository for state,
-// HotStorage for the hot layer, and ColdStorage for the cold layer.
-func New(ds datastore.TxnDatastore, l ffs.JobLogger, hs ffs.HotStorage, cs ffs.ColdStorage, maxParallel int, dealFinalityTimeout time.Duration, sr2rf func() (int, error), gcConfig GCConfig) (*Scheduler, error) {
+// HotStorage for the hot layer, and ColdStorage for the cold layer. If en isn't nil, it's
+// notified about StorageJob status changes and deal events. If fi isn't nil, repairable
+// StorageConfigs with deals in miners that start faulting are repaired immediately. If bt
+// isn't nil, new deals that would exceed the remaining budget of the user aren't proposed.
+func New(ds datastore.TxnDatastore, l ffs.JobLogger, hs ffs.HotStorage, cs ffs.ColdStorage, maxParallel int, dealFinalityTimeout time.Duration, sr2rf func() (int, error), gcConfig GCConfig, evalConfig EvalConfig, retentionConfig RetentionConfig, en ffs.EventNotifier, fi faults.Module, bt ffs.BudgetTracker) (*Scheduler, error) {
+	if evalConfig.RenewalFrequency < 0 || evalConfig.RepairFrequency < 0 || evalConfig.MinFrequency < 0 {
+		return nil, fmt.Errorf("eval frequencies can't be negative")
+	}
+	if retentionConfig.MaxAge < 0 || retentionConfig.MaxPerCid < 0 || retentionConfig.PruneInterval < 0 {
+		return nil, fmt.Errorf("retention config values can't be negative")
+	}
 	sjs, err := sjstore.New(txndstr.Wrap(ds, "sjstore"))
 	if err != nil {
 		return nil, fmt.Errorf("loading stroage jobstore: %s", err)
@@ -98,14 +199,24 @@ func New(ds datastore.TxnDatastore, l ffs.JobLogger, hs ffs.HotStorage, cs ffs.C
 	if err != nil {
 		return nil, fmt.Errorf("loading retrieval jobstore: %s", err)
 	}
+	if evalConfig.RenewalFrequency == 0 {
+		evalConfig.RenewalFrequency = RenewalEvalFrequency
+	}
+	if evalConfig.RepairFrequency == 0 {
+		evalConfig.RepairFrequency = RepairEvalFrequency
+	}
+	if evalConfig.MinFrequency == 0 {
+		evalConfig.MinFrequency = MinEvalFrequency
+	}
 	as := astore.New(txndstr.Wrap(ds, "astore"))
-	ts, err := trackstore.New(txndstr.Wrap(ds, "tstore"))
+	ts, err := trackstore.New(txndstr.Wrap(ds, "tstore"), evalConfig.MinFrequency)
 	if err != nil {
 		return nil, fmt.Errorf("loading scheduler trackstore: %s", err)
 	}
 
 	cis := cistore.New(txndstr.Wrap(ds, "cistore_v2"))
 	ris := ristore.New(txndstr.Wrap(ds, "ristore"))
+	ags := aggstore.New(txndstr.Wrap(ds, "aggstore"))
 
 	ctx, cancel := context.WithCancel(context.Background())
 	sch := &Scheduler{
@@ -120,11 +231,20 @@ func New(ds datastore.TxnDatastore, l ffs.JobLogger, hs ffs.HotStorage, cs ffs.C
 
 		cis: cis,
 		ris: ris,
+		ags: ags,
 
-		l:  l,
-		gc: gcConfig,
+		l:         l,
+		en:        en,
+		fi:        fi,
+		bt:        bt,
+		gc:        gcConfig,
+		eval:      evalConfig,
+		retention: retentionConfig,
 
 		jobsCancel: make(map[ffs.JobID]chan struct{}),
+		aggrLocks:  util.NewKeyedMutex(),
+
+		drainInterrupt: make(chan struct{}),
 		sd: storageDaemon{
 			rateLim:       make(chan struct{}, maxParallel),
 			evaluateQueue: make(chan struct{}, 1),
@@ -141,27 +261,46 @@ func New(ds datastore.TxnDatastore, l ffs.JobLogger, hs ffs.HotStorage, cs ffs.C
 		sr2RepFactor:        sr2rf,
 		dealFinalityTimeout: dealFinalityTimeout,
 	}
+	sjs.OnStatusChange(sch.notifyJobStatus)
 
 	go sch.run()
 
 	return sch, nil
 }
 
-// GetCidFromHot returns an io.Reader of the data from hot storage.
-func (s *Scheduler) GetCidFromHot(ctx context.Context, c cid.Cid) (io.Reader, error) {
-	r, err := s.hs.Get(ctx, c)
+// GetCidFromHot returns an io.Reader of the data from hot storage. The data
+// can be read from a path in the Cid, and restricted to a byte range.
+func (s *Scheduler) GetCidFromHot(ctx context.Context, c cid.Cid, p string, offset, length int64) (io.Reader, error) {
+	r, err := s.hs.Get(ctx, c, p, offset, length)
 	if err != nil {
 		return nil, fmt.Errorf("getting %s from hot storage: %s", c, err)
 	}
 	return r, nil
 }
 
-// Cancel cancels an executing Job.
+// HotCacheExpiration returns when unfrozen data of a Cid cached in hot storage
+// for an APIID expires. It returns zero time if the data isn't cached.
+func (s *Scheduler) HotCacheExpiration(ctx context.Context, iid ffs.APIID, c cid.Cid) (time.Time, error) {
+	exp, err := s.hs.CacheExpiration(ctx, iid, c)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("getting %s cache expiration from hot storage: %s", c, err)
+	}
+	return exp, nil
+}
+
+// Cancel cancels a queued, scheduled or executing storage or retrieval Job.
+// If the Job doesn't exist it returns ErrNotFound.
 func (s *Scheduler) Cancel(jid ffs.JobID) error {
 	s.cancelLock.Lock()
 	defer s.cancelLock.Unlock()
 
 	ok, err := s.sjs.CancelQueued(jid)
+	if err == sjstore.ErrNotFound {
+		ok, err = s.rjs.CancelQueued(jid)
+		if err == rjstore.ErrNotFound {
+			return ErrNotFound
+		}
+	}
 	if err != nil {
 		return fmt.Errorf("canceling potentially queued job: %s", err)
 	}
@@ -188,6 +327,158 @@ func (s *Scheduler) Cancel(jid ffs.JobID) error {
 	return nil
 }
 
+// remainingBudget returns the amount of attoFIL that can still be spent
+// in deals for a Cid, or nil if there's no limit.
+func (s *Scheduler) remainingBudget(iid ffs.APIID, c cid.Cid) (*big.Int, error) {
+	if s.bt == nil {
+		return nil, nil
+	}
+	return s.bt.Remaining(iid, c)
+}
+
+// withBudgetReserver returns a copy of ctx which reserves the cost of new
+// deals from the budgets of iid for c, if budgets are tracked.
+func (s *Scheduler) withBudgetReserver(ctx context.Context, iid ffs.APIID, c cid.Cid) context.Context {
+	if s.bt == nil {
+		return ctx
+	}
+	return ffs.WithBudgetReserver(ctx, func(amount *big.Int) (ffs.BudgetReservation, error) {
+		return s.bt.Reserve(iid, c, amount)
+	})
+}
+
+// notify notifies an Event to the configured EventNotifier, if any.
+func (s *Scheduler) notify(e ffs.Event) {
+	if s.en == nil {
+		return
+	}
+	e.Timestamp = time.Now()
+	s.en.Notify(e)
+}
+
+func (s *Scheduler) notifyJobStatus(j ffs.StorageJob) {
+	s.notify(ffs.Event{
+		Type:      ffs.EventJobStatus,
+		APIID:     j.APIID,
+		Cid:       j.Cid,
+		JobID:     j.ID,
+		JobStatus: j.Status,
+		Message:   j.ErrCause,
+	})
+}
+
+// Drain stops dequeuing queued storage and retrieval jobs, letting executing
+// jobs finish. If timeout isn't zero, jobs still executing after it are interrupted
+// and re-queued, so they're executed again when the Scheduler is undrained or
+// restarted.
+// Calling Drain while draining replaces the previous timeout.
+func (s *Scheduler) Drain(timeout time.Duration) error {
+	if timeout < 0 {
+		return fmt.Errorf("timeout can't be negative")
+	}
+	s.drainLock.Lock()
+	defer s.drainLock.Unlock()
+
+	log.Infof("draining scheduler with timeout %s", timeout)
+	s.draining = true
+	if s.drainTimer != nil {
+		s.drainTimer.Stop()
+		s.drainTimer = nil
+	}
+	s.drainDeadline = time.Time{}
+	s.resetDrainInterrupt()
+	if timeout > 0 {
+		s.drainDeadline = time.Now().Add(timeout)
+		s.drainTimer = time.AfterFunc(timeout, s.interruptExecuting)
+	}
+	return nil
+}
+
+// Undrain resumes dequeuing queued storage and retrieval jobs.
+func (s *Scheduler) Undrain() {
+	s.drainLock.Lock()
+	defer s.drainLock.Unlock()
+
+	log.Infof("undraining scheduler")
+	s.draining = false
+	s.drainDeadline = time.Time{}
+	if s.drainTimer != nil {
+		s.drainTimer.Stop()
+		s.drainTimer = nil
+	}
+	s.resetDrainInterrupt()
+
+	select {
+	case s.sd.evaluateQueue <- struct{}{}:
+	default:
+	}
+	select {
+	case s.rd.evaluateQueue <- struct{}{}:
+	default:
+	}
+}
+
+// DrainStatus returns the current draining state.
+func (s *Scheduler) DrainStatus() DrainStatus {
+	s.drainLock.Lock()
+	ds := DrainStatus{
+		Draining: s.draining,
+		Deadline: s.drainDeadline,
+	}
+	s.drainLock.Unlock()
+
+	ds.ExecutingStorageJobs = s.sjs.GetStats().TotalExecuting
+	s.cancelLock.Lock()
+	ds.ExecutingRetrievalJobs = s.rd.executing
+	s.cancelLock.Unlock()
+	return ds
+}
+
+func (s *Scheduler) isDraining() bool {
+	s.drainLock.Lock()
+	defer s.drainLock.Unlock()
+	return s.draining
+}
+
+// interruptExecuting interrupts all executing storage and retrieval jobs
+// when the drain deadline is reached. Interrupted jobs are re-queued
+// instead of being finalized.
+func (s *Scheduler) interruptExecuting() {
+	s.drainLock.Lock()
+	defer s.drainLock.Unlock()
+
+	// The drain could have been replaced or undone
+	// while the timer fired.
+	if !s.draining || s.drainDeadline.IsZero() || time.Now().Before(s.drainDeadline) {
+		return
+	}
+	log.Infof("drain deadline reached, interrupting executing jobs")
+	select {
+	case <-s.drainInterrupt:
+	default:
+		close(s.drainInterrupt)
+	}
+}
+
+// resetDrainInterrupt replaces the drain interrupt channel if it was
+// closed, so new executions aren't interrupted. This method must be
+// guarded by drainLock.
+func (s *Scheduler) resetDrainInterrupt() {
+	select {
+	case <-s.drainInterrupt:
+		s.drainInterrupt = make(chan struct{})
+	default:
+	}
+}
+
+// drainInterruptChan returns the channel that is closed when executing
+// jobs should be interrupted.
+func (s *Scheduler) drainInterruptChan() <-chan struct{} {
+	s.drainLock.Lock()
+	defer s.drainLock.Unlock()
+	return s.drainInterrupt
+}
+
 // GCStaged runs a unpinned garbage collection of stage-pins.
 func (s *Scheduler) GCStaged(ctx context.Context) ([]cid.Cid, error) {
 	return s.gcStaged(ctx, 0)
@@ -222,6 +513,13 @@ func (s *Scheduler) gcStaged(ctx context.Context, gracePeriod time.Duration) ([]
 	for _, j := range ej {
 		cids[j.Cid] = struct{}{}
 	}
+	sj, _, _, err := s.sjs.List(sjstore.ListConfig{Select: sjstore.Scheduled})
+	if err != nil {
+		return nil, fmt.Errorf("listing scheduled storage jobs: %s", err)
+	}
+	for _, j := range sj {
+		cids[j.Cid] = struct{}{}
+	}
 
 	excludedCids := make([]cid.Cid, 0, len(cids))
 	for c := range cids {
@@ -238,10 +536,68 @@ func (s *Scheduler) gcStaged(ctx context.Context, gracePeriod time.Duration) ([]
 	return gced, nil
 }
 
+// PruneStorageJobs deletes final storage jobs, and their logs, which aren't
+// retained by the provided maximum age and maximum number of storage jobs per
+// APIID and Cid. Zero values use the configured RetentionConfig values.
+func (s *Scheduler) PruneStorageJobs(ctx context.Context, maxAge time.Duration, maxPerCid int) (PruneReport, error) {
+	rp := sjstore.RetentionPolicy{MaxAge: maxAge, MaxPerCid: maxPerCid}
+	if rp.MaxAge == 0 {
+		rp.MaxAge = s.retention.MaxAge
+	}
+	if rp.MaxPerCid == 0 {
+		rp.MaxPerCid = s.retention.MaxPerCid
+	}
+	return s.prune(ctx, rp)
+}
+
+func (s *Scheduler) prune(ctx context.Context, rp sjstore.RetentionPolicy) (PruneReport, error) {
+	log.Debugf("pruning storage jobs...")
+	// If pruning fails after deleting some batches, the actions and
+	// logs of the deleted jobs are still removed.
+	pruned, pruneErr := s.sjs.Prune(rp, time.Now())
+	if pruneErr != nil && len(pruned) == 0 {
+		return PruneReport{}, fmt.Errorf("pruning storage jobs from store: %s", pruneErr)
+	}
+
+	type key struct {
+		iid ffs.APIID
+		c   cid.Cid
+	}
+	jidsByCid := map[key][]ffs.JobID{}
+	for _, j := range pruned {
+		if err := s.as.DeleteStorageAction(j.ID); err != nil {
+			return PruneReport{}, fmt.Errorf("deleting storage action of job %s: %s", j.ID, err)
+		}
+		k := key{iid: j.APIID, c: j.Cid}
+		jidsByCid[k] = append(jidsByCid[k], j.ID)
+	}
+	report := PruneReport{PrunedJobs: pruned}
+	for k, jids := range jidsByCid {
+		n, err := s.l.RemoveByJobs(ctx, k.iid, k.c, jids)
+		if err != nil {
+			return PruneReport{}, fmt.Errorf("removing logs of pruned jobs: %s", err)
+		}
+		report.RemovedLogEntries += n
+	}
+	if len(report.PrunedJobs) > 0 {
+		log.Infof("pruned %d storage jobs and %d log entries", len(report.PrunedJobs), report.RemovedLogEntries)
+	}
+	if pruneErr != nil {
+		return report, fmt.Errorf("pruning storage jobs from store: %s", pruneErr)
+	}
+
+	return report, nil
+}
+
 // Close terminates the scheduler.
 func (s *Scheduler) Close() error {
 	log.Info("closing...")
 	defer log.Info("closed")
+	s.drainLock.Lock()
+	if s.drainTimer != nil {
+		s.drainTimer.Stop()
+	}
+	s.drainLock.Unlock()
 	s.cancel()
 	<-s.finished
 	if err := s.sjs.Close(); err != nil {
@@ -276,6 +632,28 @@ func (s *Scheduler) run() {
 		}
 	}()
 
+	// Timer for pruning final storage jobs.
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+
+		if s.retention.PruneInterval == 0 || (s.retention.MaxAge == 0 && s.retention.MaxPerCid == 0) {
+			return
+		}
+
+		for {
+			select {
+			case <-s.ctx.Done():
+				return
+			case <-time.After(s.retention.PruneInterval):
+				rp := sjstore.RetentionPolicy{MaxAge: s.retention.MaxAge, MaxPerCid: s.retention.MaxPerCid}
+				if _, err := s.prune(s.ctx, rp); err != nil {
+					log.Errorf("automatic storage jobs pruning: %s", err)
+				}
+			}
+		}
+	}()
+
 	if err := s.resumeStartedDeals(); err != nil {
 		log.Errorf("resuming started deals: %s", err)
 		return
@@ -285,14 +663,16 @@ func (s *Scheduler) run() {
 	wg.Add(1)
 	go func() {
 		defer wg.Done()
+		last := time.Now()
 		for {
 			select {
 			case <-s.ctx.Done():
 				return
-			case <-time.After(RenewalEvalFrequency):
+			case now := <-time.After(evalTick(s.eval.RenewalFrequency)):
 				log.Debug("running renewal checks...")
-				s.execRenewCron(s.ctx)
+				s.execRenewCron(s.ctx, last, now)
 				log.Debug("renewal cron done")
+				last = now
 			}
 		}
 	}()
@@ -301,14 +681,39 @@ func (s *Scheduler) run() {
 	wg.Add(1)
 	go func() {
 		defer wg.Done()
+		last := time.Now()
 		for {
 			select {
 			case <-s.ctx.Done():
 				return
-			case <-time.After(RepairEvalFrequency):
+			case now := <-time.After(evalTick(s.eval.RepairFrequency)):
 				log.Debug("running repair checks...")
-				s.execRepairCron(s.ctx)
+				s.execRepairCron(s.ctx, last, now)
 				log.Debug("repair cron done")
+				last = now
+			}
+		}
+	}()
+
+	// Loop for repairing storage configs with deals in faulting miners.
+	if s.fi != nil {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			s.watchFaults()
+		}()
+	}
+
+	// Timer for queueing due scheduled storage jobs.
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		for {
+			select {
+			case <-s.ctx.Done():
+				return
+			case <-time.After(ScheduledEvalFrequency):
+				s.queueDueScheduled()
 			}
 		}
 	}()
@@ -387,13 +792,24 @@ func (s *Scheduler) resumeStartedDeals() error {
 	return nil
 }
 
-// execRepairCron gets all repairable storage configs and
-// reschedule them as if they were pushed. The scheduler main executing logic
-// does whatever work is necessary to satisfy the storage config, thus
-// it has repairing semantics too. If no work is needed, this scheduled
-// job would have no real work done.
-func (s *Scheduler) execRepairCron(ctx context.Context) {
-	tcids, err := s.ts.GetRepairables()
+// evalTick returns how often the renewal or repair crons should look for
+// due tracked StorageConfigs given their default evaluation frequency.
+func evalTick(frequency time.Duration) time.Duration {
+	if frequency < TrackedEvalTick {
+		return frequency
+	}
+	return TrackedEvalTick
+}
+
+// execRepairCron gets all repairable storage configs due for evaluation
+// in the (from, to] window and reschedule them as if they were pushed.
+// The scheduler main executing logic does whatever work is necessary to
+// satisfy the storage config, thus it has repairing semantics too. If no
+// work is needed, this scheduled job would have no real work done. Repair
+// jobs are queued with urgent priority so they aren't delayed by user
+// pushed jobs.
+func (s *Scheduler) execRepairCron(ctx context.Context, from, to time.Time) {
+	tcids, err := s.ts.GetRepairablesDue(from, to, s.eval.RepairFrequency)
 	if err != nil {
 		log.Errorf("getting repairable cid configs from store: %s", err)
 		return
@@ -403,7 +819,7 @@ func (s *Scheduler) execRepairCron(ctx context.Context) {
 			lCtx := context.WithValue(ctx, ffs.CtxStorageCid, tc.Cid)
 			lCtx = context.WithValue(lCtx, ffs.CtxAPIID, sc.IID)
 			s.l.Log(lCtx, "Scheduling deal repair evaluation...")
-			jid, err := s.push(sc.IID, tc.Cid, sc.StorageConfig, cid.Undef)
+			jid, err := s.push(sc.IID, tc.Cid, sc.StorageConfig, cid.Undef, ffs.PriorityUrgent, time.Time{})
 			if err != nil {
 				s.l.Log(lCtx, "Scheduling deal repair errored: %s", err)
 			} else {
@@ -413,11 +829,13 @@ func (s *Scheduler) execRepairCron(ctx context.Context) {
 	}
 }
 
-// execRenewCron gets all renewable storage configs and
-// reschedule them as if they were pushed. The scheduler main executing logic
-// will do renewals if necessary.
-func (s *Scheduler) execRenewCron(ctx context.Context) {
-	tcids, err := s.ts.GetRenewables()
+// execRenewCron gets all renewable storage configs due for evaluation
+// in the (from, to] window and reschedule them as if they were pushed.
+// The scheduler main executing logic will do renewals if necessary.
+// Renewal jobs are queued with urgent priority so they aren't delayed
+// by user pushed jobs.
+func (s *Scheduler) execRenewCron(ctx context.Context, from, to time.Time) {
+	tcids, err := s.ts.GetRenewablesDue(from, to, s.eval.RenewalFrequency)
 	if err != nil {
 		log.Errorf("getting repairable cid configs from store: %s", err)
 	}
@@ -426,7 +844,7 @@ func (s *Scheduler) execRenewCron(ctx context.Context) {
 			lCtx := context.WithValue(ctx, ffs.CtxStorageCid, tc.Cid)
 			lCtx = context.WithValue(lCtx, ffs.CtxAPIID, sc.IID)
 			s.l.Log(lCtx, "Scheduling deal renew evaluation...")
-			jid, err := s.push(sc.IID, tc.Cid, sc.StorageConfig, cid.Undef)
+			jid, err := s.push(sc.IID, tc.Cid, sc.StorageConfig, cid.Undef, ffs.PriorityUrgent, time.Time{})
 			if err != nil {
 				s.l.Log(lCtx, "Scheduling deal renewal errored: %s", err)
 			} else {
@@ -436,7 +854,29 @@ func (s *Scheduler) execRenewCron(ctx context.Context) {
 	}
 }
 
+// queueDueScheduled queues all Scheduled storage jobs which
+// reached their NotBefore time.
+func (s *Scheduler) queueDueScheduled() {
+	n, err := s.sjs.QueueDue(time.Now())
+	if err != nil {
+		log.Errorf("queueing due scheduled jobs: %s", err)
+		return
+	}
+	if n == 0 {
+		return
+	}
+	log.Debugf("queued %d due scheduled jobs", n)
+	select {
+	case s.sd.evaluateQueue <- struct{}{}:
+	default:
+	}
+}
+
 func (s *Scheduler) execQueuedStorages(ctx context.Context) {
+	if s.isDraining() {
+		return
+	}
+
 	var err error
 	var j *ffs.StorageJob
 
@@ -498,14 +938,23 @@ func (s *Scheduler) executeQueuedStorage(j ffs.StorageJob) {
 	ctx = context.WithValue(ctx, ffs.CtxAPIID, j.APIID)
 
 	var cancelLock sync.Mutex
-	var canceled bool
+	var canceled, interrupted bool
+	interrupt := s.drainInterruptChan()
 	go func() {
 		// If the user called Cancel to cancel Job execution,
-		// we cancel the context to finish.
-		<-cancelChan
-		cancelLock.Lock()
-		canceled = true
-		cancelLock.Unlock()
+		// or the drain deadline was reached, we cancel the
+		// context to finish.
+		select {
+		case <-cancelChan:
+			cancelLock.Lock()
+			canceled = true
+			cancelLock.Unlock()
+		case <-interrupt:
+			cancelLock.Lock()
+			interrupted = true
+			cancelLock.Unlock()
+		case <-ctx.Done():
+		}
 		cancel()
 	}()
 
@@ -528,11 +977,31 @@ func (s *Scheduler) executeQueuedStorage(j ffs.StorageJob) {
 	// Something bad-enough happened to make Job
 	// execution fail.
 	if err != nil {
+		// Interrupted jobs are executed again, which resumes
+		// any started deals.
+		cancelLock.Lock()
+		wasInterrupted := interrupted && !canceled
+		cancelLock.Unlock()
+		if wasInterrupted {
+			if err := s.sjs.Requeue(j.ID); err != nil {
+				log.Errorf("re-queuing interrupted job %s: %s", j.ID, err)
+			}
+			s.l.Log(ctx, "Job %s was interrupted by draining, and will be executed again.", j.ID)
+			return
+		}
+
 		log.Errorf("executing job %s: %s", j.ID, err)
 		if err := s.sjs.Finalize(j.ID, ffs.Failed, err, dealErrors); err != nil {
 			log.Errorf("changing job to failed: %s", err)
 		}
 		s.l.Log(ctx, "Job %s execution failed: %s", j.ID, err)
+
+		// Canceled jobs also fail, but they shouldn't be retried.
+		cancelLock.Lock()
+		if !canceled {
+			s.scheduleRetry(ctx, j, a)
+		}
+		cancelLock.Unlock()
 		return
 	}
 	// Save whatever stored information was completely/partially
@@ -558,6 +1027,10 @@ func (s *Scheduler) executeQueuedStorage(j ffs.StorageJob) {
 }
 
 func (s *Scheduler) execQueuedRetrievals(ctx context.Context) {
+	if s.isDraining() {
+		return
+	}
+
 	var err error
 	var j *ffs.RetrievalJob
 
@@ -606,20 +1079,37 @@ func (s *Scheduler) executeQueuedRetrievals(j ffs.RetrievalJob) {
 	// Create chan to allow Job cancellation.
 	s.cancelLock.Lock()
 	s.jobsCancel[j.ID] = cancelChan
+	s.rd.executing++
 	s.cancelLock.Unlock()
 	defer func() {
 		s.cancelLock.Lock()
 		delete(s.jobsCancel, j.ID)
+		s.rd.executing--
 		s.cancelLock.Unlock()
 	}()
 
 	ctx, cancel := context.WithCancel(context.WithValue(context.Background(), ffs.CtxKeyJid, j.ID))
 	defer cancel()
 	ctx = context.WithValue(ctx, ffs.CtxRetrievalID, j.RetrievalID)
+
+	var cancelLock sync.Mutex
+	var canceled, interrupted bool
+	interrupt := s.drainInterruptChan()
 	go func() {
 		// If the user called Cancel to cancel Job execution,
-		// we cancel the context to finish.
-		<-cancelChan
+		// or the drain deadline was reached, we cancel the
+		// context to finish.
+		select {
+		case <-cancelChan:
+			cancelLock.Lock()
+			canceled = true
+			cancelLock.Unlock()
+		case <-interrupt:
+			cancelLock.Lock()
+			interrupted = true
+			cancelLock.Unlock()
+		case <-ctx.Done():
+		}
 		cancel()
 	}()
 
@@ -636,11 +1126,22 @@ func (s *Scheduler) executeQueuedRetrievals(j ffs.RetrievalJob) {
 
 	// Execute
 	s.l.Log(ctx, "Executing job %s...", j.ID)
-	info, err := s.executeRetrieval(ctx, a, j)
+	info, err := s.executeRetrieval(ctx, a)
 
 	// Something bad-enough happened to make Job
 	// execution fail.
 	if err != nil {
+		cancelLock.Lock()
+		wasInterrupted := interrupted && !canceled
+		cancelLock.Unlock()
+		if wasInterrupted {
+			if err := s.rjs.Enqueue(j); err != nil {
+				log.Errorf("re-queuing interrupted retrieval job %s: %s", j.ID, err)
+			}
+			s.l.Log(ctx, "Job %s was interrupted by draining, and will be executed again.", j.ID)
+			return
+		}
+
 		log.Errorf("executing retrieval job %s: %s", j.ID, err)
 		if err := s.rjs.Finalize(j.ID, ffs.Failed, err); err != nil {
 			log.Errorf("changing retrieval job status to failed: %s", err)
@@ -656,11 +1157,11 @@ func (s *Scheduler) executeQueuedRetrievals(j ffs.RetrievalJob) {
 
 	finalStatus := ffs.Success
 	// Detect if user-cancelation was triggered
-	select {
-	case <-cancelChan:
+	cancelLock.Lock()
+	if canceled {
 		finalStatus = ffs.Canceled
-	default:
 	}
+	cancelLock.Unlock()
 
 	// Finalize Job, saving any deals errors happened during execution.
 	if err := s.rjs.Finalize(j.ID, finalStatus, nil); err != nil {
@@ -0,0 +1,89 @@
+package scheduler
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ipfs/go-cid"
+	"github.com/textileio/powergate/v2/ffs"
+	"github.com/textileio/powergate/v2/ffs/scheduler/internal/cistore"
+)
+
+// addAggregateDeals adds to curr the active deals of its aggregate which were
+// made by other data stored in it.
+func (s *Scheduler) addAggregateDeals(ctx context.Context, curr ffs.ColdInfo) (ffs.ColdInfo, error) {
+	aggDeals, err := s.ags.Get(curr.Filecoin.DataCid)
+	if err != nil {
+		return ffs.ColdInfo{}, fmt.Errorf("getting aggregate deals: %s", err)
+	}
+	known := make(map[uint64]struct{}, len(curr.Filecoin.Proposals))
+	for _, p := range curr.Filecoin.Proposals {
+		known[p.DealID] = struct{}{}
+	}
+	var missing ffs.ColdInfo
+	for _, p := range aggDeals {
+		if _, ok := known[p.DealID]; !ok {
+			missing.Filecoin.Proposals = append(missing.Filecoin.Proposals, p)
+		}
+	}
+	if len(missing.Filecoin.Proposals) == 0 {
+		return curr, nil
+	}
+	missing, err = s.getRefreshedColdInfo(ctx, missing)
+	if err != nil {
+		return ffs.ColdInfo{}, fmt.Errorf("refreshing aggregate deals: %s", err)
+	}
+	if len(missing.Filecoin.Proposals) > 0 {
+		s.l.Log(ctx, "Adding %d deals made for the aggregate by other data stored in it.", len(missing.Filecoin.Proposals))
+	}
+	curr.Filecoin.Proposals = append(missing.Filecoin.Proposals, curr.Filecoin.Proposals...)
+	return curr, nil
+}
+
+// addAggregateRef saves that data of an APIID was stored in an aggregate,
+// so the aggregate isn't released while the data is tracked.
+func (s *Scheduler) addAggregateRef(ctx context.Context, root cid.Cid, iid ffs.APIID, c cid.Cid) error {
+	unlock, err := s.aggrLocks.Lock(ctx, root.String())
+	if err != nil {
+		return fmt.Errorf("locking aggregate: %s", err)
+	}
+	defer unlock()
+	if err := s.ags.AddRef(root, iid, c); err != nil {
+		return fmt.Errorf("adding aggregate reference: %s", err)
+	}
+	return nil
+}
+
+// removeAggregateRef removes the reference of data of an APIID to its
+// aggregate, if it was aggregated. The aggregate is released when no
+// other data references it.
+func (s *Scheduler) removeAggregateRef(ctx context.Context, iid ffs.APIID, c cid.Cid) error {
+	info, err := s.cis.Get(iid, c)
+	if err == cistore.ErrNotFound {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("getting storage info: %s", err)
+	}
+	if !info.Cold.Filecoin.Aggregated() {
+		return nil
+	}
+	root := info.Cold.Filecoin.DataCid
+	unlock, err := s.aggrLocks.Lock(ctx, root.String())
+	if err != nil {
+		return fmt.Errorf("locking aggregate: %s", err)
+	}
+	defer unlock()
+	remaining, err := s.ags.RemoveRef(root, iid, c)
+	if err != nil {
+		return fmt.Errorf("removing aggregate reference: %s", err)
+	}
+	if remaining > 0 {
+		return nil
+	}
+	if err := s.cs.ReleaseAggregate(ctx, root); err != nil {
+		return fmt.Errorf("releasing aggregate %s: %s", root, err)
+	}
+	log.Infof("released aggregate %s since no data references it", root)
+	return nil
+}
@@ -0,0 +1,86 @@
+package scheduler
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ipfs/go-cid"
+	"github.com/stretchr/testify/require"
+	"github.com/textileio/powergate/v2/deals"
+	"github.com/textileio/powergate/v2/ffs"
+)
+
+func TestAggregateDealsMadeOnce(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	cs := newColdStorage()
+	cs.block = make(chan struct{})
+	s := newScheduler(t, newHotStorage(), cs)
+	root := randomCid()
+	cfg := coldOnlyConfig()
+
+	// Data stored in the same aggregate needs new deals at the same time,
+	// e.g. to repair a faulted deal.
+	var wg sync.WaitGroup
+	res := make([]ffs.ColdInfo, 3)
+	errs := make([]error, len(res))
+	for i := range res {
+		i := i
+		curr := ffs.StorageInfo{
+			APIID: ffs.NewAPIID(),
+			Cid:   randomCid(),
+			Cold: ffs.ColdInfo{
+				Enabled:  true,
+				Filecoin: ffs.FilInfo{DataCid: root, AggregatePath: fmt.Sprintf("/%d", i)},
+			},
+		}
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			dealUpdates := make(chan deals.StorageDealInfo, 10)
+			res[i], _, errs[i] = s.executeColdStorage(ctx, curr, cfg.Cold, 0, 0, dealUpdates)
+		}()
+	}
+	time.Sleep(time.Millisecond * 100)
+	close(cs.block)
+	wg.Wait()
+
+	// Deals are made once for the aggregate, and shared by all of its data.
+	require.Len(t, cs.proposals, 1)
+	for i, cold := range res {
+		require.NoError(t, errs[i])
+		require.Equal(t, root, cold.Filecoin.DataCid)
+		require.Equal(t, fmt.Sprintf("/%d", i), cold.Filecoin.AggregatePath)
+		require.Len(t, cold.Filecoin.Proposals, 1)
+		require.Equal(t, res[0].Filecoin.Proposals[0].DealID, cold.Filecoin.Proposals[0].DealID)
+	}
+	require.False(t, s.aggrLocks.InUse(root.String()))
+}
+
+func TestAggregateReleasedByLastRef(t *testing.T) {
+	t.Parallel()
+	cs := newColdStorage()
+	cs.aggRoot = randomCid()
+	s := newScheduler(t, newHotStorage(), cs)
+	cfg := coldOnlyConfig().WithColdFilAggregate(true)
+
+	iids := []ffs.APIID{ffs.NewAPIID(), ffs.NewAPIID()}
+	cids := []cid.Cid{randomCid(), randomCid()}
+	for i := range iids {
+		jid, err := s.PushConfig(iids[i], cids[i], cfg, ffs.PriorityNormal, time.Time{})
+		require.NoError(t, err)
+		waitForJob(t, s, jid, ffs.Success)
+		info, err := s.GetStorageInfo(iids[i], cids[i])
+		require.NoError(t, err)
+		require.Equal(t, cs.aggRoot, info.Cold.Filecoin.DataCid)
+	}
+
+	// The aggregate is released when the last data stored in it is untracked.
+	require.NoError(t, s.Untrack(iids[0], cids[0]))
+	require.Empty(t, cs.released)
+	require.NoError(t, s.Untrack(iids[1], cids[1]))
+	require.Equal(t, []cid.Cid{cs.aggRoot}, cs.released)
+}
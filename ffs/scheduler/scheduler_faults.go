@@ -0,0 +1,145 @@
+package scheduler
+
+import (
+	"context"
+	"time"
+
+	"github.com/ipfs/go-cid"
+	"github.com/textileio/powergate/v2/ffs"
+	"github.com/textileio/powergate/v2/ffs/scheduler/internal/cistore"
+	"github.com/textileio/powergate/v2/index/faults"
+)
+
+// watchFaults listens to faults index updates and queues repair
+// jobs for repairable Cids with deals in miners which started faulting.
+func (s *Scheduler) watchFaults() {
+	sub := s.fi.Listen()
+	defer s.fi.Unregister(sub)
+
+	idx := s.fi.Get()
+	last := lastFaultEpochs(idx)
+	// If the index wasn't built yet, the first update contains all the
+	// faults history, so it's only used as the baseline for new faults.
+	warm := idx.Height > 0
+	for {
+		select {
+		case <-s.ctx.Done():
+			return
+		case _, ok := <-sub:
+			if !ok {
+				return
+			}
+			idx := s.fi.Get()
+			if !warm {
+				last = lastFaultEpochs(idx)
+				warm = idx.Height > 0
+				continue
+			}
+			faulted := make(map[string]int64)
+			for miner, epoch := range recentFaultEpochs(idx) {
+				if prev, ok := last[miner]; !ok || epoch > prev {
+					faulted[miner] = epoch
+				}
+			}
+			last = lastFaultEpochs(idx)
+			if len(faulted) > 0 {
+				log.Infof("detected %d new faulting miners, evaluating repairs...", len(faulted))
+				s.execFaultRepairs(s.ctx, faulted)
+			}
+		}
+	}
+}
+
+// execFaultRepairs queues repair jobs for repairable storage configs of Cids
+// which have active deals with miners that faulted after the deal started.
+// faulted contains the last fault epoch of each faulted miner. Repair jobs are
+// queued with urgent priority, in the same way as in execRepairCron.
+func (s *Scheduler) execFaultRepairs(ctx context.Context, faulted map[string]int64) {
+	tcids, err := s.ts.GetRepairables()
+	if err != nil {
+		log.Errorf("getting repairable cid configs from store: %s", err)
+		return
+	}
+	for _, tc := range tcids {
+		for _, sc := range tc.Tracked {
+			info, err := s.cis.Get(sc.IID, tc.Cid)
+			if err == cistore.ErrNotFound {
+				continue
+			}
+			if err != nil {
+				log.Errorf("getting storage info of %s: %s", tc.Cid, err)
+				continue
+			}
+			var miner string
+			for _, p := range info.Cold.Filecoin.Proposals {
+				if epoch, ok := faulted[p.Miner]; ok && !p.Faulted && epoch >= int64(p.StartEpoch) {
+					miner = p.Miner
+					break
+				}
+			}
+			if miner == "" {
+				continue
+			}
+
+			lCtx := context.WithValue(ctx, ffs.CtxStorageCid, tc.Cid)
+			lCtx = context.WithValue(lCtx, ffs.CtxAPIID, sc.IID)
+			s.l.Log(lCtx, "Miner %s holding a deal started faulting, scheduling deal repair evaluation...", miner)
+			jid, err := s.push(sc.IID, tc.Cid, sc.StorageConfig, cid.Undef, ffs.PriorityUrgent, time.Time{})
+			if err != nil {
+				s.l.Log(lCtx, "Scheduling deal repair errored: %s", err)
+			} else {
+				s.l.Log(lCtx, "Job %s was queued for repair evaluation.", jid)
+			}
+		}
+	}
+}
+
+// markFaultedDeals marks the deals whose miner has recent faults after the
+// deal started as faulted, so they're replaced with deals with other miners.
+// Deals of miners which recovered are marked as active again.
+func (s *Scheduler) markFaultedDeals(ctx context.Context, curr ffs.ColdInfo) ffs.ColdInfo {
+	if s.fi == nil {
+		return curr
+	}
+	epochs := recentFaultEpochs(s.fi.Get())
+	proposals := make([]ffs.FilStorage, len(curr.Filecoin.Proposals))
+	for i, p := range curr.Filecoin.Proposals {
+		epoch, ok := epochs[p.Miner]
+		faulted := ok && epoch >= int64(p.StartEpoch)
+		if faulted && !p.Faulted {
+			s.l.Log(ctx, "Detected that miner %s of deal %d is faulting, marking deal as faulted.", p.Miner, p.DealID)
+		}
+		if !faulted && p.Faulted {
+			s.l.Log(ctx, "Detected that miner %s of deal %d recovered from faults, marking deal as active.", p.Miner, p.DealID)
+		}
+		p.Faulted = faulted
+		proposals[i] = p
+	}
+	curr.Filecoin.Proposals = proposals
+	return curr
+}
+
+// recentFaultEpochs returns the last fault epoch of miners in the index which
+// have faults in the recent faults window, so they haven't recovered yet.
+func recentFaultEpochs(idx faults.IndexSnapshot) map[string]int64 {
+	res := lastFaultEpochs(idx)
+	for miner, epoch := range res {
+		if idx.Height == 0 || epoch <= idx.Height-faults.RecentFaultsWindow {
+			delete(res, miner)
+		}
+	}
+	return res
+}
+
+// lastFaultEpochs returns the last fault epoch of each miner in the index.
+func lastFaultEpochs(idx faults.IndexSnapshot) map[string]int64 {
+	res := make(map[string]int64, len(idx.Miners))
+	for miner, f := range idx.Miners {
+		for _, epoch := range f.Epochs {
+			if last, ok := res[miner]; !ok || epoch > last {
+				res[miner] = epoch
+			}
+		}
+	}
+	return res
+}
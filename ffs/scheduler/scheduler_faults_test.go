@@ -0,0 +1,214 @@
+package scheduler
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/textileio/powergate/v2/ffs"
+	"github.com/textileio/powergate/v2/ffs/joblogger"
+	"github.com/textileio/powergate/v2/index/faults"
+	"github.com/textileio/powergate/v2/signaler"
+	"github.com/textileio/powergate/v2/tests"
+	txndstr "github.com/textileio/powergate/v2/txndstransform"
+)
+
+func TestFaultRepairs(t *testing.T) {
+	t.Parallel()
+	fi := newFaultsIndex()
+	s := newSchedulerWithFaults(t, newHotStorage(), newColdStorage(), fi)
+	iid := ffs.NewAPIID()
+	c := randomCid()
+
+	cfg := coldOnlyConfig().WithRepairable(true)
+	jid, err := s.PushConfig(iid, c, cfg, ffs.PriorityNormal, time.Time{})
+	require.NoError(t, err)
+	waitForJob(t, s, jid, ffs.Success)
+	info, err := s.GetStorageInfo(iid, c)
+	require.NoError(t, err)
+	require.Len(t, info.Cold.Filecoin.Proposals, 1)
+	miner := info.Cold.Filecoin.Proposals[0].Miner
+
+	// The first update of a cold index is the whole faults
+	// history, so it doesn't trigger repairs.
+	fi.update(5000, map[string][]int64{miner: {4000}})
+	require.Never(t, func() bool {
+		return len(listJobs(s, iid, All)) != 1
+	}, time.Millisecond*300, time.Millisecond*10)
+
+	// A new fault of the miner triggers an urgent repair, which
+	// marks the deal as faulted and replaces it.
+	fi.update(5100, map[string][]int64{miner: {4000, 5050}})
+	var repair ffs.StorageJob
+	require.Eventually(t, func() bool {
+		for _, j := range listJobs(s, iid, All) {
+			if j.ID != jid {
+				repair = j
+				return true
+			}
+		}
+		return false
+	}, time.Second*5, time.Millisecond*10)
+	require.Equal(t, ffs.PriorityUrgent, repair.Priority)
+	waitForJob(t, s, repair.ID, ffs.Success)
+	info, err = s.GetStorageInfo(iid, c)
+	require.NoError(t, err)
+	require.Len(t, info.Cold.Filecoin.Proposals, 2)
+	require.Equal(t, 1, info.Cold.Filecoin.ActiveDeals())
+	for _, p := range info.Cold.Filecoin.Proposals {
+		require.Equal(t, p.Miner == miner, p.Faulted)
+	}
+
+	// Once the miner recovers, its deal is active again.
+	fi.update(5100+faults.RecentFaultsWindow, map[string][]int64{miner: {4000, 5050}})
+	jid, err = s.PushConfig(iid, c, cfg, ffs.PriorityNormal, time.Time{})
+	require.NoError(t, err)
+	waitForJob(t, s, jid, ffs.Success)
+	info, err = s.GetStorageInfo(iid, c)
+	require.NoError(t, err)
+	require.Equal(t, 2, info.Cold.Filecoin.ActiveDeals())
+}
+
+func TestMarkFaultedDeals(t *testing.T) {
+	t.Parallel()
+	fi := newFaultsIndex()
+	s := newSchedulerWithFaults(t, newHotStorage(), newColdStorage(), fi)
+	height := int64(100000)
+	fi.update(height, map[string][]int64{
+		// f01 has recent faults after its deal started.
+		"f01": {height - 10},
+		// f02 recovered from old faults.
+		"f02": {height - faults.RecentFaultsWindow - 10},
+		// f03 faulted before its deal started.
+		"f03": {height - 100},
+	})
+	curr := ffs.ColdInfo{
+		Filecoin: ffs.FilInfo{
+			Proposals: []ffs.FilStorage{
+				{DealID: 1, Miner: "f01", StartEpoch: 1000},
+				{DealID: 2, Miner: "f02", StartEpoch: 1000, Faulted: true},
+				{DealID: 3, Miner: "f03", StartEpoch: uint64(height - 50)},
+				{DealID: 4, Miner: "f04", StartEpoch: 1000},
+			},
+		},
+	}
+	res := s.markFaultedDeals(context.Background(), curr)
+	var faulted []uint64
+	for _, p := range res.Filecoin.Proposals {
+		if p.Faulted {
+			faulted = append(faulted, p.DealID)
+		}
+	}
+	require.Equal(t, []uint64{1}, faulted)
+	require.Equal(t, 3, res.Filecoin.ActiveDeals())
+	// The original deals aren't modified.
+	require.True(t, curr.Filecoin.Proposals[1].Faulted)
+	require.False(t, curr.Filecoin.Proposals[0].Faulted)
+
+	// Without a built index, no miner is considered faulted.
+	fi.update(0, map[string][]int64{"f01": {10}})
+	res = s.markFaultedDeals(context.Background(), res)
+	require.Equal(t, 4, res.Filecoin.ActiveDeals())
+}
+
+func TestExecFaultRepairs(t *testing.T) {
+	t.Parallel()
+	fi := newFaultsIndex()
+	s := newSchedulerWithFaults(t, newHotStorage(), newColdStorage(), fi)
+	iid := ffs.NewAPIID()
+	repairable, notRepairable := randomCid(), randomCid()
+
+	cfg := coldOnlyConfig().WithRepairable(true)
+	jid, err := s.PushConfig(iid, repairable, cfg, ffs.PriorityNormal, time.Time{})
+	require.NoError(t, err)
+	waitForJob(t, s, jid, ffs.Success)
+	jid, err = s.PushConfig(iid, notRepairable, coldOnlyConfig(), ffs.PriorityNormal, time.Time{})
+	require.NoError(t, err)
+	waitForJob(t, s, jid, ffs.Success)
+	info, err := s.GetStorageInfo(iid, repairable)
+	require.NoError(t, err)
+	deal := info.Cold.Filecoin.Proposals[0]
+	info, err = s.GetStorageInfo(iid, notRepairable)
+	require.NoError(t, err)
+	otherDeal := info.Cold.Filecoin.Proposals[0]
+
+	// Faults before the deal started don't queue repairs.
+	s.execFaultRepairs(context.Background(), map[string]int64{deal.Miner: int64(deal.StartEpoch) - 1})
+	require.Len(t, listJobs(s, iid, All), 2)
+
+	// Only repairable configs are repaired.
+	faulted := map[string]int64{
+		deal.Miner:      int64(deal.StartEpoch) + 1,
+		otherDeal.Miner: int64(otherDeal.StartEpoch) + 1,
+	}
+	s.execFaultRepairs(context.Background(), faulted)
+	jobs := listJobs(s, iid, All)
+	require.Len(t, jobs, 3)
+	var repairs int
+	for _, j := range jobs {
+		if j.Priority == ffs.PriorityUrgent {
+			require.Equal(t, repairable, j.Cid)
+			repairs++
+		}
+	}
+	require.Equal(t, 1, repairs)
+}
+
+func TestWatchFaultsUnregister(t *testing.T) {
+	t.Parallel()
+	fi := newFaultsIndex()
+	ds := tests.NewTxMapDatastore()
+	l := joblogger.New(txndstr.Wrap(ds, "joblogger"))
+	s, err := New(txndstr.Wrap(ds, "scheduler"), l, newHotStorage(), newColdStorage(), 10, time.Minute, nil, GCConfig{}, EvalConfig{}, RetentionConfig{}, nil, fi, nil)
+	require.NoError(t, err)
+	require.NoError(t, s.Close())
+
+	fi.lock.Lock()
+	defer fi.lock.Unlock()
+	require.Equal(t, 1, fi.unregistered)
+}
+
+type faultsIndex struct {
+	lock         sync.Mutex
+	idx          faults.IndexSnapshot
+	sig          *signaler.Signaler
+	unregistered int
+}
+
+var _ faults.Module = (*faultsIndex)(nil)
+
+func newFaultsIndex() *faultsIndex {
+	return &faultsIndex{sig: signaler.New()}
+}
+
+func (fi *faultsIndex) Get() faults.IndexSnapshot {
+	fi.lock.Lock()
+	defer fi.lock.Unlock()
+	return fi.idx
+}
+
+func (fi *faultsIndex) Listen() <-chan struct{} {
+	return fi.sig.Listen()
+}
+
+func (fi *faultsIndex) Unregister(c <-chan struct{}) {
+	fi.lock.Lock()
+	fi.unregistered++
+	fi.lock.Unlock()
+	fi.sig.Unregister(c)
+}
+
+// update replaces the index with the provided faults at height, and
+// signals listeners.
+func (fi *faultsIndex) update(height int64, epochs map[string][]int64) {
+	idx := faults.IndexSnapshot{Height: height, Miners: make(map[string]faults.Faults, len(epochs))}
+	for miner, es := range epochs {
+		idx.Miners[miner] = faults.Faults{Epochs: es}
+	}
+	fi.lock.Lock()
+	fi.idx = idx
+	fi.lock.Unlock()
+	fi.sig.Signal()
+}
@@ -3,15 +3,17 @@ package scheduler
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/ipfs/go-cid"
 	"github.com/textileio/powergate/v2/ffs"
 	"github.com/textileio/powergate/v2/ffs/scheduler/internal/astore"
 	"github.com/textileio/powergate/v2/ffs/scheduler/internal/ristore"
+	"github.com/textileio/powergate/v2/ffs/scheduler/internal/rjstore"
 )
 
 // StartRetrieval schedules a new RetrievalJob to execute a Filecoin retrieval.
-func (s *Scheduler) StartRetrieval(iid ffs.APIID, rid ffs.RetrievalID, pyCid, piCid cid.Cid, sel string, miners []string, walletAddr string, maxPrice uint64) (ffs.JobID, error) {
+func (s *Scheduler) StartRetrieval(iid ffs.APIID, rid ffs.RetrievalID, pyCid, piCid cid.Cid, sel string, miners []string, walletAddr string, maxPrice uint64, cacheTTL int) (ffs.JobID, error) {
 	if iid == ffs.EmptyInstanceID {
 		return ffs.EmptyJobID, fmt.Errorf("empty API ID")
 	}
@@ -24,12 +26,18 @@ func (s *Scheduler) StartRetrieval(iid ffs.APIID, rid ffs.RetrievalID, pyCid, pi
 	if piCid == cid.Undef {
 		return ffs.EmptyJobID, fmt.Errorf("piece cid is undefined")
 	}
+	if sel != "" {
+		return ffs.EmptyJobID, fmt.Errorf("selectors aren't supported yet")
+	}
 	if len(miners) == 0 {
 		return ffs.EmptyJobID, fmt.Errorf("miner list can't be empty")
 	}
 	if walletAddr == "" {
 		return ffs.EmptyJobID, fmt.Errorf("wallet address can't be empty")
 	}
+	if cacheTTL < 0 {
+		return ffs.EmptyJobID, fmt.Errorf("cache ttl should be greater or equal to zero")
+	}
 
 	jid := ffs.NewJobID()
 	j := ffs.RetrievalJob{
@@ -52,6 +60,7 @@ func (s *Scheduler) StartRetrieval(iid ffs.APIID, rid ffs.RetrievalID, pyCid, pi
 		Miners:        miners,
 		WalletAddress: walletAddr,
 		MaxPrice:      maxPrice,
+		CacheTTL:      cacheTTL,
 	}
 	if err := s.as.PutRetrievalAction(j.ID, ra); err != nil {
 		return ffs.EmptyJobID, fmt.Errorf("saving retrieval action for job: %s", err)
@@ -82,19 +91,84 @@ func (s *Scheduler) GetRetrievalInfo(rid ffs.RetrievalID) (ffs.RetrievalInfo, er
 	return info, nil
 }
 
-func (s *Scheduler) executeRetrieval(ctx context.Context, a astore.RetrievalAction, j ffs.RetrievalJob) (ffs.RetrievalInfo, error) {
-	// ToDo: WIP.
-	_ = j
-	fi, err := s.cs.Fetch(ctx, a.PayloadCid, &a.PieceCid, a.WalletAddress, a.Miners, a.MaxPrice, a.Selector)
+// RetrievalJob returns the current state of a RetrievalJob.
+func (s *Scheduler) RetrievalJob(jid ffs.JobID) (ffs.RetrievalJob, error) {
+	j, err := s.rjs.Get(jid)
+	if err == rjstore.ErrNotFound {
+		return ffs.RetrievalJob{}, ErrNotFound
+	}
 	if err != nil {
-		return ffs.RetrievalInfo{}, fmt.Errorf("fetching from cold storage: %s", err)
+		return ffs.RetrievalJob{}, fmt.Errorf("get retrieval job from store: %s", err)
 	}
-	// ToDo: use graphsync to get the underlying DataCid to be pinned
-	dataCid := cid.Undef
+	return j, nil
+}
+
+// WatchRetrievalJobs returns a channel to listen to RetrievalJob status changes
+// from a specified API instance.
+func (s *Scheduler) WatchRetrievalJobs(ctx context.Context, c chan<- ffs.RetrievalJob, iid ffs.APIID) error {
+	return s.rjs.Watch(ctx, c, iid)
+}
 
-	size, err := s.hs.Pin(ctx, a.APIID, dataCid)
+// RemoveRetrieval removes the information of an executed retrieval, and
+// unpins its retrieved data from hot storage.
+func (s *Scheduler) RemoveRetrieval(ctx context.Context, iid ffs.APIID, rid ffs.RetrievalID) error {
+	info, err := s.ris.Get(rid)
+	if err == ristore.ErrNotFound {
+		return nil
+	}
 	if err != nil {
-		return ffs.RetrievalInfo{}, fmt.Errorf("pinning data cid: %s", err)
+		return fmt.Errorf("getting retrieval info from store: %s", err)
+	}
+	if info.DataCid.Defined() {
+		if err := s.hs.Unpin(ctx, iid, info.DataCid); err != nil {
+			return fmt.Errorf("unpinning retrieved data: %s", err)
+		}
+	}
+	if err := s.ris.Delete(rid); err != nil {
+		return fmt.Errorf("deleting retrieval info: %s", err)
+	}
+	return nil
+}
+
+func (s *Scheduler) executeRetrieval(ctx context.Context, a astore.RetrievalAction) (ffs.RetrievalInfo, error) {
+	// Selectors aren't supported, so the retrieved DAG is the
+	// complete payload.
+	dataCid := a.PayloadCid
+
+	// Data already available in hot storage, e.g: cached by a
+	// recent retrieval, doesn't need a new retrieval.
+	pinned, err := s.hs.IsPinned(ctx, a.APIID, dataCid)
+	if err != nil {
+		return ffs.RetrievalInfo{}, fmt.Errorf("checking if data is pinned: %s", err)
+	}
+	exp, err := s.hs.CacheExpiration(ctx, a.APIID, dataCid)
+	if err != nil {
+		return ffs.RetrievalInfo{}, fmt.Errorf("getting cache expiration: %s", err)
+	}
+	var fi ffs.FetchInfo
+	if pinned {
+		s.l.Log(ctx, "Data is available in Hot-Storage, skipping retrieval.")
+	} else {
+		fi, err = s.cs.Fetch(ctx, a.PayloadCid, &a.PieceCid, a.WalletAddress, a.Miners, a.MaxPrice, a.Selector)
+		if err != nil {
+			return ffs.RetrievalInfo{}, fmt.Errorf("fetching from cold storage: %s", err)
+		}
+	}
+
+	// Fully pinned data isn't switched to a cache-pin.
+	var size int
+	if a.CacheTTL > 0 && (!pinned || !exp.IsZero()) {
+		ttl := time.Duration(a.CacheTTL) * time.Second
+		size, err = s.hs.Cache(ctx, a.APIID, dataCid, ttl)
+		if err != nil {
+			return ffs.RetrievalInfo{}, fmt.Errorf("caching data cid: %s", err)
+		}
+		s.l.Log(ctx, "Retrieved data is cached in Hot-Storage for %s.", ttl)
+	} else {
+		size, err = s.hs.Pin(ctx, a.APIID, dataCid)
+		if err != nil {
+			return ffs.RetrievalInfo{}, fmt.Errorf("pinning data cid: %s", err)
+		}
 	}
 
 	ri := ffs.RetrievalInfo{
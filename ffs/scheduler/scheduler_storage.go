@@ -4,9 +4,12 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"math/big"
+	"strings"
 	"sync"
 	"time"
 
+	"github.com/filecoin-project/go-state-types/abi"
 	"github.com/ipfs/go-cid"
 	"github.com/textileio/powergate/v2/deals"
 	"github.com/textileio/powergate/v2/ffs"
@@ -15,10 +18,15 @@ import (
 	"github.com/textileio/powergate/v2/ffs/scheduler/internal/sjstore"
 )
 
-// PushConfig queues the specified StorageConfig to be executed as a new Job. It returns
-// the created JobID for further tracking of its state.
-func (s *Scheduler) PushConfig(iid ffs.APIID, c cid.Cid, cfg ffs.StorageConfig) (ffs.JobID, error) {
-	return s.push(iid, c, cfg, cid.Undef)
+// PushConfig queues the specified StorageConfig to be executed as a new Job with
+// the provided priority. If notBefore is in the future, the Job is Scheduled and
+// won't be queued until that time. It returns the created JobID for further
+// tracking of its state.
+func (s *Scheduler) PushConfig(iid ffs.APIID, c cid.Cid, cfg ffs.StorageConfig, priority ffs.JobPriority, notBefore time.Time) (ffs.JobID, error) {
+	if err := s.validateEvalFrequency(cfg); err != nil {
+		return ffs.EmptyJobID, err
+	}
+	return s.push(iid, c, cfg, cid.Undef, priority, notBefore)
 }
 
 // PushReplace queues a new StorageConfig to be executed as a new Job, replacing an oldCid that will be
@@ -27,10 +35,10 @@ func (s *Scheduler) PushReplace(iid ffs.APIID, c cid.Cid, cfg ffs.StorageConfig,
 	if !oldCid.Defined() {
 		return ffs.EmptyJobID, fmt.Errorf("cid can't be undefined")
 	}
-	return s.push(iid, c, cfg, oldCid)
+	return s.push(iid, c, cfg, oldCid, ffs.PriorityNormal, time.Time{})
 }
 
-func (s *Scheduler) push(iid ffs.APIID, c cid.Cid, cfg ffs.StorageConfig, oldCid cid.Cid) (ffs.JobID, error) {
+func (s *Scheduler) push(iid ffs.APIID, c cid.Cid, cfg ffs.StorageConfig, oldCid cid.Cid, priority ffs.JobPriority, notBefore time.Time) (ffs.JobID, error) {
 	if !c.Defined() {
 		return ffs.EmptyJobID, fmt.Errorf("cid can't be undefined")
 	}
@@ -40,15 +48,37 @@ func (s *Scheduler) push(iid ffs.APIID, c cid.Cid, cfg ffs.StorageConfig, oldCid
 	if err := cfg.Validate(); err != nil {
 		return ffs.EmptyJobID, fmt.Errorf("validating storage config: %s", err)
 	}
-	jid := ffs.NewJobID()
+
 	j := ffs.StorageJob{
-		ID:        jid,
+		ID:        ffs.NewJobID(),
 		APIID:     iid,
 		Cid:       c,
 		Status:    ffs.Queued,
+		Priority:  priority,
+		Attempt:   1,
 		CreatedAt: time.Now().Unix(),
 	}
+	if !notBefore.IsZero() {
+		j.NotBefore = notBefore.Unix()
+	}
+	return s.pushJob(j, cfg, oldCid)
+}
+
+// validateEvalFrequency checks that the StorageConfig EvalFrequency, if set,
+// isn't lower than the configured minimum.
+func (s *Scheduler) validateEvalFrequency(cfg ffs.StorageConfig) error {
+	if cfg.EvalFrequency > 0 && time.Duration(cfg.EvalFrequency)*time.Second < s.eval.MinFrequency {
+		return fmt.Errorf("eval frequency should be at least %d seconds, got %d", int64(s.eval.MinFrequency/time.Second), cfg.EvalFrequency)
+	}
+	return nil
+}
 
+// pushJob saves the StorageConfig to be executed by the provided Job,
+// and enqueues the Job. If the Job NotBefore time is in the future, the
+// Job is Scheduled instead. In both cases, any other Queued or Scheduled
+// Job for the Cid is canceled.
+func (s *Scheduler) pushJob(j ffs.StorageJob, cfg ffs.StorageConfig, oldCid cid.Cid) (ffs.JobID, error) {
+	jid, iid, c := j.ID, j.APIID, j.Cid
 	ctx := context.WithValue(context.Background(), ffs.CtxKeyJid, jid)
 	ctx = context.WithValue(ctx, ffs.CtxStorageCid, c)
 	ctx = context.WithValue(ctx, ffs.CtxAPIID, iid)
@@ -68,6 +98,14 @@ func (s *Scheduler) push(iid ffs.APIID, c cid.Cid, cfg ffs.StorageConfig, oldCid
 		return ffs.EmptyJobID, fmt.Errorf("saving repairable/renewable storage config: %s", err)
 	}
 
+	if j.NotBefore > time.Now().Unix() {
+		if err := s.sjs.Schedule(j); err != nil {
+			return ffs.EmptyJobID, fmt.Errorf("scheduling job: %s", err)
+		}
+		s.l.Log(ctx, "Job %s was scheduled to be queued at %s.", jid, time.Unix(j.NotBefore, 0).Format(time.RFC3339))
+		return jid, nil
+	}
+
 	if err := s.sjs.Enqueue(j); err != nil {
 		return ffs.EmptyJobID, fmt.Errorf("enqueuing job: %s", err)
 	}
@@ -84,8 +122,124 @@ func (s *Scheduler) push(iid ffs.APIID, c cid.Cid, cfg ffs.StorageConfig, oldCid
 	return jid, nil
 }
 
-// Untrack untracks a Cid from iid for renewal and repair background crons.
+// PlanConfig returns the actions that executing the StorageConfig for a Cid
+// would take considering its current storage state, without creating a Job.
+func (s *Scheduler) PlanConfig(ctx context.Context, iid ffs.APIID, c cid.Cid, cfg ffs.StorageConfig) (ffs.StorageConfigPlan, error) {
+	if !c.Defined() {
+		return ffs.StorageConfigPlan{}, fmt.Errorf("cid can't be undefined")
+	}
+	if iid == ffs.EmptyInstanceID {
+		return ffs.StorageConfigPlan{}, fmt.Errorf("empty API ID")
+	}
+	if err := cfg.Validate(); err != nil {
+		return ffs.StorageConfigPlan{}, fmt.Errorf("validating storage config: %s", err)
+	}
+	if err := s.validateEvalFrequency(cfg); err != nil {
+		return ffs.StorageConfigPlan{}, err
+	}
+	ci, err := s.getRefreshedInfo(ctx, iid, c)
+	if err != nil {
+		return ffs.StorageConfigPlan{}, fmt.Errorf("getting current cid info from store: %s", err)
+	}
+
+	plan := ffs.StorageConfigPlan{
+		Cid: c,
+		Cold: ffs.ColdPlan{
+			ActiveDeals: ci.Cold.Filecoin.ActiveDeals(),
+			TotalCost:   big.NewInt(0),
+		},
+	}
+	if cfg.Hot.Enabled {
+		if !ci.Hot.Enabled {
+			plan.Hot.Pin = true
+			plan.Hot.Unfreeze = cfg.Hot.AllowUnfreeze && len(ci.Cold.Filecoin.Proposals) > 0
+		}
+	} else {
+		plan.Hot.Unpin = ci.Hot.Enabled
+	}
+
+	if !cfg.Cold.Enabled {
+		return plan, nil
+	}
+	if s.sr2RepFactor != nil {
+		cfg.Cold.Filecoin.RepFactor, err = s.sr2RepFactor()
+		if err != nil {
+			return ffs.StorageConfigPlan{}, fmt.Errorf("getting SR2 replication factor: %s", err)
+		}
+	}
+	if cfg.Cold.Filecoin.RepFactor-ci.Cold.Filecoin.ActiveDeals() <= 0 {
+		return plan, nil
+	}
+	deltaFilConfig := createDeltaFilConfig(cfg.Cold, ci.Cold.Filecoin)
+	deltaFilConfig.MaxCost, err = s.remainingBudget(iid, c)
+	if err != nil {
+		return ffs.StorageConfigPlan{}, fmt.Errorf("getting remaining budget: %s", err)
+	}
+	cold, err := s.planNewDeals(ffs.WithReplicaMiners(ctx, replicaMiners(ci.Cold.Filecoin)), c, deltaFilConfig)
+	if err != nil {
+		return ffs.StorageConfigPlan{}, fmt.Errorf("planning cold-storage config: %s", err)
+	}
+	cold.ActiveDeals = plan.Cold.ActiveDeals
+	plan.Cold = cold
+
+	return plan, nil
+}
+
+// planNewDeals plans the new deals of a FilConfig as executeColdStorage makes
+// them. If a price escalation policy is configured, miner selection is planned
+// with progressively higher max prices until the RepFactor is reached.
+func (s *Scheduler) planNewDeals(ctx context.Context, c cid.Cid, cfg ffs.FilConfig) (ffs.ColdPlan, error) {
+	if !cfg.PriceEscalation.Enabled() {
+		return s.cs.Plan(ctx, c, cfg)
+	}
+	prices := cfg.PriceEscalation.Prices()
+	res := ffs.ColdPlan{TotalCost: big.NewInt(0)}
+	var lastErr error
+	for _, price := range prices {
+		stepCfg := cfg
+		stepCfg.RepFactor = cfg.RepFactor - len(res.Deals)
+		if stepCfg.RepFactor <= 0 {
+			break
+		}
+		stepCfg.MaxPrice = price
+		// Miners planned in previous steps aren't selected again.
+		stepCfg.ExcludedMiners = append([]string(nil), cfg.ExcludedMiners...)
+		for _, d := range res.Deals {
+			stepCfg.ExcludedMiners = append(stepCfg.ExcludedMiners, d.Miner)
+		}
+		if cfg.MaxCost != nil {
+			stepCfg.MaxCost = new(big.Int).Sub(cfg.MaxCost, res.TotalCost)
+		}
+		step, err := s.cs.Plan(ctx, c, stepCfg)
+		if err != nil {
+			lastErr = err
+			continue
+		}
+		res.PayloadSize = step.PayloadSize
+		res.PieceSize = step.PieceSize
+		res.Deals = append(res.Deals, step.Deals...)
+		res.Warnings = append(res.Warnings, step.Warnings...)
+		res.TotalCost.Add(res.TotalCost, step.TotalCost)
+	}
+	if len(res.Deals) == 0 && lastErr != nil {
+		return ffs.ColdPlan{}, fmt.Errorf("price escalation reached max price %d: %s", prices[len(prices)-1], lastErr)
+	}
+	if len(res.Deals) < cfg.RepFactor {
+		res.Warnings = append(res.Warnings, fmt.Sprintf("Price escalation would reach max price %d with %d of %d desired deals", prices[len(prices)-1], len(res.Deals), cfg.RepFactor))
+	}
+	return res, nil
+}
+
+// Untrack untracks a Cid from iid for renewal and repair background crons,
+// and cancels any Scheduled Job for the Cid. If the Cid was stored in an
+// aggregate, its reference to the aggregate is removed.
 func (s *Scheduler) Untrack(iid ffs.APIID, c cid.Cid) error {
+	if err := s.sjs.CancelScheduled(iid, c); err != nil {
+		return fmt.Errorf("canceling scheduled jobs: %s", err)
+	}
+	if err := s.removeAggregateRef(context.Background(), iid, c); err != nil {
+		return fmt.Errorf("removing aggregate reference: %s", err)
+	}
 	if err := s.ts.Remove(iid, c); err != nil {
 		return fmt.Errorf("removing cid from action store: %s", err)
 	}
@@ -159,6 +313,8 @@ const (
 	Executing
 	// Final lists final StorageJobs.
 	Final
+	// Scheduled lists scheduled StorageJobs.
+	Scheduled
 )
 
 // ListStorageJobsConfig controls the behavior for listing StorageJobs.
@@ -190,6 +346,84 @@ func (s *Scheduler) ListStorageJobs(config ListStorageJobsConfig) ([]ffs.Storage
 	return s.sjs.List(c)
 }
 
+// SetFairShare sets the FairShare configuration for an APIID.
+func (s *Scheduler) SetFairShare(iid ffs.APIID, fs ffs.FairShare) error {
+	if iid == ffs.EmptyInstanceID {
+		return fmt.Errorf("empty API ID")
+	}
+	if err := s.sjs.SetFairShare(iid, fs); err != nil {
+		return fmt.Errorf("setting fair share in store: %s", err)
+	}
+
+	// The new configuration might allow queued jobs to
+	// be executed.
+	select {
+	case s.sd.evaluateQueue <- struct{}{}:
+	default:
+	}
+	return nil
+}
+
+// RemoveFairShare removes the FairShare configuration of an APIID,
+// which gets the default share of execution slots.
+func (s *Scheduler) RemoveFairShare(iid ffs.APIID) error {
+	if iid == ffs.EmptyInstanceID {
+		return fmt.Errorf("empty API ID")
+	}
+	if err := s.sjs.RemoveFairShare(iid); err != nil {
+		return fmt.Errorf("removing fair share from store: %s", err)
+	}
+
+	// Removing a MaxExecuting limit might allow queued
+	// jobs to be executed.
+	select {
+	case s.sd.evaluateQueue <- struct{}{}:
+	default:
+	}
+	return nil
+}
+
+// FairShares returns the configured FairShares by APIID.
+func (s *Scheduler) FairShares() map[ffs.APIID]ffs.FairShare {
+	return s.sjs.GetFairShares()
+}
+
+// scheduleRetry schedules a new Job for the StorageConfig executed by a
+// failed Job, if its RetryPolicy allows another attempt. The new Job is
+// Scheduled to be queued after the RetryPolicy backoff, so pushing a new
+// StorageConfig for the Cid, or untracking it, cancels the retry.
+func (s *Scheduler) scheduleRetry(ctx context.Context, j ffs.StorageJob, a astore.StorageAction) {
+	rp := a.Cfg.Retry
+	attempt := j.Attempt
+	if attempt == 0 {
+		// Jobs created before retry policies existed.
+		attempt = 1
+	}
+	if !rp.Enabled() || attempt >= rp.MaxAttempts {
+		return
+	}
+	backoff := rp.Backoff(attempt)
+
+	now := time.Now()
+	retry := ffs.StorageJob{
+		ID:            ffs.NewJobID(),
+		APIID:         j.APIID,
+		Cid:           j.Cid,
+		Status:        ffs.Scheduled,
+		Priority:      j.Priority,
+		Attempt:       attempt + 1,
+		PreviousJobID: j.ID,
+		NotBefore:     now.Add(backoff).Unix(),
+		CreatedAt:     now.Unix(),
+	}
+	jid, err := s.pushJob(retry, a.Cfg, a.ReplacedCid)
+	if err != nil {
+		s.l.Log(ctx, "Retrying job %s errored: %s", j.ID, err)
+		return
+	}
+	s.l.Log(ctx, "Job %s will retry job %s in %s, attempt %d of %d.", jid, j.ID, backoff, attempt+1, rp.MaxAttempts)
+}
+
 // StorageConfig returns the storage config for a job.
 func (s *Scheduler) StorageConfig(jid ffs.JobID) (ffs.StorageConfig, error) {
 	a, err := s.as.GetStorageAction(jid)
@@ -211,6 +445,10 @@ func (s *Scheduler) executeStorage(ctx context.Context, a astore.StorageAction,
 		return ffs.StorageInfo{}, nil, fmt.Errorf("getting current cid info from store: %s", err)
 	}
 
+	if a.Cfg.Repairable {
+		ci.Cold = s.markFaultedDeals(ctx, ci.Cold)
+	}
+
 	if a.ReplacedCid.Defined() {
 		if err := s.Untrack(a.APIID, a.ReplacedCid); err != nil && err != astore.ErrNotFound {
 			return ffs.StorageInfo{}, nil, fmt.Errorf("untracking replaced cid: %s", err)
@@ -230,18 +468,24 @@ func (s *Scheduler) executeStorage(ctx context.Context, a astore.StorageAction,
 
 	// We want to avoid relying on Lotus working in online-mode.
 	// We need to take care ourselves of pulling the data from
-	// the IPFS network.
-	if !a.Cfg.Hot.Enabled && a.Cfg.Cold.Enabled {
+	// the IPFS network. Offline deals data is transferred manually
+	// to miners, so it isn't needed.
+	if !a.Cfg.Hot.Enabled && a.Cfg.Cold.Enabled && !a.Cfg.Cold.Filecoin.Offline.Enabled() {
 		s.l.Log(ctx, "Automatically staging Cid from the IPFS network...")
 		stageCtx, cancel := context.WithTimeout(ctx, time.Duration(a.Cfg.Hot.Ipfs.AddTimeout)*time.Second)
 		defer cancel()
 		if err := s.hs.StageCid(stageCtx, a.APIID, a.Cid); err != nil {
-			return ffs.StorageInfo{}, nil, fmt.Errorf("automatically staging cid: %s", err)
+			// Cold-only data which is renewed by unfreezing it might not be
+			// available in the IPFS network anymore.
+			if !a.Cfg.Cold.Filecoin.Renew.Unfreeze || len(ci.Cold.Filecoin.Proposals) == 0 {
+				return ffs.StorageInfo{}, nil, fmt.Errorf("automatically staging cid: %s", err)
+			}
+			s.l.Log(ctx, "Staging Cid from the IPFS network failed, it will be unfrozen from Filecoin if renewals are needed: %s", err)
 		}
 	}
 
 	s.l.Log(ctx, "Executing Cold-Storage configuration...")
-	cold, errors, err := s.executeColdStorage(ctx, ci, a.Cfg.Cold, dealUpdates)
+	cold, errors, err := s.executeColdStorage(ctx, ci, a.Cfg.Cold, a.Cfg.Hot.UnfreezeMaxPrice, a.Cfg.Hot.UnfreezeCacheTTL, dealUpdates)
 	if err != nil {
 		s.l.Log(ctx, "Cold-Storage execution failed.")
 		return ffs.StorageInfo{}, errors, fmt.Errorf("executing cold-storage config: %s", err)
@@ -277,6 +521,14 @@ func (s *Scheduler) executeDisabledHotStorage(ctx context.Context, iid ffs.APIID
 		s.l.Log(ctx, "Data was already unpinned.")
 		return nil
 	}
+	exp, err := s.hs.CacheExpiration(ctx, iid, c)
+	if err != nil {
+		return fmt.Errorf("getting cache expiration: %s", err)
+	}
+	if !exp.IsZero() {
+		s.l.Log(ctx, "Unfrozen data is cached until %s.", exp.Format(time.RFC3339))
+		return nil
+	}
 	if err := s.hs.Unpin(ctx, iid, c); err != nil {
 		return fmt.Errorf("unpinning cid %s: %s", c, err)
 	}
@@ -329,7 +581,17 @@ func (s *Scheduler) executeEnabledHotStorage(ctx context.Context, iid ffs.APIID,
 			return ffs.HotInfo{}, fmt.Errorf("unfreezing from Cold Storage: %s", err)
 		}
 		s.l.Log(ctx, "Unfrozen successfully from %s with cost %d attoFil, saving in Hot-Storage...", fi.RetrievedMiner, fi.FundsSpent)
-		size, err = s.hs.Pin(ctx, iid, curr.Cold.Filecoin.DataCid)
+		// If the data was aggregated, the whole aggregate was retrieved
+		// but only the data is pinned. The aggregate is cached if configured,
+		// so other data stored in it can be unfrozen without a new retrieval.
+		if curr.Cold.Filecoin.DataCid != curr.Cid && cfg.UnfreezeCacheTTL > 0 {
+			ttl := time.Duration(cfg.UnfreezeCacheTTL) * time.Second
+			if _, err := s.hs.Cache(ctx, iid, curr.Cold.Filecoin.DataCid, ttl); err != nil {
+				return ffs.HotInfo{}, fmt.Errorf("caching unfrozen aggregate: %s", err)
+			}
+			s.l.Log(ctx, "Unfrozen aggregate is cached in Hot-Storage for %s.", ttl)
+		}
+		size, err = s.hs.Pin(ctx, iid, curr.Cid)
 		if err != nil {
 			return ffs.HotInfo{}, fmt.Errorf("pinning unfrozen cid: %s", err)
 		}
@@ -367,11 +629,16 @@ func (s *Scheduler) getRefreshedInfo(ctx context.Context, iid ffs.APIID, c cid.C
 }
 
 func (s *Scheduler) getRefreshedHotInfo(ctx context.Context, iid ffs.APIID, c cid.Cid, curr ffs.HotInfo) (ffs.HotInfo, error) {
-	var err error
-	curr.Enabled, err = s.hs.IsPinned(ctx, iid, c)
+	pinned, err := s.hs.IsPinned(ctx, iid, c)
+	if err != nil {
+		return ffs.HotInfo{}, err
+	}
+	curr.Ipfs.CacheExpiration, err = s.hs.CacheExpiration(ctx, iid, c)
 	if err != nil {
 		return ffs.HotInfo{}, err
 	}
+	// Cached unfrozen data is available, but hot storage isn't enabled.
+	curr.Enabled = pinned && curr.Ipfs.CacheExpiration.IsZero()
 	return curr, nil
 }
 
@@ -392,7 +659,7 @@ func (s *Scheduler) getRefreshedColdInfo(ctx context.Context, curr ffs.ColdInfo)
 	return curr, nil
 }
 
-func (s *Scheduler) executeColdStorage(ctx context.Context, curr ffs.StorageInfo, cfg ffs.ColdConfig, dealUpdates chan deals.StorageDealInfo) (ffs.ColdInfo, []ffs.DealError, error) {
+func (s *Scheduler) executeColdStorage(ctx context.Context, curr ffs.StorageInfo, cfg ffs.ColdConfig, unfreezeMaxPrice uint64, unfreezeCacheTTL int, dealUpdates chan deals.StorageDealInfo) (ffs.ColdInfo, []ffs.DealError, error) {
 	if !cfg.Enabled {
 		s.l.Log(ctx, "Cold-Storage was disabled, Filecoin deals will eventually expire.")
 		return curr.Cold, nil, nil
@@ -423,10 +690,45 @@ func (s *Scheduler) executeColdStorage(ctx context.Context, curr ffs.StorageInfo
 
 	// 2. If this Storage Config is renewable, then let's check if any of the existing deals
 	// should be renewed, and do it.
+	if cfg.Filecoin.Renew.Enabled && curr.Cold.Filecoin.Aggregated() {
+		// Deals of aggregated data are shared with other data, so they aren't renewed.
+		s.l.Log(ctx, "Data was stored aggregated with other data, avoiding deal renewals.")
+		cfg.Filecoin.Renew.Enabled = false
+	}
 	if cfg.Filecoin.Renew.Enabled {
-		if curr.Hot.Enabled {
-			s.l.Log(ctx, "Checking deal renewals...")
-			newFilInfo, errors, err := s.cs.EnsureRenewals(ctx, curr.Cid, curr.Cold.Filecoin, cfg.Filecoin, s.dealFinalityTimeout, dealUpdates)
+		if !curr.Hot.Enabled && !cfg.Filecoin.Renew.Unfreeze {
+			// (**) Renewable note:
+			// This shouldn't happen since it would be an invalid Storage Config.
+			// Renewing deals requires sending the data again, so without Hot Storage
+			// we can only do it if the config allows unfreezing the data from Filecoin,
+			// which means paying for a retrieval to later discard the data.
+			return ffs.ColdInfo{}, nil, fmt.Errorf("invalid storage configuration, can't be renewable with disabled hot storage")
+		}
+		s.l.Log(ctx, "Checking deal renewals...")
+		renew := true
+		var unfrozen bool
+		if !curr.Hot.Enabled {
+			var err error
+			renew, unfrozen, err = s.unfreezeForRenewals(ctx, &curr, cfg, unfreezeMaxPrice)
+			if err != nil {
+				s.l.Log(ctx, "Unfreezing data for deal renewals failed: %s", err)
+				renew = false
+			}
+		}
+		var maxCost *big.Int
+		if renew {
+			var err error
+			maxCost, err = s.remainingBudget(curr.APIID, curr.Cid)
+			if err != nil {
+				s.l.Log(ctx, "Getting remaining budget for deal renewals failed: %s", err)
+				renew = false
+			}
+		}
+		if renew {
+			renewCfg := cfg.Filecoin
+			renewCfg.MaxCost = maxCost
+			rCtx := s.withBudgetReserver(ctx, curr.APIID, curr.Cid)
+			newFilInfo, errors, err := s.cs.EnsureRenewals(rCtx, curr.Cid, curr.Cold.Filecoin, renewCfg, s.dealFinalityTimeout, dealUpdates)
 			if err != nil {
 				s.l.Log(ctx, "Deal renewal process couldn't be executed: %s", err)
 			} else {
@@ -442,6 +744,13 @@ func (s *Scheduler) executeColdStorage(ctx context.Context, curr ffs.StorageInfo
 						return ffs.ColdInfo{}, nil, fmt.Errorf("eager saving of new info: %s", err)
 					}
 					s.l.Log(ctx, "A total of %d new deals were created in the renewal process", numDeals)
+					s.notify(ffs.Event{
+						Type:     ffs.EventRenewalCreated,
+						APIID:    curr.APIID,
+						Cid:      curr.Cid,
+						JobID:    jobIDFromCtx(ctx),
+						NumDeals: numDeals,
+					})
 				}
 				s.l.Log(ctx, "Deal renewal evaluated successfully")
 				curr.Cold.Filecoin = newFilInfo
@@ -450,17 +759,18 @@ func (s *Scheduler) executeColdStorage(ctx context.Context, curr ffs.StorageInfo
 					log.Errorf("saving cid info to store: %s", err)
 				}
 			}
-		} else {
-			// (**) Renewable note:
-			// This shouldn't happen since it would be an invalid Storage Config.
-			// We can only accept "Repair" if Hot Storage is enabled.
-			// We can the feature to retrieve the data from a miner,
-			// put it in Hot Storage, and then try the renewal. Looks to me
-			// we should be sure about doing that since it would be paying
-			// for retrieval to later discard the data. Sounds like Filecoin
-			// should allow renewing a deal without the need of sending the data
-			// again. Still not clear.
-			return ffs.ColdInfo{}, nil, fmt.Errorf("invalid storage configuration, can't be renewable with disabled hot storage")
+		}
+		if unfrozen && unfreezeCacheTTL > 0 {
+			ttl := time.Duration(unfreezeCacheTTL) * time.Second
+			if _, err := s.hs.Cache(ctx, curr.APIID, curr.Cold.Filecoin.DataCid, ttl); err != nil {
+				return ffs.ColdInfo{}, nil, fmt.Errorf("caching unfrozen data: %s", err)
+			}
+			s.l.Log(ctx, "Unfrozen data is cached in Hot-Storage for %s.", ttl)
+		} else if unfrozen {
+			if err := s.hs.Unpin(ctx, curr.APIID, curr.Cold.Filecoin.DataCid); err != nil {
+				return ffs.ColdInfo{}, nil, fmt.Errorf("unpinning unfrozen data: %s", err)
+			}
+			s.l.Log(ctx, "Unfrozen data was unpinned.")
 		}
 	}
 
@@ -476,54 +786,217 @@ func (s *Scheduler) executeColdStorage(ctx context.Context, curr ffs.StorageInfo
 			return ffs.ColdInfo{}, nil, fmt.Errorf("getting SR2 replication factor: %s", err)
 		}
 	}
-	if cfg.Filecoin.RepFactor-len(curr.Cold.Filecoin.Proposals) <= 0 {
+	// Deals of aggregates are shared by all the data stored in them, so new
+	// deals are made once for all of it.
+	aggregated := curr.Cold.Filecoin.Aggregated()
+	if aggregated {
+		unlock, err := s.aggrLocks.Lock(ctx, curr.Cold.Filecoin.DataCid.String())
+		if err != nil {
+			return ffs.ColdInfo{}, allErrors, fmt.Errorf("locking aggregate: %s", err)
+		}
+		defer unlock()
+		curr.Cold, err = s.addAggregateDeals(ctx, curr.Cold)
+		if err != nil {
+			return ffs.ColdInfo{}, allErrors, err
+		}
+	}
+	if cfg.Filecoin.RepFactor-curr.Cold.Filecoin.ActiveDeals() <= 0 {
 		s.l.Log(ctx, "The current replication factor is equal or higher than desired, avoiding making new deals.")
 		return curr.Cold, nil, nil
 	}
 
 	// The answer is yes, calculate how many extra deals we need and create them.
-	deltaFilConfig := createDeltaFilConfig(cfg, curr.Cold.Filecoin)
-	s.l.Log(ctx, "Current replication factor is lower than desired, making %d new deals...", deltaFilConfig.RepFactor)
-	startedProposals, rejectedProposals, size, err := s.cs.Store(ctx, curr.Cid, deltaFilConfig)
+	// If a price escalation policy is configured, miner selection is retried with
+	// progressively higher max prices until the desired RepFactor is reached.
+	prices := []uint64{cfg.Filecoin.MaxPrice}
+	escalation := cfg.Filecoin.PriceEscalation.Enabled()
+	if escalation {
+		prices = cfg.Filecoin.PriceEscalation.Prices()
+	}
+	size := abi.PaddedPieceSize(curr.Cold.Filecoin.Size)
+	var numNewDeals int
+	var lastErr error
+	for i, price := range prices {
+		deltaFilConfig := createDeltaFilConfig(cfg, curr.Cold.Filecoin)
+		if i > 0 && (deltaFilConfig.RepFactor <= 0 || ctx.Err() != nil) {
+			break
+		}
+		if escalation {
+			deltaFilConfig.MaxPrice = price
+			// Miners which failed deals in previous steps aren't retried, unless
+			// they only rejected the price, which is higher in this step.
+			for _, de := range allErrors {
+				if de.Miner != "" && !isPriceRejection(de) {
+					deltaFilConfig.ExcludedMiners = append(deltaFilConfig.ExcludedMiners, de.Miner)
+				}
+			}
+			s.l.Log(ctx, "Price escalation step %d of %d, selecting miners with max price %d attoFIL/GiB/epoch...", i+1, len(prices), price)
+		}
+		agg, okDeals, dealErrors, pieceSize, err := s.makeNewDeals(ctx, curr, deltaFilConfig, dealUpdates)
+		allErrors = append(allErrors, dealErrors...)
+		if pieceSize > 0 {
+			size = pieceSize
+		}
+		if err != nil {
+			if !escalation {
+				return ffs.ColdInfo{}, allErrors, err
+			}
+			s.l.Log(ctx, "Price escalation step %d of %d didn't make any deal: %s", i+1, len(prices), err)
+			lastErr = err
+			continue
+		}
+		numNewDeals += len(okDeals)
+		// Append to any existing other proposals.
+		curr.Cold.Filecoin.DataCid = agg.Root
+		curr.Cold.Filecoin.AggregatePath = agg.Path
+		curr.Cold.Filecoin.Size = uint64(size)
+		curr.Cold.Filecoin.Proposals = append(okDeals, curr.Cold.Filecoin.Proposals...)
+		if escalation && len(okDeals) > 0 && i < len(prices)-1 {
+			// Eagerly save the new deals, since further steps could take long.
+			if err := s.cis.Put(curr); err != nil {
+				return ffs.ColdInfo{}, allErrors, fmt.Errorf("eager saving of new info: %s", err)
+			}
+		}
+	}
+
+	// If not even one new deal finished successfully, consider this Job execution a failure.
+	if numNewDeals == 0 && lastErr != nil {
+		return ffs.ColdInfo{}, allErrors, fmt.Errorf("price escalation reached max price %d: %s", prices[len(prices)-1], lastErr)
+	}
+	if escalation && cfg.Filecoin.RepFactor > curr.Cold.Filecoin.ActiveDeals() {
+		s.l.Log(ctx, "Price escalation reached max price %d with %d of %d desired deals.", prices[len(prices)-1], curr.Cold.Filecoin.ActiveDeals(), cfg.Filecoin.RepFactor)
+	}
+	if aggregated {
+		if err := s.ags.Put(curr.Cold.Filecoin.DataCid, curr.Cold.Filecoin.Proposals); err != nil {
+			return ffs.ColdInfo{}, allErrors, fmt.Errorf("saving aggregate deals: %s", err)
+		}
+	} else if curr.Cold.Filecoin.Aggregated() {
+		if err := s.addAggregateRef(ctx, curr.Cold.Filecoin.DataCid, curr.APIID, curr.Cid); err != nil {
+			return ffs.ColdInfo{}, allErrors, err
+		}
+	}
+
+	// At least 1 of the proposal deals reached a successful final status, Job succeeds.
+	return ffs.ColdInfo{
+		Enabled: true,
+		Filecoin: ffs.FilInfo{
+			DataCid:       curr.Cold.Filecoin.DataCid,
+			Size:          uint64(size),
+			AggregatePath: curr.Cold.Filecoin.AggregatePath,
+			Proposals:     curr.Cold.Filecoin.Proposals,
+			RetrievalCost: curr.Cold.Filecoin.RetrievalCost,
+		},
+	}, allErrors, nil
+}
+
+// makeNewDeals makes the deals of a FilConfig, and waits for them to reach a final
+// state. It returns the Aggregate where the data was stored, the deals that finished
+// successfully, the errors of the ones that didn't, and the piece size of the data.
+// If the Job wasn't canceled, and not even one deal finished successfully, it returns
+// an error.
+func (s *Scheduler) makeNewDeals(ctx context.Context, curr ffs.StorageInfo, cfg ffs.FilConfig, dealUpdates chan deals.StorageDealInfo) (ffs.Aggregate, []ffs.FilStorage, []ffs.DealError, abi.PaddedPieceSize, error) {
+	ctx = ffs.WithReplicaMiners(ctx, replicaMiners(curr.Cold.Filecoin))
+	ctx = s.withBudgetReserver(ctx, curr.APIID, curr.Cid)
+	var err error
+	cfg.MaxCost, err = s.remainingBudget(curr.APIID, curr.Cid)
+	if err != nil {
+		return ffs.Aggregate{}, nil, nil, 0, fmt.Errorf("getting remaining budget: %s", err)
+	}
+	if cfg.MaxCost != nil {
+		s.l.Log(ctx, "The remaining budget for new deals is %s attoFIL", cfg.MaxCost)
+	}
+	s.l.Log(ctx, "Current replication factor is lower than desired, making %d new deals...", cfg.RepFactor)
+	var agg ffs.Aggregate
+	var startedProposals []cid.Cid
+	var rejectedProposals []ffs.DealError
+	var size abi.PaddedPieceSize
+	switch {
+	case curr.Cold.Filecoin.Aggregated():
+		// New deals for already aggregated data are made for its aggregate.
+		agg = ffs.Aggregate{Root: curr.Cold.Filecoin.DataCid, Path: curr.Cold.Filecoin.AggregatePath}
+		startedProposals, rejectedProposals, size, err = s.cs.Store(ctx, agg.Root, cfg)
+	case cfg.Aggregate:
+		agg, startedProposals, rejectedProposals, size, err = s.cs.StoreAggregated(ctx, curr.Cid, cfg)
+	default:
+		agg = ffs.Aggregate{Root: curr.Cid}
+		startedProposals, rejectedProposals, size, err = s.cs.Store(ctx, curr.Cid, cfg)
+	}
 	if err != nil {
 		s.l.Log(ctx, "Starting deals failed, with cause: %s", err)
-		return ffs.ColdInfo{}, rejectedProposals, err
+		return ffs.Aggregate{}, nil, rejectedProposals, 0, err
 	}
-	allErrors = append(allErrors, rejectedProposals...)
 
 	// If *none* of the tried proposals succeeded, then the Job fails.
 	if len(startedProposals) == 0 {
-		return ffs.ColdInfo{}, allErrors, fmt.Errorf("all proposals were rejected")
+		return ffs.Aggregate{}, nil, rejectedProposals, size, fmt.Errorf("all proposals were rejected")
 	}
 
 	// Track all deals that weren't rejected, just in case Powergate crashes/closes before
 	// we see them finalize, so they can be detected and resumed on starting Powergate again (point 1. above)
 	if err := s.sjs.AddStartedDeals(curr.APIID, curr.Cid, startedProposals); err != nil {
-		return ffs.ColdInfo{}, rejectedProposals, err
+		return ffs.Aggregate{}, nil, rejectedProposals, size, err
 	}
 
 	// Wait for started deals.
 	okDeals, failedDeals := s.waitForDeals(ctx, curr.Cid, startedProposals, dealUpdates)
-	allErrors = append(allErrors, failedDeals...)
+	dealErrors := append(rejectedProposals, failedDeals...)
 	if err := s.sjs.RemoveStartedDeals(curr.APIID, curr.Cid); err != nil {
-		return ffs.ColdInfo{}, allErrors, fmt.Errorf("removing temporal started deals storage: %s", err)
+		return ffs.Aggregate{}, nil, dealErrors, size, fmt.Errorf("removing temporal started deals storage: %s", err)
 	}
 
 	// If the Job wasn't canceled, and not even one deal finished succcessfully,
 	// consider this Job execution a failure.
 	if ctx.Err() == nil && len(failedDeals) == len(startedProposals) {
-		return ffs.ColdInfo{}, allErrors, fmt.Errorf("all started deals failed")
+		return ffs.Aggregate{}, nil, dealErrors, size, fmt.Errorf("all started deals failed")
 	}
 
-	// At least 1 of the proposal deals reached a successful final status, Job succeeds.
-	return ffs.ColdInfo{
-		Enabled: true,
-		Filecoin: ffs.FilInfo{
-			DataCid:   curr.Cid,
-			Size:      uint64(size),
-			Proposals: append(okDeals, curr.Cold.Filecoin.Proposals...), // Append to any existing other proposals
-		},
-	}, allErrors, nil
+	return agg, okDeals, dealErrors, size, nil
+}
+
+// unfreezeForRenewals makes cold-only data available in hot storage to renew its deals,
+// retrieving it from Filecoin if needed. It returns true if renewals should be
+// evaluated, and true if the data was unfrozen and should be unpinned afterwards.
+// The retrieval cost is accumulated in curr, and eagerly saved.
+func (s *Scheduler) unfreezeForRenewals(ctx context.Context, curr *ffs.StorageInfo, cfg ffs.ColdConfig, maxPrice uint64) (bool, bool, error) {
+	due, err := s.cs.RenewalsDue(ctx, curr.Cold.Filecoin, cfg.Filecoin)
+	if err != nil {
+		return false, false, fmt.Errorf("checking due renewals: %s", err)
+	}
+	if due == 0 {
+		s.l.Log(ctx, "No deals are due for renewal, avoiding unfreezing data.")
+		return false, false, nil
+	}
+	pinned, err := s.hs.IsPinned(ctx, curr.APIID, curr.Cold.Filecoin.DataCid)
+	if err != nil {
+		return false, false, fmt.Errorf("getting pinned status: %s", err)
+	}
+	if pinned {
+		s.l.Log(ctx, "Data is available in hot storage, no unfreezing needed for renewals.")
+		return true, false, nil
+	}
+
+	var pieceCid *cid.Cid
+	var miners []string
+	for _, p := range curr.Cold.Filecoin.Proposals {
+		if p.PieceCid != cid.Undef {
+			pieceCid = &p.PieceCid
+		}
+		miners = append(miners, p.Miner)
+	}
+	s.l.Log(ctx, "Unfreezing from Filecoin to renew %d deals...", due)
+	fi, err := s.cs.Fetch(ctx, curr.Cold.Filecoin.DataCid, pieceCid, cfg.Filecoin.Addr, miners, maxPrice, "")
+	if err != nil {
+		return false, false, fmt.Errorf("unfreezing from cold storage: %s", err)
+	}
+	curr.Cold.Filecoin.RetrievalCost += fi.FundsSpent
+	if err := s.cis.Put(*curr); err != nil {
+		return false, false, fmt.Errorf("saving retrieval cost: %s", err)
+	}
+	s.l.Log(ctx, "Unfrozen successfully from %s with cost %d attoFil (total retrieval cost %d attoFil), saving in Hot-Storage...", fi.RetrievedMiner, fi.FundsSpent, curr.Cold.Filecoin.RetrievalCost)
+	if _, err := s.hs.Pin(ctx, curr.APIID, curr.Cold.Filecoin.DataCid); err != nil {
+		return false, false, fmt.Errorf("pinning unfrozen cid: %s", err)
+	}
+	return true, true, nil
 }
 
 func (s *Scheduler) waitForDeals(ctx context.Context, c cid.Cid, startedProposals []cid.Cid, dealUpdates chan deals.StorageDealInfo) ([]ffs.FilStorage, []ffs.DealError) {
@@ -533,6 +1006,8 @@ func (s *Scheduler) waitForDeals(ctx context.Context, c cid.Cid, startedProposal
 	var okDeals []ffs.FilStorage
 	var wg sync.WaitGroup
 	var lock sync.Mutex
+	iid, _ := ctx.Value(ffs.CtxAPIID).(ffs.APIID)
+	jid := jobIDFromCtx(ctx)
 	wg.Add(len(startedProposals))
 	for _, pc := range startedProposals {
 		pc := pc
@@ -551,22 +1026,63 @@ func (s *Scheduler) waitForDeals(ctx context.Context, c cid.Cid, startedProposal
 				lock.Lock()
 				failedDeals = append(failedDeals, dealError)
 				lock.Unlock()
+				s.notify(ffs.Event{
+					Type:        ffs.EventDealFailed,
+					APIID:       iid,
+					Cid:         c,
+					JobID:       jid,
+					ProposalCid: pc,
+					Miner:       dealError.Miner,
+					Message:     dealError.Message,
+				})
 				return
 			}
 			lock.Lock()
 			okDeals = append(okDeals, res)
 			lock.Unlock()
+			s.notify(ffs.Event{
+				Type:        ffs.EventDealActive,
+				APIID:       iid,
+				Cid:         c,
+				JobID:       jid,
+				ProposalCid: pc,
+				DealID:      res.DealID,
+				Miner:       res.Miner,
+			})
 		}()
 	}
 	wg.Wait()
 	return okDeals, failedDeals
 }
 
+func jobIDFromCtx(ctx context.Context) ffs.JobID {
+	jid, _ := ctx.Value(ffs.CtxKeyJid).(ffs.JobID)
+	return jid
+}
+
 func createDeltaFilConfig(cfg ffs.ColdConfig, curr ffs.FilInfo) ffs.FilConfig {
 	res := cfg.Filecoin
-	res.RepFactor = cfg.Filecoin.RepFactor - len(curr.Proposals)
+	res.RepFactor = cfg.Filecoin.RepFactor - curr.ActiveDeals()
 	for _, p := range curr.Proposals {
 		res.ExcludedMiners = append(res.ExcludedMiners, p.Miner)
 	}
 	return res
 }
+
+// replicaMiners returns the miners of active deals, which keep storing the
+// data while new deals are made.
+func replicaMiners(curr ffs.FilInfo) []string {
+	res := make([]string, 0, len(curr.Proposals))
+	for _, p := range curr.Proposals {
+		if !p.Faulted {
+			res = append(res, p.Miner)
+		}
+	}
+	return res
+}
+
+// isPriceRejection returns true if a miner rejected a deal because its
+// price was lower than the miner asking price.
+func isPriceRejection(de ffs.DealError) bool {
+	return strings.Contains(de.Message, "less than asking price")
+}
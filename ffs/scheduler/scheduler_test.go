@@ -0,0 +1,834 @@
+package scheduler
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"math/big"
+	"sort"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/filecoin-project/go-state-types/abi"
+	"github.com/filecoin-project/lotus/api"
+	"github.com/ipfs/go-cid"
+	"github.com/multiformats/go-multihash"
+	"github.com/stretchr/testify/require"
+	"github.com/textileio/powergate/v2/deals"
+	"github.com/textileio/powergate/v2/ffs"
+	"github.com/textileio/powergate/v2/ffs/joblogger"
+	"github.com/textileio/powergate/v2/index/faults"
+	"github.com/textileio/powergate/v2/tests"
+	txndstr "github.com/textileio/powergate/v2/txndstransform"
+	"github.com/textileio/powergate/v2/util"
+)
+
+func init() {
+	ScheduledEvalFrequency = time.Millisecond * 50
+}
+
+func TestRetry(t *testing.T) {
+	t.Parallel()
+	cs := newColdStorage()
+	cs.storeErr = fmt.Errorf("no miners available")
+	s := newScheduler(t, newHotStorage(), cs)
+	iid := ffs.NewAPIID()
+	c := randomCid()
+
+	cfg := coldOnlyConfig().WithRetryPolicy(2, 1, 1)
+	jid, err := s.PushConfig(iid, c, cfg, ffs.PriorityHigh, time.Time{})
+	require.NoError(t, err)
+	waitForJob(t, s, jid, ffs.Failed)
+
+	// The failed Job is retried by a Scheduled Job.
+	var retry ffs.StorageJob
+	require.Eventually(t, func() bool {
+		jobs := listJobs(s, iid, Scheduled)
+		if len(jobs) != 1 {
+			return false
+		}
+		retry = jobs[0]
+		return true
+	}, time.Second*5, time.Millisecond*10)
+	require.Equal(t, jid, retry.PreviousJobID)
+	require.Equal(t, 2, retry.Attempt)
+	require.Equal(t, ffs.PriorityHigh, retry.Priority)
+	require.Equal(t, retry.CreatedAt+1, retry.NotBefore)
+
+	// The retry is the last attempt, so it isn't retried again.
+	waitForJob(t, s, retry.ID, ffs.Failed)
+	require.Never(t, func() bool {
+		return len(listJobs(s, iid, All)) != 2
+	}, time.Millisecond*300, time.Millisecond*10)
+}
+
+func TestRetryCanceled(t *testing.T) {
+	t.Parallel()
+	cs := newColdStorage()
+	cs.storeErr = fmt.Errorf("no miners available")
+	s := newScheduler(t, newHotStorage(), cs)
+	iid := ffs.NewAPIID()
+	c := randomCid()
+
+	cfg := coldOnlyConfig().WithRetryPolicy(3, 60, 60)
+	jid, err := s.PushConfig(iid, c, cfg, ffs.PriorityNormal, time.Time{})
+	require.NoError(t, err)
+	waitForJob(t, s, jid, ffs.Failed)
+	var retries []ffs.StorageJob
+	require.Eventually(t, func() bool {
+		retries = listJobs(s, iid, Scheduled)
+		return len(retries) == 1
+	}, time.Second*5, time.Millisecond*10)
+
+	// Pushing a new StorageConfig for the Cid cancels the pending retry.
+	cs.setStoreErr(nil)
+	jid, err = s.PushConfig(iid, c, coldOnlyConfig(), ffs.PriorityNormal, time.Time{})
+	require.NoError(t, err)
+	waitForJob(t, s, retries[0].ID, ffs.Canceled)
+	waitForJob(t, s, jid, ffs.Success)
+}
+
+func TestPlanConfig(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	cs := newColdStorage()
+	s := newScheduler(t, newHotStorage(), cs)
+	iid := ffs.NewAPIID()
+	c := randomCid()
+
+	// New data is planned to be pinned, and stored in RepFactor deals.
+	cfg := coldOnlyConfig().WithHotEnabled(true).WithColdFilRepFactor(2).WithColdMaxPrice(10)
+	plan, err := s.PlanConfig(ctx, iid, c, cfg)
+	require.NoError(t, err)
+	require.Equal(t, c, plan.Cid)
+	require.True(t, plan.Hot.Pin)
+	require.False(t, plan.Hot.Unfreeze)
+	require.False(t, plan.Hot.Unpin)
+	require.Equal(t, 0, plan.Cold.ActiveDeals)
+	require.Len(t, plan.Cold.Deals, 2)
+	require.Equal(t, "20", plan.Cold.TotalCost.String())
+
+	// Planning doesn't create Jobs.
+	require.Empty(t, listJobs(s, iid, All))
+
+	jid, err := s.PushConfig(iid, c, coldOnlyConfig(), ffs.PriorityNormal, time.Time{})
+	require.NoError(t, err)
+	waitForJob(t, s, jid, ffs.Success)
+	info, err := s.GetStorageInfo(iid, c)
+	require.NoError(t, err)
+	require.Len(t, info.Cold.Filecoin.Proposals, 1)
+	miner := info.Cold.Filecoin.Proposals[0].Miner
+
+	// Only the missing deals are planned, excluding miners which
+	// already store the data.
+	plan, err = s.PlanConfig(ctx, iid, c, cfg.WithHotAllowUnfreeze(true))
+	require.NoError(t, err)
+	require.True(t, plan.Hot.Pin)
+	require.True(t, plan.Hot.Unfreeze)
+	require.Equal(t, 1, plan.Cold.ActiveDeals)
+	require.Len(t, plan.Cold.Deals, 1)
+	last := cs.planned[len(cs.planned)-1]
+	require.Equal(t, 1, last.RepFactor)
+	require.Contains(t, last.ExcludedMiners, miner)
+
+	// No deals are planned if the RepFactor is satisfied.
+	n := len(cs.planned)
+	plan, err = s.PlanConfig(ctx, iid, c, coldOnlyConfig())
+	require.NoError(t, err)
+	require.False(t, plan.Hot.Pin)
+	require.Equal(t, 1, plan.Cold.ActiveDeals)
+	require.Empty(t, plan.Cold.Deals)
+	require.Equal(t, "0", plan.Cold.TotalCost.String())
+	require.Len(t, cs.planned, n)
+
+	// Invalid configurations can't be planned.
+	_, err = s.PlanConfig(ctx, iid, c, coldOnlyConfig().WithColdFilRepFactor(0))
+	require.Error(t, err)
+}
+
+func TestPlanConfigPriceEscalation(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	cs := newColdStorage()
+	cs.asks = map[string]uint64{"f01": 5, "f02": 20, "f03": 50}
+	s := newScheduler(t, newHotStorage(), cs)
+	s.bt = budgetTracker{remaining: big.NewInt(100)}
+	iid := ffs.NewAPIID()
+	c := randomCid()
+	plannedMiners := func(plan ffs.StorageConfigPlan) []string {
+		var res []string
+		for _, d := range plan.Cold.Deals {
+			res = append(res, d.Miner)
+		}
+		return res
+	}
+
+	// Without price escalation, only miners below the max price are planned.
+	cfg := coldOnlyConfig().WithColdFilRepFactor(2).WithColdMaxPrice(10)
+	plan, err := s.PlanConfig(ctx, iid, c, cfg)
+	require.NoError(t, err)
+	require.Equal(t, []string{"f01"}, plannedMiners(plan))
+
+	// Price escalation plans the missing deals with a higher max price,
+	// excluding miners planned in previous steps, and the remaining budget.
+	cfg = cfg.WithColdFilPriceEscalation(10, 10, 30)
+	n := len(cs.planned)
+	plan, err = s.PlanConfig(ctx, iid, c, cfg)
+	require.NoError(t, err)
+	require.Equal(t, []string{"f01", "f02"}, plannedMiners(plan))
+	require.Equal(t, "25", plan.Cold.TotalCost.String())
+	require.Empty(t, plan.Cold.Warnings)
+	require.Len(t, cs.planned, n+2)
+	step := cs.planned[n+1]
+	require.Equal(t, uint64(20), step.MaxPrice)
+	require.Equal(t, 1, step.RepFactor)
+	require.Contains(t, step.ExcludedMiners, "f01")
+	require.Equal(t, "95", step.MaxCost.String())
+
+	// If the max price isn't enough for the RepFactor, it's warned.
+	plan, err = s.PlanConfig(ctx, iid, c, cfg.WithColdFilRepFactor(3))
+	require.NoError(t, err)
+	require.Equal(t, []string{"f01", "f02"}, plannedMiners(plan))
+	require.Len(t, plan.Cold.Warnings, 1)
+}
+
+type budgetTracker struct {
+	remaining *big.Int
+}
+
+func (bt budgetTracker) Remaining(iid ffs.APIID, c cid.Cid) (*big.Int, error) {
+	return bt.remaining, nil
+}
+
+func (bt budgetTracker) Reserve(iid ffs.APIID, c cid.Cid, amount *big.Int) (ffs.BudgetReservation, error) {
+	return nil, fmt.Errorf("not implemented")
+}
+
+type hotStorage struct {
+	lock   sync.Mutex
+	pinned map[cid.Cid]time.Time
+	// pinErr is returned when pinning data that isn't
+	// already pinned, e.g: unavailable in the IPFS network.
+	pinErr error
+	// stageErr is returned when staging data from the
+	// IPFS network.
+	stageErr error
+	caches   int
+}
+
+var _ ffs.HotStorage = (*hotStorage)(nil)
+
+func newHotStorage() *hotStorage {
+	return &hotStorage{pinned: make(map[cid.Cid]time.Time)}
+}
+
+func (hs *hotStorage) Stage(ctx context.Context, iid ffs.APIID, r io.Reader) (cid.Cid, error) {
+	return cid.Undef, fmt.Errorf("not implemented")
+}
+
+func (hs *hotStorage) StageCid(ctx context.Context, iid ffs.APIID, c cid.Cid) error {
+	return hs.stageErr
+}
+
+func (hs *hotStorage) StageCar(ctx context.Context, iid ffs.APIID, r io.Reader) ([]cid.Cid, error) {
+	return nil, fmt.Errorf("not implemented")
+}
+
+func (hs *hotStorage) Unpin(ctx context.Context, iid ffs.APIID, c cid.Cid) error {
+	hs.lock.Lock()
+	defer hs.lock.Unlock()
+	delete(hs.pinned, c)
+	return nil
+}
+
+func (hs *hotStorage) Get(ctx context.Context, c cid.Cid, p string, offset, length int64) (io.Reader, error) {
+	hs.lock.Lock()
+	defer hs.lock.Unlock()
+	if _, ok := hs.pinned[c]; !ok {
+		return nil, fmt.Errorf("data not found")
+	}
+	return bytes.NewReader([]byte(c.String())), nil
+}
+
+func (hs *hotStorage) Pin(ctx context.Context, iid ffs.APIID, c cid.Cid) (int, error) {
+	hs.lock.Lock()
+	defer hs.lock.Unlock()
+	if _, ok := hs.pinned[c]; !ok && hs.pinErr != nil {
+		return 0, hs.pinErr
+	}
+	hs.pinned[c] = time.Time{}
+	return 100, nil
+}
+
+func (hs *hotStorage) Replace(ctx context.Context, iid ffs.APIID, c1 cid.Cid, c2 cid.Cid) (int, error) {
+	hs.lock.Lock()
+	delete(hs.pinned, c1)
+	hs.lock.Unlock()
+	return hs.Pin(ctx, iid, c2)
+}
+
+func (hs *hotStorage) IsPinned(ctx context.Context, iid ffs.APIID, c cid.Cid) (bool, error) {
+	hs.lock.Lock()
+	defer hs.lock.Unlock()
+	_, ok := hs.pinned[c]
+	return ok, nil
+}
+
+func (hs *hotStorage) Cache(ctx context.Context, iid ffs.APIID, c cid.Cid, ttl time.Duration) (int, error) {
+	hs.lock.Lock()
+	defer hs.lock.Unlock()
+	if _, ok := hs.pinned[c]; !ok && hs.pinErr != nil {
+		return 0, hs.pinErr
+	}
+	hs.pinned[c] = time.Now().Add(ttl)
+	hs.caches++
+	return 100, nil
+}
+
+func (hs *hotStorage) CacheExpiration(ctx context.Context, iid ffs.APIID, c cid.Cid) (time.Time, error) {
+	hs.lock.Lock()
+	defer hs.lock.Unlock()
+	return hs.pinned[c], nil
+}
+
+func (hs *hotStorage) GCStaged(ctx context.Context, exclude []cid.Cid, olderThan time.Time) ([]cid.Cid, error) {
+	return nil, nil
+}
+
+func (hs *hotStorage) PinnedCids(ctx context.Context) ([]ffs.PinnedCid, error) {
+	return nil, nil
+}
+
+type fetch struct {
+	dataCid  cid.Cid
+	miners   []string
+	maxPrice uint64
+}
+
+type coldStorage struct {
+	lock      sync.Mutex
+	storeErr  error
+	proposals map[cid.Cid]ffs.FilStorage
+	fetches   []fetch
+	// fetchCost is the FundsSpent of fetches.
+	fetchCost uint64
+	// renewalsDue is the result of RenewalsDue.
+	renewalsDue int
+	renewals    int
+	// planned are the configurations of Plan calls.
+	planned []ffs.FilConfig
+	// asks, if not nil, are the prices of the miners that Plan
+	// selects, which are filtered by the max price.
+	asks map[string]uint64
+	// block, if not nil, blocks Store until it's closed
+	// or the context is canceled.
+	block chan struct{}
+	// onFetch, if not nil, is called by Fetch, e.g: to make
+	// the retrieved data available in hot storage.
+	onFetch func()
+	// aggRoot, if defined, is the root of the aggregate
+	// where StoreAggregated stores data.
+	aggRoot cid.Cid
+	// released are the aggregates released by ReleaseAggregate.
+	released []cid.Cid
+}
+
+var _ ffs.ColdStorage = (*coldStorage)(nil)
+
+func newColdStorage() *coldStorage {
+	return &coldStorage{proposals: make(map[cid.Cid]ffs.FilStorage)}
+}
+
+func (cs *coldStorage) Store(ctx context.Context, c cid.Cid, cfg ffs.FilConfig) ([]cid.Cid, []ffs.DealError, abi.PaddedPieceSize, error) {
+	if cs.block != nil {
+		select {
+		case <-cs.block:
+		case <-ctx.Done():
+			return nil, nil, 0, ctx.Err()
+		}
+	}
+	cs.lock.Lock()
+	defer cs.lock.Unlock()
+	if cs.storeErr != nil {
+		return nil, nil, 0, cs.storeErr
+	}
+	var started []cid.Cid
+	for i := 0; i < cfg.RepFactor; i++ {
+		dealID := uint64(len(cs.proposals) + 1)
+		pc := cidFromString(fmt.Sprintf("proposal%d", dealID))
+		cs.proposals[pc] = ffs.FilStorage{
+			DealID:     dealID,
+			Duration:   cfg.DealMinDuration,
+			StartEpoch: 1000,
+			Miner:      fmt.Sprintf("f0%d", dealID),
+			EpochPrice: cfg.MaxPrice,
+		}
+		started = append(started, pc)
+	}
+	return started, nil, 1024, nil
+}
+
+func (cs *coldStorage) StoreAggregated(ctx context.Context, c cid.Cid, cfg ffs.FilConfig) (ffs.Aggregate, []cid.Cid, []ffs.DealError, abi.PaddedPieceSize, error) {
+	if cs.aggRoot.Defined() {
+		started, rejected, size, err := cs.Store(ctx, cs.aggRoot, cfg)
+		return ffs.Aggregate{Root: cs.aggRoot, Path: "/" + c.String()}, started, rejected, size, err
+	}
+	started, rejected, size, err := cs.Store(ctx, c, cfg)
+	return ffs.Aggregate{Root: c}, started, rejected, size, err
+}
+
+func (cs *coldStorage) ReleaseAggregate(ctx context.Context, root cid.Cid) error {
+	cs.lock.Lock()
+	defer cs.lock.Unlock()
+	cs.released = append(cs.released, root)
+	return nil
+}
+
+func (cs *coldStorage) WaitForDeal(ctx context.Context, c cid.Cid, pc cid.Cid, timeout time.Duration, dealUpdates chan deals.StorageDealInfo) (ffs.FilStorage, error) {
+	cs.lock.Lock()
+	defer cs.lock.Unlock()
+	fs, ok := cs.proposals[pc]
+	if !ok {
+		return ffs.FilStorage{}, ffs.DealError{ProposalCid: pc, Message: "proposal not found"}
+	}
+	return fs, nil
+}
+
+func (cs *coldStorage) Fetch(ctx context.Context, dataCid cid.Cid, pieceCid *cid.Cid, waddr string, miners []string, maxPrice uint64, selector string) (ffs.FetchInfo, error) {
+	cs.lock.Lock()
+	defer cs.lock.Unlock()
+	cs.fetches = append(cs.fetches, fetch{dataCid: dataCid, miners: miners, maxPrice: maxPrice})
+	if len(miners) == 0 {
+		return ffs.FetchInfo{}, fmt.Errorf("no miners to retrieve from")
+	}
+	if cs.onFetch != nil {
+		cs.onFetch()
+	}
+	return ffs.FetchInfo{RetrievedMiner: miners[0], FundsSpent: cs.fetchCost}, nil
+}
+
+func (cs *coldStorage) EnsureRenewals(ctx context.Context, c cid.Cid, inf ffs.FilInfo, cfg ffs.FilConfig, dealFinalityTimeout time.Duration, dealUpdates chan deals.StorageDealInfo) (ffs.FilInfo, []ffs.DealError, error) {
+	cs.lock.Lock()
+	defer cs.lock.Unlock()
+	due := cs.renewalsDue
+	proposals := make([]ffs.FilStorage, 0, len(inf.Proposals)+due)
+	for i, p := range inf.Proposals {
+		if i < due {
+			p.Renewed = true
+			renewal := p
+			renewal.DealID += 1000
+			renewal.Renewed = false
+			proposals = append(proposals, renewal)
+			cs.renewals++
+		}
+		proposals = append(proposals, p)
+	}
+	inf.Proposals = proposals
+	cs.renewalsDue = 0
+	return inf, nil, nil
+}
+
+func (cs *coldStorage) RenewalsDue(ctx context.Context, inf ffs.FilInfo, cfg ffs.FilConfig) (int, error) {
+	cs.lock.Lock()
+	defer cs.lock.Unlock()
+	return cs.renewalsDue, nil
+}
+
+func (cs *coldStorage) GetDealInfo(ctx context.Context, dealID uint64) (api.MarketDeal, error) {
+	return api.MarketDeal{}, nil
+}
+
+func (cs *coldStorage) Plan(ctx context.Context, c cid.Cid, cfg ffs.FilConfig) (ffs.ColdPlan, error) {
+	cs.lock.Lock()
+	defer cs.lock.Unlock()
+	cs.planned = append(cs.planned, cfg)
+	res := ffs.ColdPlan{PieceSize: 1024, TotalCost: big.NewInt(0)}
+	if cs.asks != nil {
+		excluded := make(map[string]struct{}, len(cfg.ExcludedMiners))
+		for _, m := range cfg.ExcludedMiners {
+			excluded[m] = struct{}{}
+		}
+		miners := make([]string, 0, len(cs.asks))
+		for m := range cs.asks {
+			miners = append(miners, m)
+		}
+		sort.Strings(miners)
+		for _, m := range miners {
+			if _, ok := excluded[m]; ok || cs.asks[m] > cfg.MaxPrice || len(res.Deals) == cfg.RepFactor {
+				continue
+			}
+			d := ffs.PlannedDeal{Miner: m, EpochPrice: cs.asks[m], Cost: big.NewInt(int64(cs.asks[m]))}
+			res.Deals = append(res.Deals, d)
+			res.TotalCost.Add(res.TotalCost, d.Cost)
+		}
+		if len(res.Deals) == 0 {
+			return ffs.ColdPlan{}, fmt.Errorf("no miners available")
+		}
+		return res, nil
+	}
+	for i := 0; i < cfg.RepFactor; i++ {
+		d := ffs.PlannedDeal{
+			Miner:      fmt.Sprintf("f0%d", 100+i),
+			EpochPrice: cfg.MaxPrice,
+			Cost:       big.NewInt(int64(cfg.MaxPrice)),
+		}
+		res.Deals = append(res.Deals, d)
+		res.TotalCost.Add(res.TotalCost, d.Cost)
+	}
+	return res, nil
+}
+
+func (cs *coldStorage) setStoreErr(err error) {
+	cs.lock.Lock()
+	defer cs.lock.Unlock()
+	cs.storeErr = err
+}
+
+func TestOfflineDeals(t *testing.T) {
+	t.Parallel()
+	hs := newHotStorage()
+	cs := newColdStorage()
+	s := newScheduler(t, hs, cs)
+	iid := ffs.NewAPIID()
+	c := randomCid()
+
+	// Offline deals data is transferred manually to miners, so it
+	// isn't staged from the IPFS network.
+	hs.stageErr = fmt.Errorf("data isn't available")
+	cfg := coldOnlyConfig().WithColdFilOffline("baga6ea4seaqao7s73y24kcutaosvacpdjgfe5pw76ooefnyqw4ynr3d2y6x2mpq", 1<<20)
+	require.NoError(t, cfg.Validate())
+	jid, err := s.PushConfig(iid, c, cfg, ffs.PriorityNormal, time.Time{})
+	require.NoError(t, err)
+	waitForJob(t, s, jid, ffs.Success)
+	info, err := s.GetStorageInfo(iid, c)
+	require.NoError(t, err)
+	require.Len(t, info.Cold.Filecoin.Proposals, 1)
+	require.Equal(t, c, info.Cold.Filecoin.DataCid)
+
+	// Online deals need the data.
+	c = randomCid()
+	jid, err = s.PushConfig(iid, c, coldOnlyConfig(), ffs.PriorityNormal, time.Time{})
+	require.NoError(t, err)
+	waitForJob(t, s, jid, ffs.Failed)
+}
+
+func TestMinEvalFrequency(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	s := newScheduler(t, newHotStorage(), newColdStorage())
+	iid := ffs.NewAPIID()
+	c := randomCid()
+
+	cfg := coldOnlyConfig().WithEvalFrequency(int(MinEvalFrequency/time.Second) - 1)
+	_, err := s.PushConfig(iid, c, cfg, ffs.PriorityNormal, time.Time{})
+	require.Error(t, err)
+	_, err = s.PlanConfig(ctx, iid, c, cfg)
+	require.Error(t, err)
+	require.Empty(t, listJobs(s, iid, All))
+
+	cfg = coldOnlyConfig().WithEvalFrequency(int(MinEvalFrequency / time.Second))
+	jid, err := s.PushConfig(iid, c, cfg, ffs.PriorityNormal, time.Time{})
+	require.NoError(t, err)
+	waitForJob(t, s, jid, ffs.Success)
+}
+
+func TestDrainInterrupt(t *testing.T) {
+	t.Parallel()
+	cs := newColdStorage()
+	cs.block = make(chan struct{})
+	s := newScheduler(t, newHotStorage(), cs)
+	iid := ffs.NewAPIID()
+	c := randomCid()
+
+	jid, err := s.PushConfig(iid, c, coldOnlyConfig(), ffs.PriorityNormal, time.Time{})
+	require.NoError(t, err)
+	waitForJob(t, s, jid, ffs.Executing)
+
+	// Reaching the drain deadline re-queues the executing
+	// job instead of finalizing it.
+	err = s.Drain(time.Millisecond * 100)
+	require.NoError(t, err)
+	waitForJob(t, s, jid, ffs.Queued)
+	require.Never(t, func() bool {
+		j, err := s.StorageJob(jid)
+		return err != nil || j.Status != ffs.Queued
+	}, time.Millisecond*300, time.Millisecond*10)
+
+	// Undraining executes it again.
+	close(cs.block)
+	s.Undrain()
+	waitForJob(t, s, jid, ffs.Success)
+}
+
+func TestRenewUnfreeze(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	hs := newHotStorage()
+	cs := newColdStorage()
+	s := newScheduler(t, hs, cs)
+	iid := ffs.NewAPIID()
+	c := randomCid()
+
+	cfg := coldOnlyConfig().WithColdFilRenew(true, 100).WithColdFilRenewUnfreeze(true).WithUnfreezeMaxPrice(200)
+	jid, err := s.PushConfig(iid, c, cfg, ffs.PriorityNormal, time.Time{})
+	require.NoError(t, err)
+	waitForJob(t, s, jid, ffs.Success)
+
+	// A due renewal unfreezes the data paying at most the
+	// unfreeze max price, renews the deal, and unpins the data.
+	cs.lock.Lock()
+	cs.renewalsDue = 1
+	cs.fetchCost = 50
+	cs.lock.Unlock()
+	jid, err = s.PushConfig(iid, c, cfg, ffs.PriorityNormal, time.Time{})
+	require.NoError(t, err)
+	waitForJob(t, s, jid, ffs.Success)
+
+	info, err := s.GetStorageInfo(iid, c)
+	require.NoError(t, err)
+	require.Len(t, info.Cold.Filecoin.Proposals, 2)
+	var renewed int
+	for _, p := range info.Cold.Filecoin.Proposals {
+		if p.Renewed {
+			renewed++
+		}
+	}
+	require.Equal(t, 1, renewed)
+	require.Equal(t, uint64(50), info.Cold.Filecoin.RetrievalCost)
+	pinned, err := hs.IsPinned(ctx, iid, c)
+	require.NoError(t, err)
+	require.False(t, pinned)
+	cs.lock.Lock()
+	require.Len(t, cs.fetches, 1)
+	require.Equal(t, c, cs.fetches[0].dataCid)
+	require.Equal(t, uint64(200), cs.fetches[0].maxPrice)
+	require.Equal(t, 1, cs.renewals)
+	cs.lock.Unlock()
+
+	// Without due renewals the data isn't unfrozen, and the
+	// retrieval cost is accumulated over unfreezes.
+	jid, err = s.PushConfig(iid, c, cfg, ffs.PriorityNormal, time.Time{})
+	require.NoError(t, err)
+	waitForJob(t, s, jid, ffs.Success)
+	cs.lock.Lock()
+	require.Len(t, cs.fetches, 1)
+	cs.renewalsDue = 1
+	cs.lock.Unlock()
+	jid, err = s.PushConfig(iid, c, cfg, ffs.PriorityNormal, time.Time{})
+	require.NoError(t, err)
+	waitForJob(t, s, jid, ffs.Success)
+	info, err = s.GetStorageInfo(iid, c)
+	require.NoError(t, err)
+	require.Equal(t, uint64(100), info.Cold.Filecoin.RetrievalCost)
+}
+
+func TestRetrieval(t *testing.T) {
+	t.Parallel()
+	hs := newHotStorage()
+	cs := newColdStorage()
+	cs.fetchCost = 50
+	s := newScheduler(t, hs, cs)
+	iid := ffs.NewAPIID()
+	rid := ffs.NewRetrievalID()
+	payloadCid := randomCid()
+	pieceCid := randomCid()
+
+	// Selectors aren't supported.
+	_, err := s.StartRetrieval(iid, rid, payloadCid, pieceCid, "sel", []string{"f01"}, "t3addr", 100, 0)
+	require.Error(t, err)
+
+	jid, err := s.StartRetrieval(iid, rid, payloadCid, pieceCid, "", []string{"f01"}, "t3addr", 100, 0)
+	require.NoError(t, err)
+	require.Eventually(t, func() bool {
+		j, err := s.RetrievalJob(jid)
+		return err == nil && j.Status == ffs.Success
+	}, time.Second*5, time.Millisecond*10)
+
+	// The retrieved payload is pinned, and the max price is
+	// enforced by the cold storage.
+	info, err := s.GetRetrievalInfo(rid)
+	require.NoError(t, err)
+	require.Equal(t, payloadCid, info.DataCid)
+	require.Equal(t, uint64(50), info.TotalPaid)
+	require.Equal(t, "f01", info.MinerAddr)
+	pinned, err := hs.IsPinned(context.Background(), iid, payloadCid)
+	require.NoError(t, err)
+	require.True(t, pinned)
+	cs.lock.Lock()
+	require.Equal(t, []fetch{{dataCid: payloadCid, miners: []string{"f01"}, maxPrice: 100}}, cs.fetches)
+	cs.lock.Unlock()
+}
+
+func TestRetrievalCache(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	hs := newHotStorage()
+	cs := newColdStorage()
+	cs.fetchCost = 50
+	s := newScheduler(t, hs, cs)
+	iid := ffs.NewAPIID()
+	payloadCid := randomCid()
+	pieceCid := randomCid()
+
+	retrieve := func() ffs.RetrievalInfo {
+		rid := ffs.NewRetrievalID()
+		jid, err := s.StartRetrieval(iid, rid, payloadCid, pieceCid, "", []string{"f01"}, "t3addr", 100, 60)
+		require.NoError(t, err)
+		require.Eventually(t, func() bool {
+			j, err := s.RetrievalJob(jid)
+			return err == nil && j.Status == ffs.Success
+		}, time.Second*5, time.Millisecond*10)
+		info, err := s.GetRetrievalInfo(rid)
+		require.NoError(t, err)
+		return info
+	}
+
+	// The retrieved payload is cached with the TTL.
+	info := retrieve()
+	require.Equal(t, uint64(50), info.TotalPaid)
+	exp, err := hs.CacheExpiration(ctx, iid, payloadCid)
+	require.NoError(t, err)
+	require.WithinDuration(t, time.Now().Add(time.Minute), exp, time.Second*5)
+
+	// Retrieving cached data doesn't pay for a new retrieval,
+	// and refreshes the cache.
+	info = retrieve()
+	require.Equal(t, payloadCid, info.DataCid)
+	require.Zero(t, info.TotalPaid)
+	hs.lock.Lock()
+	require.Equal(t, 2, hs.caches)
+	hs.lock.Unlock()
+	cs.lock.Lock()
+	require.Len(t, cs.fetches, 1)
+	cs.lock.Unlock()
+
+	// Fully pinned data isn't switched to a cache-pin.
+	c := randomCid()
+	_, err = hs.Pin(ctx, iid, c)
+	require.NoError(t, err)
+	payloadCid = c
+	info = retrieve()
+	require.Zero(t, info.TotalPaid)
+	exp, err = hs.CacheExpiration(ctx, iid, c)
+	require.NoError(t, err)
+	require.True(t, exp.IsZero())
+}
+
+func TestUnfreezeCacheAggregate(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	hs := newHotStorage()
+	hs.pinErr = fmt.Errorf("data unavailable in the IPFS network")
+	cs := newColdStorage()
+	cs.onFetch = func() {
+		hs.lock.Lock()
+		hs.pinErr = nil
+		hs.lock.Unlock()
+	}
+	s := newScheduler(t, hs, cs)
+	iid := ffs.NewAPIID()
+	c := randomCid()
+	root := randomCid()
+	curr := ffs.StorageInfo{
+		APIID: iid,
+		Cid:   c,
+		Cold: ffs.ColdInfo{
+			Enabled: true,
+			Filecoin: ffs.FilInfo{
+				DataCid:       root,
+				AggregatePath: "/0",
+				Proposals:     []ffs.FilStorage{{DealID: 1, Miner: "f01"}},
+			},
+		},
+	}
+	cfg := ffs.HotConfig{
+		Enabled:          true,
+		AllowUnfreeze:    true,
+		UnfreezeCacheTTL: 60,
+		Ipfs:             ffs.IpfsConfig{AddTimeout: 10},
+	}
+
+	// Unfreezing aggregated data retrieves the whole aggregate, which
+	// is cached, and the data is pinned.
+	hot, err := s.executeEnabledHotStorage(ctx, iid, curr, cfg, "t3addr", cid.Undef)
+	require.NoError(t, err)
+	require.True(t, hot.Enabled)
+	exp, err := hs.CacheExpiration(ctx, iid, root)
+	require.NoError(t, err)
+	require.False(t, exp.IsZero())
+	exp, err = hs.CacheExpiration(ctx, iid, c)
+	require.NoError(t, err)
+	require.True(t, exp.IsZero())
+	pinned, err := hs.IsPinned(ctx, iid, c)
+	require.NoError(t, err)
+	require.True(t, pinned)
+	hs.lock.Lock()
+	require.Equal(t, 1, hs.caches)
+	hs.lock.Unlock()
+	cs.lock.Lock()
+	require.Len(t, cs.fetches, 1)
+	require.Equal(t, root, cs.fetches[0].dataCid)
+	cs.lock.Unlock()
+}
+
+func newScheduler(t *testing.T, hs ffs.HotStorage, cs ffs.ColdStorage) *Scheduler {
+	return newSchedulerWithFaults(t, hs, cs, nil)
+}
+
+func newSchedulerWithFaults(t *testing.T, hs ffs.HotStorage, cs ffs.ColdStorage, fi faults.Module) *Scheduler {
+	ds := tests.NewTxMapDatastore()
+	l := joblogger.New(txndstr.Wrap(ds, "joblogger"))
+	s, err := New(txndstr.Wrap(ds, "scheduler"), l, hs, cs, 10, time.Minute, nil, GCConfig{}, EvalConfig{}, RetentionConfig{}, nil, fi, nil)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		require.NoError(t, s.Close())
+	})
+	return s
+}
+
+func coldOnlyConfig() ffs.StorageConfig {
+	return ffs.StorageConfig{
+		Hot: ffs.HotConfig{
+			Ipfs: ffs.IpfsConfig{AddTimeout: 10},
+		},
+		Cold: ffs.ColdConfig{
+			Enabled: true,
+			Filecoin: ffs.FilConfig{
+				RepFactor:       1,
+				DealMinDuration: util.MinDealDuration,
+				Addr:            "t3addr",
+			},
+		},
+	}
+}
+
+func waitForJob(t *testing.T, s *Scheduler, jid ffs.JobID, status ffs.JobStatus) ffs.StorageJob {
+	var j ffs.StorageJob
+	require.Eventually(t, func() bool {
+		var err error
+		j, err = s.StorageJob(jid)
+		return err == nil && j.Status == status
+	}, time.Second*5, time.Millisecond*10, "job %s didn't reach status %s", jid, ffs.JobStatusStr[status])
+	return j
+}
+
+// listJobs lists the StorageJobs of iid, or returns nil if listing fails.
+// It doesn't fail the test, so it can be used in require.Eventually conditions.
+func listJobs(s *Scheduler, iid ffs.APIID, sel Select) []ffs.StorageJob {
+	jobs, _, _, err := s.ListStorageJobs(ListStorageJobsConfig{APIIDFilter: iid, Select: sel})
+	if err != nil {
+		return nil
+	}
+	return jobs
+}
+
+func randomCid() cid.Cid {
+	return cidFromString(string(ffs.NewJobID()))
+}
+
+func cidFromString(s string) cid.Cid {
+	h, _ := multihash.Sum([]byte(s), multihash.SHA2_256, -1)
+	return cid.NewCidV1(cid.Raw, h)
+}
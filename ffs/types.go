@@ -3,6 +3,7 @@ package ffs
 import (
 	"context"
 	"fmt"
+	"math/big"
 	"time"
 
 	"github.com/google/uuid"
@@ -97,6 +98,9 @@ const (
 	Canceled
 	// Success indicates the Job was successfully executed.
 	Success
+	// Scheduled indicates the Job is waiting for its NotBefore
+	// time to be Queued in the Scheduler.
+	Scheduled
 )
 
 // JobStatusStr maps JobStatus to describing string.
@@ -107,6 +111,56 @@ var JobStatusStr = map[JobStatus]string{
 	Failed:      "Failed",
 	Canceled:    "Canceled",
 	Success:     "Success",
+	Scheduled:   "Scheduled",
+}
+
+// JobPriority is the priority of a StorageJob in the Scheduler queue.
+// Queued jobs with higher priority are executed first, and jobs with
+// the same priority are executed in creation order.
+type JobPriority int
+
+const (
+	// PriorityLow is used for batch workloads that can wait for
+	// other jobs to be executed first.
+	PriorityLow JobPriority = iota - 1
+	// PriorityNormal is the default priority.
+	PriorityNormal
+	// PriorityHigh is used for interactive workloads.
+	PriorityHigh
+	// PriorityUrgent is used by the Scheduler for repair and
+	// renewal jobs.
+	PriorityUrgent
+)
+
+// JobPriorityStr maps JobPriority to describing string.
+var JobPriorityStr = map[JobPriority]string{
+	PriorityLow:    "Low",
+	PriorityNormal: "Normal",
+	PriorityHigh:   "High",
+	PriorityUrgent: "Urgent",
+}
+
+// FairShare configures how an APIID shares storage job execution
+// slots with other APIIDs in the Scheduler.
+type FairShare struct {
+	// Weight is the relative share of execution slots of the APIID
+	// compared to other APIIDs. APIIDs without a configured FairShare
+	// have weight 1.
+	Weight int
+	// MaxExecuting is the maximum number of concurrently executing
+	// storage jobs for the APIID. Zero means no limit.
+	MaxExecuting int
+}
+
+// Validate validates a FairShare.
+func (fs FairShare) Validate() error {
+	if fs.Weight <= 0 {
+		return fmt.Errorf("weight should be positive")
+	}
+	if fs.MaxExecuting < 0 {
+		return fmt.Errorf("max executing jobs can't be negative")
+	}
+	return nil
 }
 
 // StorageJob is a task executed by the Scheduler.
@@ -118,7 +172,18 @@ type StorageJob struct {
 	ErrCause   string
 	DealInfo   []deals.StorageDealInfo
 	DealErrors []DealError
-	CreatedAt  int64
+	Priority   JobPriority
+	// Attempt is the execution attempt number of the StorageConfig
+	// this Job executes, starting at 1. It's greater than 1 for Jobs
+	// created by a RetryPolicy.
+	Attempt int
+	// PreviousJobID is the failed Job that was retried by this Job,
+	// or empty if this Job isn't a retry.
+	PreviousJobID JobID
+	// NotBefore is the unix timestamp before which a Scheduled
+	// Job won't be Queued. Zero if the Job execution wasn't deferred.
+	NotBefore int64
+	CreatedAt int64
 }
 
 // RetrievalJob is a retrieval task executed by the Scheduler.
@@ -135,6 +200,11 @@ type StorageConfig struct {
 	Hot        HotConfig
 	Cold       ColdConfig
 	Repairable bool
+	Retry      RetryPolicy
+	// EvalFrequency is the number of seconds between renewal and
+	// repair evaluations of the StorageConfig. Zero uses the
+	// Scheduler default frequencies.
+	EvalFrequency int
 }
 
 // WithRepairable allows to enable/disable auto-repair.
@@ -143,6 +213,21 @@ func (s StorageConfig) WithRepairable(enabled bool) StorageConfig {
 	return s
 }
 
+// WithRetryPolicy configures automatic retries of failed Jobs.
+func (s StorageConfig) WithRetryPolicy(maxAttempts, initialBackoff, maxBackoff int) StorageConfig {
+	s.Retry.MaxAttempts = maxAttempts
+	s.Retry.InitialBackoff = initialBackoff
+	s.Retry.MaxBackoff = maxBackoff
+	return s
+}
+
+// WithEvalFrequency sets the number of seconds between renewal and
+// repair evaluations.
+func (s StorageConfig) WithEvalFrequency(seconds int) StorageConfig {
+	s.EvalFrequency = seconds
+	return s
+}
+
 // WithColdEnabled allows to enable/disable Cold storage usage.
 func (s StorageConfig) WithColdEnabled(enabled bool) StorageConfig {
 	s.Cold.Enabled = enabled
@@ -170,6 +255,28 @@ func (s StorageConfig) WithColdFilCountryCodes(countryCodes []string) StorageCon
 	return s
 }
 
+// WithColdFilMinCountries defines the minimum number of distinct countries
+// where miners storing the data should be located.
+func (s StorageConfig) WithColdFilMinCountries(minCountries int) StorageConfig {
+	s.Cold.Filecoin.MinCountries = minCountries
+	return s
+}
+
+// WithColdFilMinerQuality defines the minimum relative power, maximum
+// recent faults, maximum faulty sectors ratio and allowed sector size of
+// miners selected for new deals. Zero values disable each filter, except
+// for maxFaults where a negative value disables it.
+func (s StorageConfig) WithColdFilMinerQuality(minRelativePower float64, maxFaults int, maxFaultyRatio float64, sectorSize uint64) StorageConfig {
+	s.Cold.Filecoin.MinRelativePower = minRelativePower
+	s.Cold.Filecoin.MaxFaults = nil
+	if maxFaults >= 0 {
+		s.Cold.Filecoin.MaxFaults = &maxFaults
+	}
+	s.Cold.Filecoin.MaxFaultyRatio = maxFaultyRatio
+	s.Cold.Filecoin.SectorSize = sectorSize
+	return s
+}
+
 // WithColdFilExcludedMiners defines a list of miner addresses which won't be selected for
 // making deals, no matter if they comply to other filters in the configuration.
 func (s StorageConfig) WithColdFilExcludedMiners(miners []string) StorageConfig {
@@ -207,6 +314,13 @@ func (s StorageConfig) WithColdFilRenew(enabled bool, threshold int) StorageConf
 	return s
 }
 
+// WithColdFilRenewUnfreeze specifies if cold-only data should be temporarily
+// unfrozen from Filecoin to renew deals.
+func (s StorageConfig) WithColdFilRenewUnfreeze(unfreeze bool) StorageConfig {
+	s.Cold.Filecoin.Renew.Unfreeze = unfreeze
+	return s
+}
+
 // WithColdMaxPrice specifies the max price that should be considered for
 // deal asks even when all other filers match.
 func (s StorageConfig) WithColdMaxPrice(maxPrice uint64) StorageConfig {
@@ -214,6 +328,38 @@ func (s StorageConfig) WithColdMaxPrice(maxPrice uint64) StorageConfig {
 	return s
 }
 
+// WithColdFilPriceEscalation specifies that if not enough deals are made, miner
+// selection should be retried with max prices from startPrice to maxPrice,
+// increasing by step. A zero step disables price escalation.
+func (s StorageConfig) WithColdFilPriceEscalation(startPrice, step, maxPrice uint64) StorageConfig {
+	s.Cold.Filecoin.PriceEscalation.StartPrice = startPrice
+	s.Cold.Filecoin.PriceEscalation.Step = step
+	s.Cold.Filecoin.PriceEscalation.MaxPrice = maxPrice
+	return s
+}
+
+// WithColdFilAggregate specifies that small data can be aggregated with
+// other small data and stored in shared deals.
+func (s StorageConfig) WithColdFilAggregate(enabled bool) StorageConfig {
+	s.Cold.Filecoin.Aggregate = enabled
+	return s
+}
+
+// WithColdFilOffline specifies that new deals are offline deals for
+// a known piece, whose data is transferred manually to miners.
+func (s StorageConfig) WithColdFilOffline(pieceCid string, pieceSize uint64) StorageConfig {
+	s.Cold.Filecoin.Offline.PieceCid = pieceCid
+	s.Cold.Filecoin.Offline.PieceSize = pieceSize
+	return s
+}
+
+// WithColdFilDataCapPolicy specifies what to do if the remaining DataCap
+// doesn't cover all new verified deals.
+func (s StorageConfig) WithColdFilDataCapPolicy(policy DataCapPolicy) StorageConfig {
+	s.Cold.Filecoin.DataCapPolicy = policy
+	return s
+}
+
 // WithVerifiedDeal specifies that new deals will be marked as verified assuming
 // the wallet address is a verified-client.
 func (s StorageConfig) WithVerifiedDeal(enabled bool) StorageConfig {
@@ -260,6 +406,13 @@ func (s StorageConfig) WithUnfreezeMaxPrice(maxPrice uint64) StorageConfig {
 	return s
 }
 
+// WithUnfreezeCacheTTL indicates for how many seconds data unfrozen from
+// cold storage while hot storage is disabled is kept cached in hot storage.
+func (s StorageConfig) WithUnfreezeCacheTTL(seconds int) StorageConfig {
+	s.Hot.UnfreezeCacheTTL = seconds
+	return s
+}
+
 // Validate validates a StorageConfig.
 func (s StorageConfig) Validate() error {
 	if err := s.Hot.Validate(); err != nil {
@@ -268,10 +421,80 @@ func (s StorageConfig) Validate() error {
 	if err := s.Cold.Validate(); err != nil {
 		return fmt.Errorf("cold-filecoin config is invalid: %s", err)
 	}
-	// We can't accept being renewable without hot storage enabled.
-	// See the (**) note in scheduler.go
-	if s.Cold.Enabled && s.Cold.Filecoin.Renew.Enabled && !s.Hot.Enabled {
-		return fmt.Errorf("hot storage should be enabled to enable renewals")
+	if err := s.Retry.Validate(); err != nil {
+		return fmt.Errorf("retry policy is invalid: %s", err)
+	}
+	if s.EvalFrequency < 0 {
+		return fmt.Errorf("eval frequency can't be negative")
+	}
+	// We can't accept being renewable without hot storage enabled, unless
+	// the data can be unfrozen for renewals. See the (**) note in scheduler_storage.go
+	if s.Cold.Enabled && s.Cold.Filecoin.Renew.Enabled && !s.Hot.Enabled && !s.Cold.Filecoin.Renew.Unfreeze {
+		return fmt.Errorf("hot storage or renew unfreeze should be enabled to enable renewals")
+	}
+	// Offline deals data is transferred manually to miners, so it might
+	// not be available in the IPFS network to be pinned.
+	if s.Cold.Enabled && s.Cold.Filecoin.Offline.Enabled() && s.Hot.Enabled {
+		return fmt.Errorf("hot storage should be disabled for offline deals")
+	}
+	return nil
+}
+
+// maxRetryBackoff is the maximum backoff of a RetryPolicy.
+const maxRetryBackoff = 30 * 24 * time.Hour
+
+// RetryPolicy configures automatic retries of Jobs that end
+// with Failed status.
+type RetryPolicy struct {
+	// MaxAttempts is the maximum number of times a StorageConfig is
+	// executed, including the first attempt. Zero or one disables retries.
+	MaxAttempts int
+	// InitialBackoff is the number of seconds to wait before the
+	// first retry. Every following retry doubles the wait.
+	InitialBackoff int
+	// MaxBackoff is an upper bound in seconds of the wait between
+	// retries.
+	MaxBackoff int
+}
+
+// Enabled returns true if failed Jobs should be retried.
+func (rp RetryPolicy) Enabled() bool {
+	return rp.MaxAttempts > 1
+}
+
+// Backoff returns the wait before retrying a Job that failed
+// in the provided attempt.
+func (rp RetryPolicy) Backoff(attempt int) time.Duration {
+	backoff := time.Duration(rp.InitialBackoff) * time.Second
+	maxBackoff := time.Duration(rp.MaxBackoff) * time.Second
+	for i := 1; i < attempt && backoff < maxBackoff; i++ {
+		if backoff > maxBackoff/2 {
+			return maxBackoff
+		}
+		backoff *= 2
+	}
+	if backoff > maxBackoff {
+		backoff = maxBackoff
+	}
+	return backoff
+}
+
+// Validate validates a RetryPolicy.
+func (rp RetryPolicy) Validate() error {
+	if rp.MaxAttempts < 0 {
+		return fmt.Errorf("max attempts can't be negative, got %d", rp.MaxAttempts)
+	}
+	if !rp.Enabled() {
+		return nil
+	}
+	if rp.InitialBackoff <= 0 {
+		return fmt.Errorf("initial backoff should be greater than 0 seconds, got %d", rp.InitialBackoff)
+	}
+	if rp.MaxBackoff < rp.InitialBackoff {
+		return fmt.Errorf("max backoff should be greater or equal than initial backoff, got %d", rp.MaxBackoff)
+	}
+	if time.Duration(rp.MaxBackoff) > maxRetryBackoff/time.Second {
+		return fmt.Errorf("max backoff can't be greater than %d seconds, got %d", maxRetryBackoff/time.Second, rp.MaxBackoff)
 	}
 	return nil
 }
@@ -287,12 +510,23 @@ type HotConfig struct {
 	// UnfreezeMaxPrice indicates the maximum amount of attoFil to pay for
 	// retrieval of data to unfreeze.
 	UnfreezeMaxPrice uint64
+	// UnfreezeCacheTTL indicates for how many seconds data unfrozen from
+	// cold storage while hot storage is disabled, e.g: to renew deals, is
+	// kept cached in hot storage. Cached data can be read without a new
+	// retrieval, and is unpinned by GC after the TTL. If zero, unfrozen
+	// data is unpinned as soon as it isn't needed. The TTL of the default
+	// storage config also applies to retrievals, and to the aggregate of
+	// data unfrozen with hot storage enabled.
+	UnfreezeCacheTTL int
 	// Ipfs contains configuration related to storing Cid data in a IPFS node.
 	Ipfs IpfsConfig
 }
 
 // Validate validates a HotConfig.
 func (hc HotConfig) Validate() error {
+	if hc.UnfreezeCacheTTL < 0 {
+		return fmt.Errorf("unfreeze cache ttl should be greater or equal to zero, got %d", hc.UnfreezeCacheTTL)
+	}
 	if !hc.Enabled {
 		return nil
 	}
@@ -375,6 +609,45 @@ type FilConfig struct {
 	DealStartOffset int64
 	// VerifiedDeal indicates if new deals should be marked as verified.
 	VerifiedDeal bool
+	// MinCountries indicates the minimum number of distinct countries
+	// where miners storing the data should be located. Zero disables
+	// this constraint.
+	MinCountries int
+	// MinRelativePower indicates the minimum relative power in the network
+	// of miners selected for new deals. Zero disables this filter.
+	MinRelativePower float64
+	// MaxFaults indicates the maximum number of faults in the last week
+	// of miners selected for new deals. Zero allows no faults, and nil
+	// disables this filter.
+	MaxFaults *int
+	// MaxFaultyRatio indicates the maximum ratio of faulty sectors over
+	// live sectors of miners selected for new deals. Zero disables this
+	// filter.
+	MaxFaultyRatio float64
+	// SectorSize indicates the sector size of miners selected for new
+	// deals. Zero allows any sector size.
+	SectorSize uint64
+	// PriceEscalation indicates how miner selection is retried with higher
+	// max prices if not enough deals are made to ensure RepFactor.
+	PriceEscalation FilPriceEscalation
+	// Aggregate indicates that, if the data is small, it can be stored
+	// in deals shared with other small data. The data is aggregated
+	// in a single DAG, and deals are made for the aggregate. Deals are
+	// paid by a single wallet, so data is only aggregated with data stored
+	// with the same FilConfig, including Addr. Users with different
+	// wallet addresses don't share aggregates.
+	Aggregate bool
+	// Offline indicates that deals are offline deals for a known piece,
+	// where data is transferred manually to miners.
+	Offline FilOffline
+	// DataCapPolicy indicates what to do if VerifiedDeal is enabled, but
+	// the remaining DataCap of Addr doesn't cover all new deals.
+	DataCapPolicy DataCapPolicy
+	// MaxCost is the maximum amount of attoFIL that new deals can cost
+	// for their whole duration. It isn't part of the user configuration,
+	// but it's set from the user budgets when making new deals. A nil
+	// value means no limit.
+	MaxCost *big.Int `json:"-"`
 }
 
 // Validate returns a non-nil error if the configuration is invalid.
@@ -385,9 +658,39 @@ func (fc *FilConfig) Validate() error {
 	if fc.DealMinDuration < util.MinDealDuration {
 		return fmt.Errorf("deal duration should be greater than minimum, got %d", fc.DealMinDuration)
 	}
+	if fc.MinCountries < 0 || fc.MinCountries > fc.RepFactor {
+		return fmt.Errorf("min countries should be between zero and the replication factor, got %d", fc.MinCountries)
+	}
+	if fc.MinRelativePower < 0 || fc.MinRelativePower > 1 {
+		return fmt.Errorf("min relative power should be between zero and one, got %f", fc.MinRelativePower)
+	}
+	if fc.MaxFaults != nil && *fc.MaxFaults < 0 {
+		return fmt.Errorf("max faults can't be negative, got %d", *fc.MaxFaults)
+	}
+	if fc.MaxFaultyRatio < 0 || fc.MaxFaultyRatio > 1 {
+		return fmt.Errorf("max faulty ratio should be between zero and one, got %f", fc.MaxFaultyRatio)
+	}
+	if fc.SectorSize&(fc.SectorSize-1) != 0 {
+		return fmt.Errorf("sector size should be a power of two, got %d", fc.SectorSize)
+	}
 	if err := fc.Renew.Validate(); err != nil {
 		return fmt.Errorf("invalid renew config: %s", err)
 	}
+	if err := fc.PriceEscalation.Validate(); err != nil {
+		return fmt.Errorf("invalid price escalation config: %s", err)
+	}
+	if fc.Aggregate && fc.Renew.Enabled {
+		return fmt.Errorf("renewals should be disabled if aggregation is enabled")
+	}
+	if err := fc.Offline.Validate(); err != nil {
+		return fmt.Errorf("invalid offline config: %s", err)
+	}
+	if fc.DataCapPolicy < DataCapPolicyFail || fc.DataCapPolicy > DataCapPolicyPartial {
+		return fmt.Errorf("data-cap policy should be fail, unverified or partial, got %d", fc.DataCapPolicy)
+	}
+	if fc.Offline.Enabled() && (fc.Aggregate || fc.Renew.Enabled) {
+		return fmt.Errorf("aggregation and renewals should be disabled for offline deals")
+	}
 	return nil
 }
 
@@ -398,6 +701,10 @@ type FilRenew struct {
 	// Threshold indicates how many epochs before expiring should trigger
 	// deal renewal. e.g: 100 epoch before expiring.
 	Threshold int
+	// Unfreeze indicates that if hot storage is disabled, the data should be
+	// temporarily retrieved from Filecoin to make renewal deals, paying at
+	// most Hot.UnfreezeMaxPrice, and unpinned again afterwards.
+	Unfreeze bool
 }
 
 // Validate returns a non-nil error if the configuration is invalid.
@@ -408,6 +715,126 @@ func (fr *FilRenew) Validate() error {
 	return nil
 }
 
+// MaxPriceEscalationSteps is the maximum number of steps of a
+// FilPriceEscalation.
+const MaxPriceEscalationSteps = 10
+
+// FilPriceEscalation contains configuration to retry miner selection with
+// progressively higher max prices when not enough deals are made.
+type FilPriceEscalation struct {
+	// StartPrice is the max price in attoFIL per GiB per epoch of the
+	// first step. It's used instead of FilConfig.MaxPrice.
+	StartPrice uint64
+	// Step is the increment of the max price between steps. Zero
+	// disables price escalation.
+	Step uint64
+	// MaxPrice is the ceiling of the max price in attoFIL per GiB
+	// per epoch of the last step.
+	MaxPrice uint64
+}
+
+// Enabled returns true if price escalation is enabled.
+func (pe *FilPriceEscalation) Enabled() bool {
+	return pe.Step > 0
+}
+
+// Prices returns the max price of every step, from StartPrice up to
+// MaxPrice, which is always the last one. At most MaxPriceEscalationSteps
+// prices are returned.
+func (pe *FilPriceEscalation) Prices() []uint64 {
+	if !pe.Enabled() {
+		return nil
+	}
+	var res []uint64
+	// Steps are compared with the remaining distance to MaxPrice, so
+	// adding them never wraps around.
+	for p := pe.StartPrice; p < pe.MaxPrice && len(res) < MaxPriceEscalationSteps-1; p += pe.Step {
+		res = append(res, p)
+		if pe.MaxPrice-p <= pe.Step {
+			break
+		}
+	}
+	return append(res, pe.MaxPrice)
+}
+
+// steps returns the number of steps from StartPrice up to MaxPrice.
+func (pe *FilPriceEscalation) steps() uint64 {
+	diff := pe.MaxPrice - pe.StartPrice
+	steps := diff/pe.Step + 1
+	if diff%pe.Step != 0 {
+		steps++
+	}
+	return steps
+}
+
+// Validate returns a non-nil error if the configuration is invalid.
+func (pe *FilPriceEscalation) Validate() error {
+	if !pe.Enabled() {
+		return nil
+	}
+	if pe.StartPrice == 0 {
+		return fmt.Errorf("start price should be positive")
+	}
+	if pe.MaxPrice < pe.StartPrice {
+		return fmt.Errorf("max price should be greater or equal than start price, got %d", pe.MaxPrice)
+	}
+	if steps := pe.steps(); steps > MaxPriceEscalationSteps {
+		return fmt.Errorf("price escalation should have at most %d steps, got %d", MaxPriceEscalationSteps, steps)
+	}
+	return nil
+}
+
+// DataCapPolicy indicates what to do when the remaining DataCap of a
+// verified client doesn't cover all new verified deals.
+type DataCapPolicy int
+
+const (
+	// DataCapPolicyFail fails making new deals.
+	DataCapPolicyFail DataCapPolicy = iota
+	// DataCapPolicyUnverified makes all new deals unverified.
+	DataCapPolicyUnverified
+	// DataCapPolicyPartial makes as many verified deals as the
+	// DataCap covers, and the rest unverified.
+	DataCapPolicyPartial
+)
+
+// DataCapPolicyStr maps DataCapPolicy to describing string.
+var DataCapPolicyStr = map[DataCapPolicy]string{
+	DataCapPolicyFail:       "Fail",
+	DataCapPolicyUnverified: "Unverified",
+	DataCapPolicyPartial:    "Partial",
+}
+
+// FilOffline contains configuration to make offline deals, where the
+// piece is calculated beforehand (e.g: with `pow offline commp`) and
+// its data is transferred manually to miners.
+type FilOffline struct {
+	// PieceCid is the piece Cid of the data. An empty value
+	// disables offline deals.
+	PieceCid string
+	// PieceSize is the padded piece size of the data.
+	PieceSize uint64
+}
+
+// Enabled returns true if deals should be offline deals.
+func (fo *FilOffline) Enabled() bool {
+	return fo.PieceCid != ""
+}
+
+// Validate returns a non-nil error if the configuration is invalid.
+func (fo *FilOffline) Validate() error {
+	if !fo.Enabled() {
+		return nil
+	}
+	if _, err := cid.Decode(fo.PieceCid); err != nil {
+		return fmt.Errorf("piece cid should be a valid cid: %s", err)
+	}
+	if fo.PieceSize == 0 || fo.PieceSize&(fo.PieceSize-1) != 0 {
+		return fmt.Errorf("piece size should be a power of two, got %d", fo.PieceSize)
+	}
+	return nil
+}
+
 // RetrievalInfo has data about an executed Filecoin retrieval.
 type RetrievalInfo struct {
 	ID        RetrievalID
@@ -450,6 +877,9 @@ type HotInfo struct {
 // of a Cid in an IPFS node.
 type IpfsHotInfo struct {
 	Created time.Time
+	// CacheExpiration is when unfrozen data cached in hot storage
+	// can be unpinned. It's zero if the data isn't cached.
+	CacheExpiration time.Time
 }
 
 // ColdInfo contains information about the current storage state
@@ -469,8 +899,32 @@ type FilInfo struct {
 	// greater than real data size since data is padded in
 	// Filecoin.
 	Size uint64
+	// AggregatePath is the path of the data in the DataCid DAG
+	// if it was stored aggregated with other data. It's empty
+	// if the data wasn't aggregated.
+	AggregatePath string
 	// Proposals contains known deals for the data.
 	Proposals []FilStorage
+	// RetrievalCost is the total amount of attoFil paid for retrievals
+	// which unfroze the data to renew deals.
+	RetrievalCost uint64
+}
+
+// Aggregated returns true if the data was stored aggregated with
+// other data.
+func (fi FilInfo) Aggregated() bool {
+	return fi.AggregatePath != ""
+}
+
+// ActiveDeals returns the number of deals which aren't marked as faulted.
+func (fi FilInfo) ActiveDeals() int {
+	var res int
+	for _, p := range fi.Proposals {
+		if !p.Faulted {
+			res++
+		}
+	}
+	return res
 }
 
 // FilStorage contains Deal information of a storage in Filecoin.
@@ -485,6 +939,14 @@ type FilStorage struct {
 	// already renewed, so it can expiry
 	// safely if renewals are enabled.
 	Renewed bool
+	// Replaced indicates if this deal was renewed
+	// with a different miner, since its miner
+	// couldn't renew it.
+	Replaced bool
+	// Faulted indicates that the miner has recent
+	// faults after the deal started, so the deal
+	// isn't considered active until it recovers.
+	Faulted bool
 	// Duration is the duration of the deal.
 	Duration int64
 	// StartEpoch is the starting epoch in which
@@ -520,6 +982,7 @@ type JobLogger interface {
 	Log(context.Context, string, ...interface{})
 	Watch(context.Context, chan<- LogEntry) error
 	GetByCid(context.Context, APIID, cid.Cid) ([]LogEntry, error)
+	RemoveByJobs(context.Context, APIID, cid.Cid, []JobID) (int, error)
 }
 
 // LogEntry is a log entry from a Cid execution.
@@ -531,6 +994,46 @@ type LogEntry struct {
 	Msg       string
 }
 
+// EventType is the type of an Event.
+type EventType string
+
+const (
+	// EventJobStatus indicates a StorageJob changed its status.
+	EventJobStatus EventType = "job.status"
+	// EventDealActive indicates a deal of a StorageJob is active.
+	EventDealActive EventType = "deal.active"
+	// EventDealFailed indicates a deal of a StorageJob failed.
+	EventDealFailed EventType = "deal.failed"
+	// EventRenewalCreated indicates renewal deals were created for a Cid.
+	EventRenewalCreated EventType = "renewal.created"
+)
+
+// EventTypes are all existing EventTypes.
+var EventTypes = []EventType{EventJobStatus, EventDealActive, EventDealFailed, EventRenewalCreated}
+
+// Event describes a change in a StorageJob or its deals.
+type Event struct {
+	Type      EventType
+	APIID     APIID
+	Cid       cid.Cid
+	JobID     JobID
+	Timestamp time.Time
+	// JobStatus is the new status of the StorageJob,
+	// for EventJobStatus.
+	JobStatus JobStatus
+	// ProposalCid is the deal proposal Cid, for deal events.
+	ProposalCid cid.Cid
+	// DealID is the deal id in the network, for EventDealActive.
+	DealID uint64
+	// Miner is the miner of the deal, for deal events.
+	Miner string
+	// Message describes the event, e.g: the cause of
+	// a failed deal or a failed StorageJob.
+	Message string
+	// NumDeals is the number of created deals, for EventRenewalCreated.
+	NumDeals int
+}
+
 // PaychDir specifies the direction of a payment channel.
 type PaychDir int
 
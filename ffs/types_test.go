@@ -0,0 +1,149 @@
+package ffs
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/textileio/powergate/v2/util"
+)
+
+func TestRetryPolicyBackoff(t *testing.T) {
+	t.Parallel()
+	rp := RetryPolicy{MaxAttempts: 10, InitialBackoff: 10, MaxBackoff: 60}
+	require.Equal(t, 10*time.Second, rp.Backoff(1))
+	require.Equal(t, 20*time.Second, rp.Backoff(2))
+	require.Equal(t, 40*time.Second, rp.Backoff(3))
+	require.Equal(t, 60*time.Second, rp.Backoff(4))
+	require.Equal(t, 60*time.Second, rp.Backoff(100))
+
+	// Doubling doesn't overflow for big backoffs.
+	rp = RetryPolicy{MaxAttempts: 100, InitialBackoff: 1, MaxBackoff: int(maxRetryBackoff / time.Second)}
+	require.Equal(t, maxRetryBackoff, rp.Backoff(100))
+}
+
+func TestRetryPolicyValidate(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name string
+		rp   RetryPolicy
+		err  bool
+	}{
+		{name: "disabled", rp: RetryPolicy{}},
+		{name: "single attempt", rp: RetryPolicy{MaxAttempts: 1}},
+		{name: "valid", rp: RetryPolicy{MaxAttempts: 3, InitialBackoff: 10, MaxBackoff: 60}},
+		{name: "negative attempts", rp: RetryPolicy{MaxAttempts: -1}, err: true},
+		{name: "zero initial backoff", rp: RetryPolicy{MaxAttempts: 3, MaxBackoff: 60}, err: true},
+		{name: "max below initial", rp: RetryPolicy{MaxAttempts: 3, InitialBackoff: 60, MaxBackoff: 10}, err: true},
+		{name: "max too big", rp: RetryPolicy{MaxAttempts: 3, InitialBackoff: 1, MaxBackoff: int(maxRetryBackoff/time.Second) + 1}, err: true},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			err := tt.rp.Validate()
+			if tt.err {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestFilPriceEscalationPrices(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name   string
+		pe     FilPriceEscalation
+		prices []uint64
+	}{
+		{name: "disabled", pe: FilPriceEscalation{StartPrice: 10, MaxPrice: 30}},
+		{name: "exact steps", pe: FilPriceEscalation{StartPrice: 10, Step: 10, MaxPrice: 30}, prices: []uint64{10, 20, 30}},
+		{name: "max price ceiling", pe: FilPriceEscalation{StartPrice: 10, Step: 10, MaxPrice: 35}, prices: []uint64{10, 20, 30, 35}},
+		{name: "single step", pe: FilPriceEscalation{StartPrice: 10, Step: 10, MaxPrice: 10}, prices: []uint64{10}},
+		{name: "step bigger than range", pe: FilPriceEscalation{StartPrice: 10, Step: 100, MaxPrice: 30}, prices: []uint64{10, 30}},
+		// Adding the step to the last price would wrap around to 0.
+		{name: "no overflow", pe: FilPriceEscalation{StartPrice: 1, Step: 1 << 63, MaxPrice: math.MaxUint64}, prices: []uint64{1, 1<<63 + 1, math.MaxUint64}},
+		{name: "too many steps", pe: FilPriceEscalation{StartPrice: 1, Step: 1, MaxPrice: math.MaxUint64}, prices: []uint64{1, 2, 3, 4, 5, 6, 7, 8, 9, math.MaxUint64}},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			require.Equal(t, tt.prices, tt.pe.Prices())
+		})
+	}
+}
+
+func TestFilPriceEscalationValidate(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name string
+		pe   FilPriceEscalation
+		err  bool
+	}{
+		{name: "disabled", pe: FilPriceEscalation{}},
+		{name: "valid", pe: FilPriceEscalation{StartPrice: 10, Step: 10, MaxPrice: 35}},
+		{name: "max steps", pe: FilPriceEscalation{StartPrice: 10, Step: 10, MaxPrice: 100}},
+		{name: "zero start price", pe: FilPriceEscalation{Step: 10, MaxPrice: 30}, err: true},
+		{name: "max below start", pe: FilPriceEscalation{StartPrice: 30, Step: 10, MaxPrice: 10}, err: true},
+		{name: "too many steps", pe: FilPriceEscalation{StartPrice: 10, Step: 10, MaxPrice: 101}, err: true},
+		// The step count doesn't overflow for big steps.
+		{name: "big step", pe: FilPriceEscalation{StartPrice: 1, Step: math.MaxUint64, MaxPrice: math.MaxUint64}},
+		{name: "big range", pe: FilPriceEscalation{StartPrice: 1, Step: 1, MaxPrice: math.MaxUint64}, err: true},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			err := tt.pe.Validate()
+			if tt.err {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestFilOfflineValidate(t *testing.T) {
+	t.Parallel()
+	pieceCid := "baga6ea4seaqao7s73y24kcutaosvacpdjgfe5pw76ooefnyqw4ynr3d2y6x2mpq"
+	tests := []struct {
+		name string
+		fo   FilOffline
+		err  bool
+	}{
+		{name: "disabled", fo: FilOffline{}},
+		{name: "valid", fo: FilOffline{PieceCid: pieceCid, PieceSize: 1 << 20}},
+		{name: "invalid piece cid", fo: FilOffline{PieceCid: "invalid", PieceSize: 1 << 20}, err: true},
+		{name: "zero piece size", fo: FilOffline{PieceCid: pieceCid}, err: true},
+		{name: "piece size not power of two", fo: FilOffline{PieceCid: pieceCid, PieceSize: 1000}, err: true},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			err := tt.fo.Validate()
+			if tt.err {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+
+	// Offline deals require hot storage to be disabled.
+	sc := StorageConfig{
+		Hot: HotConfig{Enabled: true, Ipfs: IpfsConfig{AddTimeout: 10}},
+		Cold: ColdConfig{
+			Enabled:  true,
+			Filecoin: FilConfig{RepFactor: 1, DealMinDuration: util.MinDealDuration, Addr: "t3addr"},
+		},
+	}.WithColdFilOffline(pieceCid, 1<<20)
+	require.Error(t, sc.Validate())
+	sc.Hot.Enabled = false
+	require.NoError(t, sc.Validate())
+}
@@ -0,0 +1,520 @@
+package uploads
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/ipfs/go-cid"
+	"github.com/ipfs/go-datastore"
+	"github.com/ipfs/go-datastore/query"
+	logging "github.com/ipfs/go-log/v2"
+	"github.com/textileio/powergate/v2/ffs"
+	"github.com/textileio/powergate/v2/util"
+)
+
+/**
+/session/<session-id>: Stores upload Session data by session-id
+*/
+
+var (
+	log = logging.Logger("ffs-uploads")
+
+	// ErrNotFound indicates the upload session doesn't exist.
+	ErrNotFound = errors.New("upload session not found")
+	// ErrQuotaExceeded indicates the user reached the maximum number
+	// of sessions or bytes of unfinalized uploads.
+	ErrQuotaExceeded = errors.New("upload quota exceeded")
+	// ErrSessionTooLarge indicates a chunk would exceed the maximum
+	// size of a session.
+	ErrSessionTooLarge = errors.New("upload session maximum size exceeded")
+
+	// ExpirationTick is the frequency in which sessions are
+	// evaluated to be expired.
+	ExpirationTick = time.Minute * 10
+	// DefaultChunkSize is the chunk size of sessions created
+	// without an explicit chunk size.
+	DefaultChunkSize int64 = 1 << 20
+	// MaxChunkSize is the maximum chunk size of a session, which
+	// should fit in a gRPC message.
+	MaxChunkSize int64 = 3 << 20
+	// MaxSessionSize is the maximum number of bytes
+	// uploaded in a session.
+	MaxSessionSize int64 = 32 << 30
+	// MaxUserSessions is the maximum number of unfinalized
+	// sessions of a user.
+	MaxUserSessions = 10
+	// MaxUserBytes is the maximum number of bytes of
+	// unfinalized sessions of a user.
+	MaxUserBytes int64 = 64 << 30
+
+	dsBaseSession = datastore.NewKey("session")
+)
+
+// ValidationError indicates a request was rejected since it isn't
+// valid for the upload Session, e.g: a chunk with a wrong index.
+type ValidationError struct {
+	Message string
+}
+
+func (ve ValidationError) Error() string {
+	return ve.Message
+}
+
+func validationErrorf(format string, a ...interface{}) error {
+	return ValidationError{Message: fmt.Sprintf(format, a...)}
+}
+
+// Session is an upload of data in numbered chunks, which can be resumed
+// after interruptions until it's finalized. Chunks are received in order,
+// and all of them have ChunkSize bytes except the last one.
+type Session struct {
+	ID        string
+	APIID     ffs.APIID
+	ChunkSize int64
+	// Offset is the number of bytes received.
+	Offset int64
+	// NextChunk is the index of the next expected chunk.
+	NextChunk int64
+	CreatedAt int64
+	UpdatedAt int64
+}
+
+// Stager stages data in hot storage.
+type Stager interface {
+	// Stage adds the data of an io.Reader in hot storage and stage-pins it.
+	Stage(context.Context, ffs.APIID, io.Reader) (cid.Cid, error)
+}
+
+// Module persists partial uploads of upload sessions, and stages their
+// data when they're finalized. Sessions without activity for longer than
+// a TTL are considered abandoned, and expired.
+type Module struct {
+	ds  datastore.TxnDatastore
+	dir string
+	ttl time.Duration
+	st  Stager
+
+	lock       sync.Mutex
+	finalizing map[string]struct{}
+	sessions   *util.KeyedMutex
+	usage      map[ffs.APIID]usage
+
+	ctx      context.Context
+	cancel   context.CancelFunc
+	finished chan struct{}
+}
+
+// usage is the number of sessions and bytes of unfinalized
+// uploads of a user.
+type usage struct {
+	sessions int
+	bytes    int64
+}
+
+// New returns a new Module. Partial uploads are persisted in dir, and
+// sessions without activity for longer than ttl are expired.
+func New(ds datastore.TxnDatastore, dir string, ttl time.Duration, st Stager) (*Module, error) {
+	if ttl <= 0 {
+		return nil, fmt.Errorf("session ttl should be greater than zero")
+	}
+	if err := os.MkdirAll(dir, os.ModePerm); err != nil {
+		return nil, fmt.Errorf("creating uploads directory: %s", err)
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	m := &Module{
+		ds:         ds,
+		dir:        dir,
+		ttl:        ttl,
+		st:         st,
+		finalizing: make(map[string]struct{}),
+		sessions:   util.NewKeyedMutex(),
+		usage:      make(map[ffs.APIID]usage),
+		ctx:        ctx,
+		cancel:     cancel,
+		finished:   make(chan struct{}),
+	}
+	if err := m.loadUsage(); err != nil {
+		cancel()
+		return nil, fmt.Errorf("loading users usage: %s", err)
+	}
+	go m.run()
+	return m, nil
+}
+
+// Create creates a new upload Session for iid. If chunkSize is zero,
+// DefaultChunkSize is used.
+func (m *Module) Create(iid ffs.APIID, chunkSize int64) (Session, error) {
+	if chunkSize == 0 {
+		chunkSize = DefaultChunkSize
+	}
+	if chunkSize < 0 || chunkSize > MaxChunkSize {
+		return Session{}, validationErrorf("chunk size should be between 1 and %d bytes", MaxChunkSize)
+	}
+	now := time.Now().Unix()
+	s := Session{
+		ID:        uuid.New().String(),
+		APIID:     iid,
+		ChunkSize: chunkSize,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	u := m.usage[iid]
+	if u.sessions >= MaxUserSessions {
+		return Session{}, ErrQuotaExceeded
+	}
+	if err := m.save(s); err != nil {
+		return Session{}, err
+	}
+	u.sessions++
+	m.usage[iid] = u
+	return s, nil
+}
+
+// Get returns an upload Session of iid.
+func (m *Module) Get(iid ffs.APIID, id string) (Session, error) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	return m.get(iid, id)
+}
+
+// PutChunk persists the chunk with the provided index of an upload Session,
+// verifying that its hex encoded SHA-256 matches checksum. Chunks that were
+// already received are ignored, so retries are safe. It returns the updated
+// Session. Chunks of a Session are written one at a time, without blocking
+// other Sessions.
+func (m *Module) PutChunk(ctx context.Context, iid ffs.APIID, id string, index int64, data []byte, checksum string) (Session, error) {
+	unlock, err := m.sessions.Lock(ctx, id)
+	if err != nil {
+		return Session{}, err
+	}
+	defer unlock()
+
+	s, err := m.reserveChunk(iid, id, index, data, checksum)
+	if err != nil || index < s.NextChunk {
+		return s, err
+	}
+
+	if err := m.writeChunk(s, data); err != nil {
+		m.lock.Lock()
+		m.release(iid, int64(len(data)))
+		m.lock.Unlock()
+		return Session{}, err
+	}
+
+	s.Offset += int64(len(data))
+	s.NextChunk++
+	s.UpdatedAt = time.Now().Unix()
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	if err := m.save(s); err != nil {
+		m.release(iid, int64(len(data)))
+		return Session{}, err
+	}
+	return s, nil
+}
+
+// reserveChunk validates a chunk of a session, and reserves its size from
+// the user quota. Chunks that were already received aren't reserved. It
+// returns the current session.
+func (m *Module) reserveChunk(iid ffs.APIID, id string, index int64, data []byte, checksum string) (Session, error) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	s, err := m.get(iid, id)
+	if err != nil {
+		return Session{}, err
+	}
+	if _, ok := m.finalizing[id]; ok {
+		return Session{}, validationErrorf("upload session is being finalized")
+	}
+	if index < s.NextChunk {
+		return s, nil
+	}
+	if index > s.NextChunk {
+		return Session{}, validationErrorf("chunk %d was expected, got %d", s.NextChunk, index)
+	}
+	if s.Offset != s.NextChunk*s.ChunkSize {
+		return Session{}, validationErrorf("the last chunk was already received")
+	}
+	size := int64(len(data))
+	if size == 0 || size > s.ChunkSize {
+		return Session{}, validationErrorf("chunk size should be between 1 and %d bytes, got %d", s.ChunkSize, len(data))
+	}
+	if s.Offset+size > MaxSessionSize {
+		return Session{}, ErrSessionTooLarge
+	}
+	sum := sha256.Sum256(data)
+	if hex.EncodeToString(sum[:]) != checksum {
+		return Session{}, validationErrorf("chunk checksum doesn't match")
+	}
+	u := m.usage[iid]
+	if u.bytes+size > MaxUserBytes {
+		return Session{}, ErrQuotaExceeded
+	}
+	u.bytes += size
+	m.usage[iid] = u
+	return s, nil
+}
+
+// writeChunk writes a chunk at the end of the received data of a session.
+func (m *Module) writeChunk(s Session, data []byte) error {
+	f, err := os.OpenFile(m.dataPath(s.ID), os.O_WRONLY|os.O_CREATE, 0600)
+	if err != nil {
+		return fmt.Errorf("opening upload data file: %s", err)
+	}
+	defer func() {
+		if err := f.Close(); err != nil {
+			log.Errorf("closing upload data file: %s", err)
+		}
+	}()
+	// Discard any data written by an interrupted previous
+	// attempt that wasn't saved in the session.
+	if err := f.Truncate(s.Offset); err != nil {
+		return fmt.Errorf("truncating upload data file: %s", err)
+	}
+	if _, err := f.WriteAt(data, s.Offset); err != nil {
+		return fmt.Errorf("writing chunk: %s", err)
+	}
+	if err := f.Sync(); err != nil {
+		return fmt.Errorf("syncing upload data file: %s", err)
+	}
+	return nil
+}
+
+// Finalize stages the data of an upload Session in hot storage, and
+// removes the Session. It returns the cid of the staged data.
+func (m *Module) Finalize(ctx context.Context, iid ffs.APIID, id string) (cid.Cid, error) {
+	// Wait for chunks being uploaded, and reject new
+	// ones while the data is staged.
+	unlock, err := m.sessions.Lock(ctx, id)
+	if err != nil {
+		return cid.Undef, err
+	}
+	s, err := m.startFinalize(iid, id)
+	unlock()
+	if err != nil {
+		return cid.Undef, err
+	}
+
+	defer func() {
+		m.lock.Lock()
+		delete(m.finalizing, id)
+		m.lock.Unlock()
+	}()
+
+	f, err := os.Open(m.dataPath(id))
+	if err != nil {
+		return cid.Undef, fmt.Errorf("opening upload data file: %s", err)
+	}
+	defer func() {
+		if err := f.Close(); err != nil {
+			log.Errorf("closing upload data file: %s", err)
+		}
+	}()
+	c, err := m.st.Stage(ctx, iid, f)
+	if err != nil {
+		return cid.Undef, fmt.Errorf("staging uploaded data: %s", err)
+	}
+
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	if err := m.remove(s); err != nil {
+		return cid.Undef, err
+	}
+	return c, nil
+}
+
+// startFinalize marks a session as being finalized, so its chunks
+// aren't accepted and it isn't expired. It returns the session.
+func (m *Module) startFinalize(iid ffs.APIID, id string) (Session, error) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	s, err := m.get(iid, id)
+	if err != nil {
+		return Session{}, err
+	}
+	if _, ok := m.finalizing[id]; ok {
+		return Session{}, validationErrorf("upload session is already being finalized")
+	}
+	if s.Offset == 0 {
+		return Session{}, validationErrorf("upload session didn't receive any data")
+	}
+	m.finalizing[id] = struct{}{}
+	return s, nil
+}
+
+// Close closes the module.
+func (m *Module) Close() error {
+	log.Info("closing...")
+	defer log.Info("closed")
+	m.cancel()
+	<-m.finished
+	return nil
+}
+
+func (m *Module) run() {
+	defer close(m.finished)
+
+	ticker := time.NewTicker(ExpirationTick)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-m.ctx.Done():
+			log.Info("uploads expiration daemon shutting down")
+			return
+		case <-ticker.C:
+			if err := m.expire(time.Now()); err != nil {
+				log.Errorf("expiring upload sessions: %s", err)
+			}
+		}
+	}
+}
+
+// expire removes sessions without activity for longer than the TTL.
+func (m *Module) expire(now time.Time) error {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	res, err := m.ds.Query(query.Query{Prefix: dsBaseSession.String()})
+	if err != nil {
+		return fmt.Errorf("querying sessions: %s", err)
+	}
+	defer func() {
+		if err := res.Close(); err != nil {
+			log.Errorf("closing sessions query result: %s", err)
+		}
+	}()
+	var expired []Session
+	for r := range res.Next() {
+		if r.Error != nil {
+			return fmt.Errorf("iter next: %s", r.Error)
+		}
+		var s Session
+		if err := json.Unmarshal(r.Value, &s); err != nil {
+			return fmt.Errorf("unmarshaling session: %s", err)
+		}
+		// Sessions receiving chunks or being finalized are in use.
+		if _, ok := m.finalizing[s.ID]; ok {
+			continue
+		}
+		if m.sessions.InUse(s.ID) {
+			continue
+		}
+		if now.Sub(time.Unix(s.UpdatedAt, 0)) > m.ttl {
+			expired = append(expired, s)
+		}
+	}
+	for _, s := range expired {
+		log.Infof("expiring abandoned upload session %s", s.ID)
+		if err := m.remove(s); err != nil {
+			log.Errorf("expiring upload session %s: %s", s.ID, err)
+		}
+	}
+	return nil
+}
+
+// get returns a session of iid. This method must be guarded.
+func (m *Module) get(iid ffs.APIID, id string) (Session, error) {
+	buf, err := m.ds.Get(makeSessionKey(id))
+	if err == datastore.ErrNotFound {
+		return Session{}, ErrNotFound
+	}
+	if err != nil {
+		return Session{}, fmt.Errorf("getting session from datastore: %s", err)
+	}
+	var s Session
+	if err := json.Unmarshal(buf, &s); err != nil {
+		return Session{}, fmt.Errorf("unmarshaling session: %s", err)
+	}
+	if s.APIID != iid {
+		return Session{}, ErrNotFound
+	}
+	return s, nil
+}
+
+// save persists a session. This method must be guarded.
+func (m *Module) save(s Session) error {
+	buf, err := json.Marshal(s)
+	if err != nil {
+		return fmt.Errorf("marshaling session: %s", err)
+	}
+	if err := m.ds.Put(makeSessionKey(s.ID), buf); err != nil {
+		return fmt.Errorf("saving session in datastore: %s", err)
+	}
+	return nil
+}
+
+// remove deletes a session and its data, and releases its usage
+// from the user quota. This method must be guarded.
+func (m *Module) remove(s Session) error {
+	if err := os.Remove(m.dataPath(s.ID)); err != nil && !os.IsNotExist(err) {
+		return fmt.Errorf("removing upload data file: %s", err)
+	}
+	if err := m.ds.Delete(makeSessionKey(s.ID)); err != nil {
+		return fmt.Errorf("deleting session from datastore: %s", err)
+	}
+	u := m.usage[s.APIID]
+	u.sessions--
+	m.usage[s.APIID] = u
+	m.release(s.APIID, s.Offset)
+	return nil
+}
+
+// release releases bytes from the user quota. This method must be guarded.
+func (m *Module) release(iid ffs.APIID, bytes int64) {
+	u := m.usage[iid]
+	u.bytes -= bytes
+	if u.sessions <= 0 && u.bytes <= 0 {
+		delete(m.usage, iid)
+		return
+	}
+	m.usage[iid] = u
+}
+
+// loadUsage calculates the usage of users from the saved sessions.
+func (m *Module) loadUsage() error {
+	res, err := m.ds.Query(query.Query{Prefix: dsBaseSession.String()})
+	if err != nil {
+		return fmt.Errorf("querying sessions: %s", err)
+	}
+	defer func() {
+		if err := res.Close(); err != nil {
+			log.Errorf("closing sessions query result: %s", err)
+		}
+	}()
+	for r := range res.Next() {
+		if r.Error != nil {
+			return fmt.Errorf("iter next: %s", r.Error)
+		}
+		var s Session
+		if err := json.Unmarshal(r.Value, &s); err != nil {
+			return fmt.Errorf("unmarshaling session: %s", err)
+		}
+		u := m.usage[s.APIID]
+		u.sessions++
+		u.bytes += s.Offset
+		m.usage[s.APIID] = u
+	}
+	return nil
+}
+
+func (m *Module) dataPath(id string) string {
+	return filepath.Join(m.dir, id)
+}
+
+func makeSessionKey(id string) datastore.Key {
+	return dsBaseSession.ChildString(id)
+}
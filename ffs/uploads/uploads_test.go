@@ -0,0 +1,280 @@
+package uploads
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ipfs/go-cid"
+	"github.com/stretchr/testify/require"
+	"github.com/textileio/powergate/v2/ffs"
+	"github.com/textileio/powergate/v2/tests"
+	"github.com/textileio/powergate/v2/util"
+)
+
+func init() {
+	ExpirationTick = time.Millisecond * 50
+	MaxSessionSize = 30
+	MaxUserSessions = 2
+	MaxUserBytes = 40
+}
+
+type stager struct {
+	lock sync.Mutex
+	data [][]byte
+}
+
+func (s *stager) Stage(ctx context.Context, iid ffs.APIID, r io.Reader) (cid.Cid, error) {
+	data, err := ioutil.ReadAll(r)
+	if err != nil {
+		return cid.Undef, err
+	}
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.data = append(s.data, data)
+	return util.CidFromString("QmY7Yh4UquoXHLPFo2XbhXkhBvFoPwmQUSa92pxnxjQuPU")
+}
+
+func TestUpload(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	st := &stager{}
+	m := create(t, tests.NewTxMapDatastore(), time.Hour, st)
+	iid := ffs.NewAPIID()
+
+	s, err := m.Create(iid, 10)
+	require.NoError(t, err)
+	require.Equal(t, int64(10), s.ChunkSize)
+
+	data := randomBytes(t, 25)
+	chunks := [][]byte{data[:10], data[10:20], data[20:]}
+
+	// Sessions without data can't be finalized.
+	_, err = m.Finalize(ctx, iid, s.ID)
+	require.IsType(t, ValidationError{}, err)
+
+	// Out of order chunks and wrong checksums are rejected.
+	_, err = m.PutChunk(ctx, iid, s.ID, 1, chunks[1], checksum(chunks[1]))
+	require.IsType(t, ValidationError{}, err)
+	_, err = m.PutChunk(ctx, iid, s.ID, 0, chunks[0], checksum(chunks[1]))
+	require.IsType(t, ValidationError{}, err)
+
+	s, err = m.PutChunk(ctx, iid, s.ID, 0, chunks[0], checksum(chunks[0]))
+	require.NoError(t, err)
+	require.Equal(t, int64(10), s.Offset)
+	require.Equal(t, int64(1), s.NextChunk)
+
+	// Retrying a received chunk is a no-op.
+	s, err = m.PutChunk(ctx, iid, s.ID, 0, chunks[0], checksum(chunks[0]))
+	require.NoError(t, err)
+	require.Equal(t, int64(10), s.Offset)
+
+	for i := 1; i < len(chunks); i++ {
+		s, err = m.PutChunk(ctx, iid, s.ID, int64(i), chunks[i], checksum(chunks[i]))
+		require.NoError(t, err)
+	}
+	require.Equal(t, int64(25), s.Offset)
+	require.Equal(t, int64(3), s.NextChunk)
+
+	// No chunks are accepted after the last one.
+	_, err = m.PutChunk(ctx, iid, s.ID, 3, chunks[2], checksum(chunks[2]))
+	require.Error(t, err)
+
+	// Sessions of other users aren't visible.
+	_, err = m.Get(ffs.NewAPIID(), s.ID)
+	require.Equal(t, ErrNotFound, err)
+
+	_, err = m.Finalize(ctx, iid, s.ID)
+	require.NoError(t, err)
+	require.Len(t, st.data, 1)
+	require.Equal(t, data, st.data[0])
+
+	_, err = m.Get(iid, s.ID)
+	require.Equal(t, ErrNotFound, err)
+}
+
+func TestResume(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	ds := tests.NewTxMapDatastore()
+	dir := t.TempDir()
+	st := &stager{}
+	iid := ffs.NewAPIID()
+	data := randomBytes(t, 20)
+
+	m, err := New(ds, dir, time.Hour, st)
+	require.NoError(t, err)
+	s, err := m.Create(iid, 10)
+	require.NoError(t, err)
+	_, err = m.PutChunk(ctx, iid, s.ID, 0, data[:10], checksum(data[:10]))
+	require.NoError(t, err)
+	require.NoError(t, m.Close())
+
+	// Partial uploads survive restarts.
+	m, err = New(ds, dir, time.Hour, st)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		require.NoError(t, m.Close())
+	})
+	s, err = m.Get(iid, s.ID)
+	require.NoError(t, err)
+	require.Equal(t, int64(1), s.NextChunk)
+	_, err = m.PutChunk(ctx, iid, s.ID, 1, data[10:], checksum(data[10:]))
+	require.NoError(t, err)
+	_, err = m.Finalize(ctx, iid, s.ID)
+	require.NoError(t, err)
+	require.Equal(t, data, st.data[0])
+}
+
+func TestExpiration(t *testing.T) {
+	t.Parallel()
+	m := create(t, tests.NewTxMapDatastore(), time.Millisecond*100, &stager{})
+	iid := ffs.NewAPIID()
+
+	s, err := m.Create(iid, 0)
+	require.NoError(t, err)
+	require.Equal(t, DefaultChunkSize, s.ChunkSize)
+
+	require.Eventually(t, func() bool {
+		_, err := m.Get(iid, s.ID)
+		return err == ErrNotFound
+	}, 5*time.Second, 50*time.Millisecond)
+}
+
+func TestExpirationFailure(t *testing.T) {
+	t.Parallel()
+	m := create(t, tests.NewTxMapDatastore(), time.Millisecond*100, &stager{})
+	iid := ffs.NewAPIID()
+
+	s1, err := m.Create(iid, 0)
+	require.NoError(t, err)
+	s2, err := m.Create(iid, 0)
+	require.NoError(t, err)
+	// The data of s1 can't be removed.
+	require.NoError(t, os.MkdirAll(filepath.Join(m.dataPath(s1.ID), "dir"), os.ModePerm))
+
+	// Failing to expire a session doesn't stop expiring others.
+	require.Eventually(t, func() bool {
+		_, err := m.Get(iid, s2.ID)
+		return err == ErrNotFound
+	}, 5*time.Second, 50*time.Millisecond)
+	_, err = m.Get(iid, s1.ID)
+	require.NoError(t, err)
+}
+
+func TestQuota(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	ds := tests.NewTxMapDatastore()
+	m := create(t, ds, time.Hour, &stager{})
+	iid := ffs.NewAPIID()
+	data := randomBytes(t, 10)
+
+	s1, err := m.Create(iid, 10)
+	require.NoError(t, err)
+	s2, err := m.Create(iid, 10)
+	require.NoError(t, err)
+	_, err = m.Create(iid, 10)
+	require.Equal(t, ErrQuotaExceeded, err)
+
+	// Sessions can't exceed the maximum session size.
+	for i := 0; i < 3; i++ {
+		_, err = m.PutChunk(ctx, iid, s1.ID, int64(i), data, checksum(data))
+		require.NoError(t, err)
+	}
+	_, err = m.PutChunk(ctx, iid, s1.ID, 3, data, checksum(data))
+	require.Equal(t, ErrSessionTooLarge, err)
+
+	// Users can't exceed the maximum bytes of all their sessions.
+	_, err = m.PutChunk(ctx, iid, s2.ID, 0, data, checksum(data))
+	require.NoError(t, err)
+	_, err = m.PutChunk(ctx, iid, s2.ID, 1, data, checksum(data))
+	require.Equal(t, ErrQuotaExceeded, err)
+
+	// Other users have their own quota.
+	_, err = m.Create(ffs.NewAPIID(), 10)
+	require.NoError(t, err)
+
+	// Finalized sessions release their usage.
+	_, err = m.Finalize(ctx, iid, s1.ID)
+	require.NoError(t, err)
+	_, err = m.PutChunk(ctx, iid, s2.ID, 1, data, checksum(data))
+	require.NoError(t, err)
+	_, err = m.Create(iid, 10)
+	require.NoError(t, err)
+
+	// The usage is restored after restarts.
+	m = create(t, ds, time.Hour, &stager{})
+	_, err = m.Create(iid, 10)
+	require.Equal(t, ErrQuotaExceeded, err)
+}
+
+func TestConcurrentSessions(t *testing.T) {
+	t.Parallel()
+	st := &stager{}
+	m := create(t, tests.NewTxMapDatastore(), time.Hour, st)
+
+	var wg sync.WaitGroup
+	errs := make(chan error, 4)
+	ctx := context.Background()
+	for i := 0; i < cap(errs); i++ {
+		data := randomBytes(t, 10)
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			iid := ffs.NewAPIID()
+			s, err := m.Create(iid, 10)
+			if err != nil {
+				errs <- err
+				return
+			}
+			for j := int64(0); j < 3; j++ {
+				if _, err := m.PutChunk(ctx, iid, s.ID, j, data, checksum(data)); err != nil {
+					errs <- err
+					return
+				}
+			}
+			_, err = m.Finalize(ctx, iid, s.ID)
+			errs <- err
+		}()
+	}
+	wg.Wait()
+	close(errs)
+	for err := range errs {
+		require.NoError(t, err)
+	}
+	require.Len(t, st.data, 4)
+	for _, data := range st.data {
+		require.Len(t, data, 30)
+	}
+	require.Empty(t, m.usage)
+}
+
+func create(t *testing.T, ds *tests.TxMapDatastore, ttl time.Duration, st Stager) *Module {
+	m, err := New(ds, t.TempDir(), ttl, st)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		require.NoError(t, m.Close())
+	})
+	return m
+}
+
+func randomBytes(t *testing.T, size int) []byte {
+	data := make([]byte, size)
+	_, err := rand.Read(data)
+	require.NoError(t, err)
+	return data
+}
+
+func checksum(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
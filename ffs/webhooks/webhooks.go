@@ -0,0 +1,766 @@
+package webhooks
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"sort"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/ipfs/go-datastore"
+	"github.com/ipfs/go-datastore/query"
+	logging "github.com/ipfs/go-log/v2"
+	"github.com/textileio/powergate/v2/ffs"
+)
+
+/**
+/webhook/<api-id>/<webhook-id>: Stores Webhook data by api-id and webhook-id
+/delivery/<delivery-id>: Stores pending deliveries by delivery-id
+*/
+
+var (
+	log = logging.Logger("ffs-webhooks")
+
+	// ErrNotFound indicates the webhook doesn't exist.
+	ErrNotFound = errors.New("webhook not found")
+
+	// DeliveryTick is the frequency in which pending deliveries
+	// are evaluated to be sent.
+	DeliveryTick = time.Second * 5
+	// DeliveryTimeout is the timeout of a delivery HTTP request.
+	DeliveryTimeout = time.Second * 10
+	// InitialBackoff is the delay before retrying the first failed
+	// attempt of a delivery. It doubles for every further failed attempt.
+	InitialBackoff = time.Second * 10
+	// MaxBackoff is the maximum delay between delivery attempts.
+	MaxBackoff = time.Hour
+	// MaxAttempts is the maximum number of attempts of a delivery
+	// before it's discarded.
+	MaxAttempts = 10
+	// MaxParallelDeliveries is the maximum number of deliveries
+	// being sent at the same time.
+	MaxParallelDeliveries = 10
+	// MaxQueuedDeliveries is the maximum number of deliveries queued for
+	// a Webhook. If more Events are notified, e.g: while the Webhook
+	// endpoint is down, the oldest deliveries are dropped.
+	MaxQueuedDeliveries = 1000
+
+	// HeaderEvent is the HTTP header with the delivered EventType.
+	HeaderEvent = "X-Powergate-Event"
+	// HeaderDelivery is the HTTP header with the delivery id, which
+	// is the same for all the attempts of a delivery.
+	HeaderDelivery = "X-Powergate-Delivery"
+	// HeaderSignature is the HTTP header with the hex encoded HMAC-SHA256
+	// of the request body using the Webhook secret, prefixed with "sha256=".
+	HeaderSignature = "X-Powergate-Signature"
+
+	// privateNets are the private network address ranges, which
+	// aren't allowed as delivery targets by default.
+	privateNets = parseCIDRs("10.0.0.0/8", "172.16.0.0/12", "192.168.0.0/16", "fc00::/7")
+
+	dsBaseWebhook  = datastore.NewKey("webhook")
+	dsBaseDelivery = datastore.NewKey("delivery")
+)
+
+// Webhook is a subscription of an APIID to Events.
+type Webhook struct {
+	ID    string
+	APIID ffs.APIID
+	// URL is the endpoint where Events are POSTed.
+	URL string
+	// Events are the subscribed EventTypes. An empty list
+	// means all EventTypes.
+	Events []ffs.EventType
+	// Secret is used to sign deliveries. It's only returned
+	// when the Webhook is registered.
+	Secret    string
+	CreatedAt int64
+}
+
+// Payload is the JSON body of a delivery.
+type Payload struct {
+	ID          string `json:"id"`
+	Type        string `json:"type"`
+	UserID      string `json:"user_id"`
+	Timestamp   int64  `json:"timestamp"`
+	Cid         string `json:"cid,omitempty"`
+	JobID       string `json:"job_id,omitempty"`
+	JobStatus   string `json:"job_status,omitempty"`
+	ProposalCid string `json:"proposal_cid,omitempty"`
+	DealID      uint64 `json:"deal_id,omitempty"`
+	Miner       string `json:"miner,omitempty"`
+	Message     string `json:"message,omitempty"`
+	NumDeals    int    `json:"num_deals,omitempty"`
+}
+
+type delivery struct {
+	ID          string
+	WebhookID   string
+	APIID       ffs.APIID
+	EventType   ffs.EventType
+	Payload     []byte
+	Attempts    int
+	NextAttempt int64
+	CreatedAt   int64
+}
+
+// Module delivers Events to registered Webhooks. Pending deliveries
+// are persisted, and retried with exponential backoff.
+type Module struct {
+	ds           datastore.TxnDatastore
+	client       *http.Client
+	allowPrivate bool
+
+	lock       sync.Mutex
+	webhooks   map[ffs.APIID]map[string]Webhook
+	deliveries map[string]delivery
+	inflight   map[string]struct{}
+	// pending are created deliveries which aren't persisted yet.
+	pending []delivery
+	// queued counts the pending and persisted deliveries of each Webhook.
+	queued map[string]int
+	// dropped are persisted deliveries dropped by Notify, which
+	// are deleted from the datastore by the delivery daemon.
+	dropped []string
+
+	rateLim  chan struct{}
+	wakeup   chan struct{}
+	ctx      context.Context
+	cancel   context.CancelFunc
+	finished chan struct{}
+}
+
+var _ ffs.EventNotifier = (*Module)(nil)
+
+// New returns a new Module. Unless allowPrivate is true, Webhooks can't
+// target loopback, private or link-local addresses, which are checked
+// both when registering the Webhook and when connecting to it.
+func New(ds datastore.TxnDatastore, allowPrivate bool) (*Module, error) {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	if !allowPrivate {
+		dialer := &net.Dialer{
+			Timeout:   30 * time.Second,
+			KeepAlive: 30 * time.Second,
+			Control:   controlTarget,
+		}
+		transport.DialContext = dialer.DialContext
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	m := &Module{
+		ds:           ds,
+		client:       &http.Client{Timeout: DeliveryTimeout, Transport: transport},
+		allowPrivate: allowPrivate,
+		webhooks:     make(map[ffs.APIID]map[string]Webhook),
+		deliveries:   make(map[string]delivery),
+		inflight:     make(map[string]struct{}),
+		queued:       make(map[string]int),
+		rateLim:      make(chan struct{}, MaxParallelDeliveries),
+		wakeup:       make(chan struct{}, 1),
+		ctx:          ctx,
+		cancel:       cancel,
+		finished:     make(chan struct{}),
+	}
+	if err := m.loadCaches(); err != nil {
+		cancel()
+		return nil, fmt.Errorf("loading caches: %s", err)
+	}
+	go m.run()
+	return m, nil
+}
+
+// Register registers a new Webhook for iid, which receives the provided
+// EventTypes. An empty list of EventTypes subscribes to all of them.
+// The returned Webhook contains the secret used to sign deliveries.
+func (m *Module) Register(iid ffs.APIID, endpoint string, events []ffs.EventType) (Webhook, error) {
+	u, err := url.Parse(endpoint)
+	if err != nil {
+		return Webhook{}, fmt.Errorf("parsing url: %s", err)
+	}
+	if (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return Webhook{}, fmt.Errorf("url must be an absolute http or https url")
+	}
+	if !m.allowPrivate {
+		if err := checkHost(u.Hostname()); err != nil {
+			return Webhook{}, fmt.Errorf("checking url host: %s", err)
+		}
+	}
+	for _, e := range events {
+		if !isEventType(e) {
+			return Webhook{}, fmt.Errorf("unknown event type %s", e)
+		}
+	}
+	secret, err := newSecret()
+	if err != nil {
+		return Webhook{}, fmt.Errorf("generating secret: %s", err)
+	}
+	w := Webhook{
+		ID:        uuid.New().String(),
+		APIID:     iid,
+		URL:       endpoint,
+		Events:    events,
+		Secret:    secret,
+		CreatedAt: time.Now().Unix(),
+	}
+	buf, err := json.Marshal(w)
+	if err != nil {
+		return Webhook{}, fmt.Errorf("marshaling webhook: %s", err)
+	}
+
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	if err := m.ds.Put(makeWebhookKey(iid, w.ID), buf); err != nil {
+		return Webhook{}, fmt.Errorf("saving webhook in datastore: %s", err)
+	}
+	if _, ok := m.webhooks[iid]; !ok {
+		m.webhooks[iid] = make(map[string]Webhook)
+	}
+	m.webhooks[iid][w.ID] = w
+	return w, nil
+}
+
+// List returns the registered Webhooks of iid, without their secrets.
+func (m *Module) List(iid ffs.APIID) []Webhook {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	res := make([]Webhook, 0, len(m.webhooks[iid]))
+	for _, w := range m.webhooks[iid] {
+		w.Secret = ""
+		res = append(res, w)
+	}
+	sort.Slice(res, func(i, j int) bool {
+		return res[i].CreatedAt < res[j].CreatedAt
+	})
+	return res
+}
+
+// Remove removes a registered Webhook of iid, and discards its pending
+// deliveries. If the Webhook doesn't exist it returns ErrNotFound.
+func (m *Module) Remove(iid ffs.APIID, id string) error {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	if _, ok := m.webhooks[iid][id]; !ok {
+		return ErrNotFound
+	}
+	txn, err := m.ds.NewTransaction(false)
+	if err != nil {
+		return fmt.Errorf("starting transaction: %s", err)
+	}
+	defer txn.Discard()
+	if err := txn.Delete(makeWebhookKey(iid, id)); err != nil {
+		return fmt.Errorf("deleting webhook from datastore: %s", err)
+	}
+	var discarded []string
+	for _, d := range m.deliveries {
+		if d.WebhookID != id {
+			continue
+		}
+		if err := txn.Delete(makeDeliveryKey(d.ID)); err != nil {
+			return fmt.Errorf("deleting delivery from datastore: %s", err)
+		}
+		discarded = append(discarded, d.ID)
+	}
+	if err := txn.Commit(); err != nil {
+		return fmt.Errorf("committing transaction: %s", err)
+	}
+	delete(m.webhooks[iid], id)
+	delete(m.queued, id)
+	for _, id := range discarded {
+		delete(m.deliveries, id)
+	}
+	return nil
+}
+
+// Notify creates a delivery of the Event for every Webhook of the
+// Event APIID subscribed to its EventType. Notify is called while other
+// modules hold their locks, so deliveries are only queued in memory, and
+// persisted by the delivery daemon. If a Webhook has more than
+// MaxQueuedDeliveries queued, its oldest deliveries are dropped.
+func (m *Module) Notify(e ffs.Event) {
+	m.lock.Lock()
+	var created bool
+	for _, w := range m.webhooks[e.APIID] {
+		if !w.subscribed(e.Type) {
+			continue
+		}
+		d, err := newDelivery(w, e)
+		if err != nil {
+			log.Errorf("creating delivery for webhook %s: %s", w.ID, err)
+			continue
+		}
+		m.pending = append(m.pending, d)
+		m.queued[w.ID]++
+		if m.queued[w.ID] > MaxQueuedDeliveries {
+			m.dropOldest(w.ID)
+		}
+		created = true
+	}
+	m.lock.Unlock()
+
+	if !created {
+		return
+	}
+	// If the Module is closed, there's no delivery daemon
+	// to persist them.
+	if m.ctx.Err() != nil {
+		m.persistPending()
+		return
+	}
+	select {
+	case m.wakeup <- struct{}{}:
+	default:
+	}
+}
+
+// Close closes the Module. Pending deliveries are sent
+// when the Module is created again.
+func (m *Module) Close() error {
+	log.Info("closing...")
+	defer log.Info("closed")
+	m.cancel()
+	<-m.finished
+	return nil
+}
+
+func (m *Module) run() {
+	defer close(m.finished)
+	var wg sync.WaitGroup
+	defer wg.Wait()
+
+	ticker := time.NewTicker(DeliveryTick)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-m.ctx.Done():
+			log.Info("webhooks delivery daemon shutting down")
+			m.persistPending()
+			return
+		case <-ticker.C:
+		case <-m.wakeup:
+		}
+		m.persistPending()
+		for _, d := range m.dueDeliveries(time.Now()) {
+			select {
+			case <-m.ctx.Done():
+				return
+			case m.rateLim <- struct{}{}:
+			}
+			wg.Add(1)
+			go func(d delivery) {
+				defer wg.Done()
+				defer func() { <-m.rateLim }()
+				m.deliver(d)
+			}(d)
+		}
+	}
+}
+
+// dueDeliveries returns the pending deliveries which should be
+// attempted at now, and marks them as in-flight.
+func (m *Module) dueDeliveries(now time.Time) []delivery {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	var res []delivery
+	for _, d := range m.deliveries {
+		if _, ok := m.inflight[d.ID]; ok {
+			continue
+		}
+		if d.NextAttempt > now.UnixNano() {
+			continue
+		}
+		m.inflight[d.ID] = struct{}{}
+		res = append(res, d)
+	}
+	sort.Slice(res, func(i, j int) bool {
+		return res[i].NextAttempt < res[j].NextAttempt
+	})
+	return res
+}
+
+// dropOldest drops the oldest queued deliveries of a Webhook exceeding
+// MaxQueuedDeliveries. Deliveries being sent aren't dropped.
+func (m *Module) dropOldest(webhookID string) {
+	excess := m.queued[webhookID] - MaxQueuedDeliveries
+	if excess <= 0 {
+		return
+	}
+	var candidates []delivery
+	for _, d := range m.pending {
+		if d.WebhookID == webhookID {
+			candidates = append(candidates, d)
+		}
+	}
+	for _, d := range m.deliveries {
+		if _, ok := m.inflight[d.ID]; !ok && d.WebhookID == webhookID {
+			candidates = append(candidates, d)
+		}
+	}
+	sort.Slice(candidates, func(i, j int) bool {
+		return candidates[i].CreatedAt < candidates[j].CreatedAt
+	})
+	if len(candidates) > excess {
+		candidates = candidates[:excess]
+	}
+	drop := make(map[string]struct{}, len(candidates))
+	for _, d := range candidates {
+		drop[d.ID] = struct{}{}
+		if _, ok := m.deliveries[d.ID]; ok {
+			delete(m.deliveries, d.ID)
+			m.dropped = append(m.dropped, d.ID)
+		}
+	}
+	pending := m.pending[:0]
+	for _, d := range m.pending {
+		if _, ok := drop[d.ID]; !ok {
+			pending = append(pending, d)
+		}
+	}
+	m.pending = pending
+	m.queued[webhookID] -= len(drop)
+	log.Warnf("webhook %s has more than %d queued deliveries, dropped the %d oldest", webhookID, MaxQueuedDeliveries, len(drop))
+}
+
+// unqueue decreases the count of queued deliveries of a Webhook.
+func (m *Module) unqueue(webhookID string) {
+	if m.queued[webhookID] <= 1 {
+		delete(m.queued, webhookID)
+		return
+	}
+	m.queued[webhookID]--
+}
+
+// persistPending persists the deliveries queued by Notify, and makes
+// them available to be sent. Deliveries dropped by Notify are deleted.
+func (m *Module) persistPending() {
+	m.lock.Lock()
+	pending, dropped := m.pending, m.dropped
+	m.pending, m.dropped = nil, nil
+	m.lock.Unlock()
+
+	for _, id := range dropped {
+		if err := m.ds.Delete(makeDeliveryKey(id)); err != nil {
+			log.Errorf("deleting dropped delivery %s: %s", id, err)
+		}
+	}
+	for _, d := range pending {
+		if err := m.putDelivery(d); err != nil {
+			log.Errorf("saving delivery for webhook %s: %s", d.WebhookID, err)
+			m.lock.Lock()
+			m.unqueue(d.WebhookID)
+			m.lock.Unlock()
+			continue
+		}
+		m.lock.Lock()
+		if _, ok := m.webhooks[d.APIID][d.WebhookID]; ok {
+			m.deliveries[d.ID] = d
+		} else if err := m.ds.Delete(makeDeliveryKey(d.ID)); err != nil {
+			// The Webhook was removed while persisting.
+			log.Errorf("deleting delivery %s: %s", d.ID, err)
+		}
+		m.lock.Unlock()
+	}
+}
+
+func (m *Module) deliver(d delivery) {
+	m.lock.Lock()
+	w, ok := m.webhooks[d.APIID][d.WebhookID]
+	m.lock.Unlock()
+
+	var err error
+	if ok {
+		err = m.send(w, d)
+	}
+
+	d.Attempts++
+	retry := ok && err != nil && d.Attempts < MaxAttempts
+	var dsErr error
+	if retry {
+		log.Warnf("delivery %s to webhook %s failed (attempt %d): %s", d.ID, w.ID, d.Attempts, err)
+		d.NextAttempt = time.Now().Add(backoff(d.Attempts)).UnixNano()
+		if dsErr = m.putDelivery(d); dsErr != nil {
+			log.Errorf("saving failed delivery %s: %s", d.ID, dsErr)
+		}
+	} else {
+		if err != nil {
+			log.Errorf("discarding delivery %s to webhook %s after %d attempts: %s", d.ID, w.ID, d.Attempts, err)
+		}
+		if dsErr = m.ds.Delete(makeDeliveryKey(d.ID)); dsErr != nil {
+			log.Errorf("deleting delivery %s: %s", d.ID, dsErr)
+		}
+	}
+
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	delete(m.inflight, d.ID)
+	if _, ok := m.deliveries[d.ID]; !ok {
+		// The Webhook was removed while sending.
+		if retry && dsErr == nil {
+			if err := m.ds.Delete(makeDeliveryKey(d.ID)); err != nil {
+				log.Errorf("deleting delivery %s: %s", d.ID, err)
+			}
+		}
+		return
+	}
+	if retry {
+		m.deliveries[d.ID] = d
+		return
+	}
+	if dsErr == nil {
+		delete(m.deliveries, d.ID)
+		m.unqueue(d.WebhookID)
+	}
+}
+
+func (m *Module) send(w Webhook, d delivery) error {
+	ctx, cancel := context.WithTimeout(m.ctx, DeliveryTimeout)
+	defer cancel()
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, w.URL, bytes.NewReader(d.Payload))
+	if err != nil {
+		return fmt.Errorf("creating request: %s", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(HeaderEvent, string(d.EventType))
+	req.Header.Set(HeaderDelivery, d.ID)
+	req.Header.Set(HeaderSignature, "sha256="+Sign(w.Secret, d.Payload))
+	res, err := m.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("sending request: %s", err)
+	}
+	defer func() {
+		if err := res.Body.Close(); err != nil {
+			log.Errorf("closing response body: %s", err)
+		}
+	}()
+	if res.StatusCode < 200 || res.StatusCode > 299 {
+		return fmt.Errorf("unexpected response status %s", res.Status)
+	}
+	return nil
+}
+
+// putDelivery persists a delivery. It doesn't update the cache,
+// so it doesn't need to be guarded.
+func (m *Module) putDelivery(d delivery) error {
+	buf, err := json.Marshal(d)
+	if err != nil {
+		return fmt.Errorf("marshaling delivery: %s", err)
+	}
+	if err := m.ds.Put(makeDeliveryKey(d.ID), buf); err != nil {
+		return fmt.Errorf("saving delivery in datastore: %s", err)
+	}
+	return nil
+}
+
+func (m *Module) loadCaches() error {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	whs, err := m.ds.Query(query.Query{Prefix: dsBaseWebhook.String()})
+	if err != nil {
+		return fmt.Errorf("querying webhooks: %s", err)
+	}
+	defer func() {
+		if err := whs.Close(); err != nil {
+			log.Errorf("closing webhooks query result: %s", err)
+		}
+	}()
+	for r := range whs.Next() {
+		if r.Error != nil {
+			return fmt.Errorf("iter next: %s", r.Error)
+		}
+		var w Webhook
+		if err := json.Unmarshal(r.Value, &w); err != nil {
+			return fmt.Errorf("unmarshaling webhook: %s", err)
+		}
+		if _, ok := m.webhooks[w.APIID]; !ok {
+			m.webhooks[w.APIID] = make(map[string]Webhook)
+		}
+		m.webhooks[w.APIID][w.ID] = w
+	}
+
+	dls, err := m.ds.Query(query.Query{Prefix: dsBaseDelivery.String()})
+	if err != nil {
+		return fmt.Errorf("querying deliveries: %s", err)
+	}
+	defer func() {
+		if err := dls.Close(); err != nil {
+			log.Errorf("closing deliveries query result: %s", err)
+		}
+	}()
+	for r := range dls.Next() {
+		if r.Error != nil {
+			return fmt.Errorf("iter next: %s", r.Error)
+		}
+		var d delivery
+		if err := json.Unmarshal(r.Value, &d); err != nil {
+			return fmt.Errorf("unmarshaling delivery: %s", err)
+		}
+		m.deliveries[d.ID] = d
+		m.queued[d.WebhookID]++
+	}
+	return nil
+}
+
+// Sign returns the hex encoded HMAC-SHA256 of body using secret.
+// Receivers can use it to verify the HeaderSignature of deliveries.
+func Sign(secret string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	_, _ = mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+func (w Webhook) subscribed(t ffs.EventType) bool {
+	if len(w.Events) == 0 {
+		return true
+	}
+	for _, e := range w.Events {
+		if e == t {
+			return true
+		}
+	}
+	return false
+}
+
+func newDelivery(w Webhook, e ffs.Event) (delivery, error) {
+	id := uuid.New().String()
+	p := Payload{
+		ID:        id,
+		Type:      string(e.Type),
+		UserID:    e.APIID.String(),
+		Timestamp: e.Timestamp.Unix(),
+		JobID:     e.JobID.String(),
+		DealID:    e.DealID,
+		Miner:     e.Miner,
+		Message:   e.Message,
+		NumDeals:  e.NumDeals,
+	}
+	if e.Cid.Defined() {
+		p.Cid = e.Cid.String()
+	}
+	if e.ProposalCid.Defined() {
+		p.ProposalCid = e.ProposalCid.String()
+	}
+	if e.Type == ffs.EventJobStatus {
+		p.JobStatus = ffs.JobStatusStr[e.JobStatus]
+	}
+	buf, err := json.Marshal(p)
+	if err != nil {
+		return delivery{}, fmt.Errorf("marshaling payload: %s", err)
+	}
+	now := time.Now().UnixNano()
+	return delivery{
+		ID:          id,
+		WebhookID:   w.ID,
+		APIID:       w.APIID,
+		EventType:   e.Type,
+		Payload:     buf,
+		NextAttempt: now,
+		CreatedAt:   now,
+	}, nil
+}
+
+func backoff(attempts int) time.Duration {
+	b := InitialBackoff
+	for i := 1; i < attempts && b < MaxBackoff; i++ {
+		b *= 2
+	}
+	if b > MaxBackoff {
+		b = MaxBackoff
+	}
+	return b
+}
+
+// checkHost returns an error if host is, or resolves to, a loopback,
+// private or link-local address.
+func checkHost(host string) error {
+	if ip := net.ParseIP(host); ip != nil {
+		return checkTarget(ip)
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), DeliveryTimeout)
+	defer cancel()
+	addrs, err := net.DefaultResolver.LookupIPAddr(ctx, host)
+	if err != nil {
+		return fmt.Errorf("resolving host: %s", err)
+	}
+	for _, addr := range addrs {
+		if err := checkTarget(addr.IP); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// controlTarget is a net.Dialer Control function which refuses to connect
+// to loopback, private or link-local addresses. Checking the address when
+// connecting covers hosts resolving to another address after registration,
+// and redirects.
+func controlTarget(_, address string, _ syscall.RawConn) error {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return fmt.Errorf("parsing address: %s", err)
+	}
+	ip := net.ParseIP(host)
+	if ip == nil {
+		return fmt.Errorf("invalid ip %s", host)
+	}
+	return checkTarget(ip)
+}
+
+func checkTarget(ip net.IP) error {
+	if ip.IsLoopback() || ip.IsLinkLocalUnicast() || ip.IsLinkLocalMulticast() || ip.IsInterfaceLocalMulticast() || ip.IsUnspecified() {
+		return fmt.Errorf("%s is a loopback, link-local or unspecified address", ip)
+	}
+	for _, n := range privateNets {
+		if n.Contains(ip) {
+			return fmt.Errorf("%s is a private address", ip)
+		}
+	}
+	return nil
+}
+
+func parseCIDRs(cidrs ...string) []*net.IPNet {
+	res := make([]*net.IPNet, len(cidrs))
+	for i, c := range cidrs {
+		_, n, err := net.ParseCIDR(c)
+		if err != nil {
+			panic(err)
+		}
+		res[i] = n
+	}
+	return res
+}
+
+func isEventType(t ffs.EventType) bool {
+	for _, et := range ffs.EventTypes {
+		if et == t {
+			return true
+		}
+	}
+	return false
+}
+
+func newSecret() (string, error) {
+	buf := make([]byte, 32)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(buf), nil
+}
+
+func makeWebhookKey(iid ffs.APIID, id string) datastore.Key {
+	return dsBaseWebhook.ChildString(iid.String()).ChildString(id)
+}
+
+func makeDeliveryKey(id string) datastore.Key {
+	return dsBaseDelivery.ChildString(id)
+}
@@ -0,0 +1,324 @@
+package webhooks
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ipfs/go-cid"
+	"github.com/ipfs/go-datastore"
+	"github.com/ipfs/go-datastore/query"
+	"github.com/stretchr/testify/require"
+	"github.com/textileio/powergate/v2/ffs"
+	"github.com/textileio/powergate/v2/tests"
+)
+
+func init() {
+	DeliveryTick = time.Millisecond * 50
+	InitialBackoff = time.Millisecond * 50
+	MaxBackoff = time.Millisecond * 200
+	MaxAttempts = 3
+	MaxQueuedDeliveries = 5
+}
+
+type receiver struct {
+	lock     sync.Mutex
+	failures int
+	requests []*http.Request
+	bodies   [][]byte
+}
+
+func (r *receiver) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	body, _ := ioutil.ReadAll(req.Body)
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	r.requests = append(r.requests, req)
+	r.bodies = append(r.bodies, body)
+	if r.failures > 0 {
+		r.failures--
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+}
+
+func (r *receiver) count() int {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	return len(r.requests)
+}
+
+func TestRegister(t *testing.T) {
+	t.Parallel()
+	m := create(t, tests.NewTxMapDatastore())
+	iid := ffs.NewAPIID()
+
+	_, err := m.Register(iid, "not-a-url", nil)
+	require.Error(t, err)
+	_, err = m.Register(iid, "http://localhost/hook", []ffs.EventType{"unknown"})
+	require.Error(t, err)
+
+	w, err := m.Register(iid, "http://localhost/hook", []ffs.EventType{ffs.EventDealActive})
+	require.NoError(t, err)
+	require.NotEmpty(t, w.ID)
+	require.NotEmpty(t, w.Secret)
+
+	ws := m.List(iid)
+	require.Len(t, ws, 1)
+	require.Equal(t, w.ID, ws[0].ID)
+	require.Empty(t, ws[0].Secret)
+	require.Empty(t, m.List(ffs.NewAPIID()))
+
+	err = m.Remove(ffs.NewAPIID(), w.ID)
+	require.Equal(t, ErrNotFound, err)
+	err = m.Remove(iid, w.ID)
+	require.NoError(t, err)
+	require.Empty(t, m.List(iid))
+}
+
+func TestRegisterPrivateTargets(t *testing.T) {
+	t.Parallel()
+	m, err := New(tests.NewTxMapDatastore(), false)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		require.NoError(t, m.Close())
+	})
+	iid := ffs.NewAPIID()
+
+	for _, u := range []string{
+		"http://localhost/hook",
+		"http://127.0.0.1/hook",
+		"http://10.0.0.1/hook",
+		"http://172.16.0.1/hook",
+		"https://192.168.1.1/hook",
+		"http://169.254.169.254/latest/meta-data",
+		"http://0.0.0.0/hook",
+		"http://[::1]/hook",
+		"http://[fe80::1]/hook",
+		"http://[fd00::1]/hook",
+	} {
+		_, err := m.Register(iid, u, nil)
+		require.Error(t, err, u)
+	}
+	_, err = m.Register(iid, "http://1.1.1.1/hook", nil)
+	require.NoError(t, err)
+
+	// Deliveries aren't sent to private addresses, even if
+	// the Webhook host resolves to one after registration.
+	err = controlTarget("tcp", "127.0.0.1:80", nil)
+	require.Error(t, err)
+	err = controlTarget("tcp", "1.1.1.1:443", nil)
+	require.NoError(t, err)
+}
+
+// slowDatastore delays Puts.
+type slowDatastore struct {
+	*tests.TxMapDatastore
+	delay time.Duration
+}
+
+func (ds *slowDatastore) Put(key datastore.Key, value []byte) error {
+	time.Sleep(ds.delay)
+	return ds.TxMapDatastore.Put(key, value)
+}
+
+func TestNotifyDoesntPersist(t *testing.T) {
+	t.Parallel()
+	r := &receiver{}
+	srv := httptest.NewServer(r)
+	defer srv.Close()
+
+	ds := &slowDatastore{TxMapDatastore: tests.NewTxMapDatastore(), delay: time.Millisecond * 200}
+	m := create(t, ds)
+	iid := ffs.NewAPIID()
+	_, err := m.Register(iid, srv.URL, nil)
+	require.NoError(t, err)
+
+	// Notify only queues the delivery, which is persisted
+	// and sent by the delivery daemon.
+	start := time.Now()
+	m.Notify(ffs.Event{Type: ffs.EventDealFailed, APIID: iid})
+	require.Less(t, int64(time.Since(start)), int64(ds.delay))
+	require.Eventually(t, func() bool { return r.count() == 1 }, time.Second*2, 10*time.Millisecond)
+}
+
+func TestDelivery(t *testing.T) {
+	t.Parallel()
+	r := &receiver{}
+	srv := httptest.NewServer(r)
+	defer srv.Close()
+
+	m := create(t, tests.NewTxMapDatastore())
+	iid := ffs.NewAPIID()
+	w, err := m.Register(iid, srv.URL, []ffs.EventType{ffs.EventJobStatus})
+	require.NoError(t, err)
+
+	c := testCid(t)
+	// Not subscribed event type, and other APIID events are ignored.
+	m.Notify(ffs.Event{Type: ffs.EventDealActive, APIID: iid, Cid: c})
+	m.Notify(ffs.Event{Type: ffs.EventJobStatus, APIID: ffs.NewAPIID(), Cid: c})
+	m.Notify(ffs.Event{Type: ffs.EventJobStatus, APIID: iid, Cid: c, JobID: "jid", JobStatus: ffs.Success, Timestamp: time.Now()})
+
+	require.Eventually(t, func() bool { return r.count() == 1 }, time.Second, 10*time.Millisecond)
+	time.Sleep(DeliveryTick * 2)
+	require.Equal(t, 1, r.count())
+
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	req, body := r.requests[0], r.bodies[0]
+	require.Equal(t, string(ffs.EventJobStatus), req.Header.Get(HeaderEvent))
+	require.Equal(t, "sha256="+Sign(w.Secret, body), req.Header.Get(HeaderSignature))
+	var p Payload
+	err = json.Unmarshal(body, &p)
+	require.NoError(t, err)
+	require.Equal(t, req.Header.Get(HeaderDelivery), p.ID)
+	require.Equal(t, iid.String(), p.UserID)
+	require.Equal(t, c.String(), p.Cid)
+	require.Equal(t, "jid", p.JobID)
+	require.Equal(t, "Success", p.JobStatus)
+}
+
+func TestRetries(t *testing.T) {
+	t.Parallel()
+	t.Run("Success", func(t *testing.T) {
+		t.Parallel()
+		r := &receiver{failures: 2}
+		srv := httptest.NewServer(r)
+		defer srv.Close()
+
+		m := create(t, tests.NewTxMapDatastore())
+		iid := ffs.NewAPIID()
+		_, err := m.Register(iid, srv.URL, nil)
+		require.NoError(t, err)
+		m.Notify(ffs.Event{Type: ffs.EventDealFailed, APIID: iid})
+
+		require.Eventually(t, func() bool { return r.count() == 3 }, time.Second*2, 10*time.Millisecond)
+		time.Sleep(MaxBackoff * 2)
+		require.Equal(t, 3, r.count())
+
+		// All attempts have the same delivery id.
+		r.lock.Lock()
+		defer r.lock.Unlock()
+		id := r.requests[0].Header.Get(HeaderDelivery)
+		for _, req := range r.requests {
+			require.Equal(t, id, req.Header.Get(HeaderDelivery))
+		}
+	})
+	t.Run("MaxAttempts", func(t *testing.T) {
+		t.Parallel()
+		r := &receiver{failures: MaxAttempts + 1}
+		srv := httptest.NewServer(r)
+		defer srv.Close()
+
+		m := create(t, tests.NewTxMapDatastore())
+		iid := ffs.NewAPIID()
+		_, err := m.Register(iid, srv.URL, nil)
+		require.NoError(t, err)
+		m.Notify(ffs.Event{Type: ffs.EventDealFailed, APIID: iid})
+
+		require.Eventually(t, func() bool { return r.count() == MaxAttempts }, time.Second*2, 10*time.Millisecond)
+		time.Sleep(MaxBackoff * 2)
+		require.Equal(t, MaxAttempts, r.count())
+	})
+}
+
+func TestMaxQueuedDeliveries(t *testing.T) {
+	t.Parallel()
+	// The endpoint is down, so deliveries are retried.
+	srv := httptest.NewServer(&receiver{})
+	srv.Close()
+
+	ds := tests.NewTxMapDatastore()
+	m := create(t, ds)
+	iid := ffs.NewAPIID()
+	w, err := m.Register(iid, srv.URL, nil)
+	require.NoError(t, err)
+
+	// The oldest deliveries are dropped, keeping the newest ones.
+	for i := 0; i < MaxQueuedDeliveries*4; i++ {
+		m.Notify(ffs.Event{Type: ffs.EventDealFailed, APIID: iid, Message: fmt.Sprintf("%d", i)})
+		require.LessOrEqual(t, len(queuedMessages(t, m, w.ID)), MaxQueuedDeliveries)
+	}
+	last := fmt.Sprintf("%d", MaxQueuedDeliveries*4-1)
+	require.Contains(t, queuedMessages(t, m, w.ID), last)
+	require.Never(t, func() bool {
+		return len(queuedMessages(t, m, w.ID)) > MaxQueuedDeliveries
+	}, MaxBackoff*2, time.Millisecond*5)
+
+	// Dropped deliveries are deleted from the datastore.
+	require.Eventually(t, func() bool {
+		res, err := ds.Query(query.Query{Prefix: dsBaseDelivery.String(), KeysOnly: true})
+		require.NoError(t, err)
+		all, err := res.Rest()
+		require.NoError(t, err)
+		return len(all) <= MaxQueuedDeliveries
+	}, time.Second, time.Millisecond*10)
+}
+
+// queuedMessages returns the messages of the pending and
+// persisted deliveries of a Webhook.
+func queuedMessages(t *testing.T, m *Module, webhookID string) []string {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	queued := append([]delivery(nil), m.pending...)
+	for _, d := range m.deliveries {
+		queued = append(queued, d)
+	}
+	var res []string
+	for _, d := range queued {
+		if d.WebhookID != webhookID {
+			continue
+		}
+		var p Payload
+		require.NoError(t, json.Unmarshal(d.Payload, &p))
+		res = append(res, p.Message)
+	}
+	return res
+}
+
+func TestPersisted(t *testing.T) {
+	t.Parallel()
+	r := &receiver{}
+	srv := httptest.NewServer(r)
+	defer srv.Close()
+
+	ds := tests.NewTxMapDatastore()
+	m, err := New(ds, true)
+	require.NoError(t, err)
+	iid := ffs.NewAPIID()
+	w, err := m.Register(iid, srv.URL, nil)
+	require.NoError(t, err)
+	// Close before the delivery is sent.
+	err = m.Close()
+	require.NoError(t, err)
+	m.Notify(ffs.Event{Type: ffs.EventRenewalCreated, APIID: iid, NumDeals: 2})
+	require.Equal(t, 0, r.count())
+
+	m = create(t, ds)
+	ws := m.List(iid)
+	require.Len(t, ws, 1)
+	require.Equal(t, w.ID, ws[0].ID)
+	require.Eventually(t, func() bool { return r.count() == 1 }, time.Second, 10*time.Millisecond)
+}
+
+func testCid(t *testing.T) cid.Cid {
+	c, err := cid.Decode("QmY7Yh4UquoXHLPFo2XbhXkhBvFoPwmQUSa92pxnxjQuPU")
+	require.NoError(t, err)
+	return c
+}
+
+// create returns a Module which allows private targets,
+// since test receivers listen on loopback addresses.
+func create(t *testing.T, ds datastore.TxnDatastore) *Module {
+	m, err := New(ds, true)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		require.NoError(t, m.Close())
+	})
+	return m
+}
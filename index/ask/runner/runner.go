@@ -134,7 +134,7 @@ func (ai *Runner) Listen() <-chan struct{} {
 }
 
 // Unregister unregisters a channel signaler from the signaler hub.
-func (ai *Runner) Unregister(c chan struct{}) {
+func (ai *Runner) Unregister(c <-chan struct{}) {
 	ai.signaler.Unregister(c)
 }
 
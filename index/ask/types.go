@@ -9,7 +9,7 @@ type Module interface {
 	Get() Index
 	Query(q Query) ([]StorageAsk, error)
 	Listen() <-chan struct{}
-	Unregister(c chan struct{})
+	Unregister(c <-chan struct{})
 }
 
 // Index contains Ask information from markets.
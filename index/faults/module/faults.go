@@ -86,6 +86,7 @@ func (s *Index) Get() faults.IndexSnapshot {
 	defer s.lock.Unlock()
 	ii := faults.IndexSnapshot{
 		TipSetKey: s.index.TipSetKey,
+		Height:    s.index.Height,
 		Miners:    make(map[string]faults.Faults, len(s.index.Miners)),
 	}
 	for addr, v := range s.index.Miners {
@@ -105,7 +106,7 @@ func (s *Index) Listen() <-chan struct{} {
 }
 
 // Unregister frees a channel from the signaler hub.
-func (s *Index) Unregister(c chan struct{}) {
+func (s *Index) Unregister(c <-chan struct{}) {
 	s.signaler.Unregister(c)
 }
 
@@ -197,6 +198,7 @@ func (s *Index) updateIndex() error {
 			return fmt.Errorf("getting faults from path section: %s", err)
 		}
 		index.TipSetKey = sectionHeadTs.String()
+		index.Height = int64(path[j-1].Height())
 		for _, f := range fs {
 			currFaults := index.Miners[f.Miner.String()]
 			currFaults.Epochs = append(currFaults.Epochs, int64(f.Epoch))
@@ -214,6 +216,7 @@ func (s *Index) updateIndex() error {
 		s.lock.Lock()
 		s.index = faults.IndexSnapshot{
 			TipSetKey: index.TipSetKey,
+			Height:    index.Height,
 			Miners:    make(map[string]faults.Faults, len(index.Miners)),
 		}
 		for k, v := range index.Miners {
@@ -1,9 +1,19 @@
 package faults
 
+import "github.com/textileio/powergate/v2/util"
+
+// RecentFaultsWindow is the number of epochs before the current height
+// where miner faults are considered recent. Miners without faults in
+// this window are considered recovered.
+const RecentFaultsWindow = 7 * 24 * 60 * 60 / util.EpochDurationSeconds
+
 // IndexSnapshot contains faults histoy information up-to a TipSetKey.
 type IndexSnapshot struct {
 	TipSetKey string
-	Miners    map[string]Faults
+	// Height is the height of TipSetKey. It's zero if the
+	// index wasn't built yet.
+	Height int64
+	Miners map[string]Faults
 }
 
 // Faults contains epochs where a fault was detected for a miner.
@@ -15,5 +25,5 @@ type Faults struct {
 type Module interface {
 	Get() IndexSnapshot
 	Listen() <-chan struct{}
-	Unregister(c chan struct{})
+	Unregister(c <-chan struct{})
 }
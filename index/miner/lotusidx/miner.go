@@ -127,7 +127,7 @@ func (mi *Index) Listen() <-chan struct{} {
 }
 
 // Unregister unregisters a channel signaler from the signaler hub.
-func (mi *Index) Unregister(c chan struct{}) {
+func (mi *Index) Unregister(c <-chan struct{}) {
 	mi.signaler.Unregister(c)
 }
 
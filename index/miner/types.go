@@ -50,5 +50,5 @@ type Location struct {
 type Module interface {
 	Get() IndexSnapshot
 	Listen() <-chan struct{}
-	Unregister(c chan struct{})
+	Unregister(c <-chan struct{})
 }
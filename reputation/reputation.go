@@ -84,6 +84,23 @@ func (rm *Module) AddSource(id string, maddr ma.Multiaddr) error {
 	return rm.sources.Add(source.Source{ID: id, Maddr: maddr})
 }
 
+// MinerCountry returns the country where a miner is located, or an empty
+// string if its location is unknown.
+func (rm *Module) MinerCountry(addr string) string {
+	rm.lockIndex.Lock()
+	defer rm.lockIndex.Unlock()
+	return rm.mIndex.Meta.Info[addr].Location.Country
+}
+
+// MinerOnChainData returns the on-chain data and fault epochs of a miner.
+// The returned bool is false if the miner isn't in the miner index.
+func (rm *Module) MinerOnChainData(addr string) (miner.OnChainMinerData, []int64, bool) {
+	rm.lockIndex.Lock()
+	defer rm.lockIndex.Unlock()
+	d, ok := rm.mIndex.OnChain.Miners[addr]
+	return d, rm.fIndex.Miners[addr].Epochs, ok
+}
+
 // QueryMiners makes a filtered query on the scored-sorted miner list.
 // Empty filter slices represent no-filters applied.
 func (rm *Module) QueryMiners(excludedMiners []string, countryCodes []string, trustedMiners []string) ([]MinerScore, error) {
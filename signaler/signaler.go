@@ -30,19 +30,15 @@ func (s *Signaler) Listen() <-chan struct{} {
 	return c
 }
 
-// Unregister unregisters a channel signaler from the hub.
-func (s *Signaler) Unregister(c chan struct{}) {
+// Unregister unregisters a channel signaler from the hub, and closes it.
+func (s *Signaler) Unregister(c <-chan struct{}) {
 	s.lock.Lock()
 	defer s.lock.Unlock()
 	for i := range s.listeners {
 		if s.listeners[i] == c {
-			if len(s.listeners) == 1 {
-				s.listeners = nil
-				return
-			}
+			close(s.listeners[i])
 			s.listeners[i] = s.listeners[len(s.listeners)-1]
 			s.listeners = s.listeners[:len(s.listeners)-1]
-			close(s.listeners[i])
 			return
 		}
 	}
@@ -69,4 +65,5 @@ func (s *Signaler) Close() {
 	for _, c := range s.listeners {
 		close(c)
 	}
+	s.listeners = nil
 }
@@ -0,0 +1,48 @@
+package signaler
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestUnregister(t *testing.T) {
+	t.Parallel()
+	s := New()
+	c1 := s.Listen()
+	c2 := s.Listen()
+	c3 := s.Listen()
+
+	// The unregistered channel is closed, and stops receiving signals.
+	s.Unregister(c1)
+	_, ok := <-c1
+	require.False(t, ok)
+	s.Signal()
+	requireSignaled(t, c2)
+	requireSignaled(t, c3)
+
+	// Unregistering the last listener also closes it.
+	s.Unregister(c3)
+	s.Unregister(c2)
+	_, ok = <-c2
+	require.False(t, ok)
+	_, ok = <-c3
+	require.False(t, ok)
+
+	// Unregistering twice, or after closing, is a noop.
+	s.Unregister(c2)
+	c4 := s.Listen()
+	s.Close()
+	s.Unregister(c4)
+	_, ok = <-c4
+	require.False(t, ok)
+}
+
+func requireSignaled(t *testing.T, c <-chan struct{}) {
+	select {
+	case _, ok := <-c:
+		require.True(t, ok)
+	default:
+		t.Fatal("listener wasn't signaled")
+	}
+}
@@ -0,0 +1,67 @@
+package util
+
+import (
+	"context"
+	"fmt"
+	"sync"
+)
+
+// KeyedMutex is a set of mutexes identified by keys. The mutex of a key
+// only exists while it's locked or waited, so keys can be unbounded.
+type KeyedMutex struct {
+	lock  sync.Mutex
+	locks map[string]*keyedLock
+}
+
+type keyedLock struct {
+	ch chan struct{}
+	// users is the number of holders and waiters.
+	users int
+}
+
+// NewKeyedMutex returns a new KeyedMutex.
+func NewKeyedMutex() *KeyedMutex {
+	return &KeyedMutex{
+		locks: make(map[string]*keyedLock),
+	}
+}
+
+// Lock locks the mutex of key, waiting until it's unlocked or ctx is
+// canceled. It returns a function to unlock it.
+func (km *KeyedMutex) Lock(ctx context.Context, key string) (func(), error) {
+	km.lock.Lock()
+	l, ok := km.locks[key]
+	if !ok {
+		l = &keyedLock{ch: make(chan struct{}, 1)}
+		km.locks[key] = l
+	}
+	l.users++
+	km.lock.Unlock()
+
+	release := func() {
+		km.lock.Lock()
+		defer km.lock.Unlock()
+		l.users--
+		if l.users == 0 {
+			delete(km.locks, key)
+		}
+	}
+	select {
+	case l.ch <- struct{}{}:
+	case <-ctx.Done():
+		release()
+		return nil, fmt.Errorf("canceled by context")
+	}
+	return func() {
+		<-l.ch
+		release()
+	}, nil
+}
+
+// InUse returns true if the mutex of key is locked or waited.
+func (km *KeyedMutex) InUse(key string) bool {
+	km.lock.Lock()
+	defer km.lock.Unlock()
+	_, ok := km.locks[key]
+	return ok
+}
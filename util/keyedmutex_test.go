@@ -0,0 +1,64 @@
+package util
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestKeyedMutex(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	km := NewKeyedMutex()
+
+	unlock, err := km.Lock(ctx, "a")
+	require.NoError(t, err)
+	require.True(t, km.InUse("a"))
+
+	// Other keys aren't blocked.
+	unlockB, err := km.Lock(ctx, "b")
+	require.NoError(t, err)
+	unlockB()
+	require.False(t, km.InUse("b"))
+
+	// Waiting for a locked key can be canceled.
+	cctx, cancel := context.WithTimeout(ctx, time.Millisecond*50)
+	defer cancel()
+	_, err = km.Lock(cctx, "a")
+	require.Error(t, err)
+
+	// Holders of the same key are serialized.
+	var wg sync.WaitGroup
+	var lock sync.Mutex
+	var holders, maxHolders int
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			unlock, err := km.Lock(ctx, "a")
+			if err != nil {
+				return
+			}
+			lock.Lock()
+			holders++
+			if holders > maxHolders {
+				maxHolders = holders
+			}
+			lock.Unlock()
+			time.Sleep(time.Millisecond)
+			lock.Lock()
+			holders--
+			lock.Unlock()
+			unlock()
+		}()
+	}
+	unlock()
+	wg.Wait()
+	require.Equal(t, 1, maxHolders)
+
+	// Unused keys are removed.
+	require.False(t, km.InUse("a"))
+}